@@ -0,0 +1,193 @@
+package esdb
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+
+	api "github.com/EventStore/EventStore-Client-Go/v2/protos/streams"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+func benchmarkEvents(count int) []EventData {
+	events := make([]EventData, count)
+	for i := range events {
+		events[i] = EventData{
+			EventID:     uuid.Must(uuid.NewV4()),
+			EventType:   "BenchmarkEvent",
+			ContentType: JsonContentType,
+			Data:        []byte(`{"id":42,"name":"benchmark"}`),
+		}
+	}
+
+	return events
+}
+
+func TestToProposedMessagesMatchesToProposedMessage(t *testing.T) {
+	events := benchmarkEvents(3)
+	for i, message := range toProposedMessages(events) {
+		assert.True(t, proto.Equal(toProposedMessage(events[i]), message))
+	}
+}
+
+func BenchmarkToProposedMessages(b *testing.B) {
+	events := benchmarkEvents(1000)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		toProposedMessages(events)
+	}
+}
+
+// BenchmarkAppendSenderEncoding measures the client side of an append of 1000 events: building the requests,
+// checking their size and encoding them into a reused buffer, the way gRPC does on every Send.
+func BenchmarkAppendSenderEncoding(b *testing.B) {
+	events := benchmarkEvents(1000)
+	var buffer []byte
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		messages := toProposedMessages(events)
+		for _, message := range messages {
+			proposedMessageSize(message, 4*1024*1024)
+		}
+
+		sender := newAppendSender(messages)
+		for sender.next() {
+			var err error
+			if buffer, err = (proto.MarshalOptions{}).MarshalAppend(buffer[:0], sender.request); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+// appendServer accepts appends without storing their events.
+type appendServer struct {
+	api.UnimplementedStreamsServer
+}
+
+func (appendServer) Append(stream api.Streams_AppendServer) error {
+	for {
+		if _, err := stream.Recv(); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return err
+		}
+	}
+
+	return stream.SendAndClose(&api.AppendResp{Result: &api.AppendResp_Success_{Success: &api.AppendResp_Success{}}})
+}
+
+func (appendServer) BatchAppend(stream api.Streams_BatchAppendServer) error {
+	for {
+		request, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if request.IsFinal {
+			err = stream.Send(&api.BatchAppendResp{
+				CorrelationId: request.CorrelationId,
+				Result:        &api.BatchAppendResp_Success_{Success: &api.BatchAppendResp_Success{}},
+			})
+
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// benchmarkStreamsClient returns a client of an in-process appendServer.
+func benchmarkStreamsClient(b *testing.B) api.StreamsClient {
+	listener := bufconn.Listen(4 * 1024 * 1024)
+	server := grpc.NewServer()
+	api.RegisterStreamsServer(server, appendServer{})
+	go server.Serve(listener)
+	b.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return listener.Dial()
+	}))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.Cleanup(func() { conn.Close() })
+	return api.NewStreamsClient(conn)
+}
+
+// BenchmarkAppend appends 1000 events to an in-process server, one Send per event as the Append RPC requires. The
+// per-message cost of gRPC dominates, so encoding the requests ahead of their Send doesn't make it faster.
+func BenchmarkAppend(b *testing.B) {
+	client := benchmarkStreamsClient(b)
+	events := benchmarkEvents(1000)
+	header := toAppendHeader("benchmark", Any{})
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		stream, err := client.Append(context.Background())
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		if err := stream.Send(header); err != nil {
+			b.Fatal(err)
+		}
+
+		sender := newAppendSender(toProposedMessages(events))
+		for sender.next() {
+			if err := stream.Send(sender.request); err != nil {
+				b.Fatal(err)
+			}
+		}
+
+		if _, err := stream.CloseAndRecv(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkBatchAppend sends the same 1000 events as BenchmarkAppend in messages of 50 events, the default BatchSize
+// of BatchAppendToStream.
+func BenchmarkBatchAppend(b *testing.B) {
+	client := benchmarkStreamsClient(b)
+	request := batchAppendRequest{streamID: "benchmark", expectedRevision: Any{}, events: benchmarkEvents(1000)}
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		session, err := client.BatchAppend(context.Background())
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		for _, message := range toBatchAppendRequests(uuid.Must(uuid.NewV4()), request, 50, nil) {
+			if err := session.Send(message); err != nil {
+				b.Fatal(err)
+			}
+		}
+
+		if err := session.CloseSend(); err != nil {
+			b.Fatal(err)
+		}
+
+		if _, err := session.Recv(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package esdb
+
+import (
+	"context"
+	"fmt"
+)
+
+// AppendInterceptor is called for every event before it is appended, and may modify it, typically to stamp its
+// metadata with correlation or tenant information. Returning an error cancels the whole append.
+type AppendInterceptor func(ctx context.Context, event *EventData) error
+
+// interceptEvents runs the configured interceptors on copies of the events, leaving the caller's slice untouched.
+func (client *Client) interceptEvents(ctx context.Context, events []EventData) ([]EventData, error) {
+	if len(client.Config.AppendInterceptors) == 0 {
+		return events, nil
+	}
+
+	intercepted := make([]EventData, len(events))
+	copy(intercepted, events)
+
+	for i := range intercepted {
+		for _, interceptor := range client.Config.AppendInterceptors {
+			if err := interceptor(ctx, &intercepted[i]); err != nil {
+				return nil, fmt.Errorf("append interceptor rejected event %d of type '%s': %w", i, intercepted[i].EventType, err)
+			}
+		}
+	}
+
+	return intercepted, nil
+}
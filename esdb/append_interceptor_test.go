@@ -0,0 +1,38 @@
+package esdb
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInterceptEvents(t *testing.T) {
+	client := &Client{Config: &Configuration{
+		AppendInterceptors: []AppendInterceptor{
+			func(ctx context.Context, event *EventData) error {
+				event.Metadata = []byte(`{"tenant":"acme"}`)
+				return nil
+			},
+			func(ctx context.Context, event *EventData) error {
+				if event.EventType == "forbidden" {
+					return errors.New("forbidden event")
+				}
+
+				return nil
+			},
+		},
+	}}
+
+	events := []EventData{{EventType: "allowed"}}
+	intercepted, err := client.interceptEvents(context.Background(), events)
+	require.NoError(t, err)
+	assert.Equal(t, `{"tenant":"acme"}`, string(intercepted[0].Metadata))
+	assert.Nil(t, events[0].Metadata)
+
+	_, err = client.interceptEvents(context.Background(), []EventData{{EventType: "allowed"}, {EventType: "forbidden"}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "event 1 of type 'forbidden'")
+}
@@ -8,6 +8,30 @@ type AppendToStreamOptions struct {
 	ExpectedRevision ExpectedRevision
 	Authenticated    *Credentials
 	Deadline         *time.Duration
+	// Attaches the gRPC headers and trailers of the response to the result.
+	ReturnResponseMetadata bool
+	// Maximum encoded size of a single event. Events are sent one message at a time over the append session, and
+	// larger events are rejected with ErrorInvalidArgument before anything is sent. Zero, the default, disables the
+	// check and leaves it to the server's maximum gRPC message size. Events are never split into chunks, an event
+	// has to fit in one message.
+	MaxAppendPayloadBytes int
+	// Retries the append when it fails with an error that leaves its outcome unknown, such as a deadline or a
+	// connection loss. Before retrying, the tail of the stream is read and the events already committed are removed
+	// from the batch. Events without an EventID are given one up-front so they can be recognized. The attempts follow
+	// the RetryPolicy when one applies, otherwise a single retry is made.
+	DeduplicateOnRetry bool
+	// Number of events read from the end of the stream when looking for committed events. Defaults to 1000.
+	DeduplicationWindow uint64
+	// Overrides Configuration.RetryPolicy. Events without an EventID are given one up-front, so the server can
+	// recognize a retried append that was already committed.
+	RetryPolicy *RetryPolicy
+	// Metadata every new stream starts with. It is written right after a successful append that created the stream,
+	// so readers may briefly see the stream without it. The metadata is only written if the stream has none yet, so
+	// concurrent writers don't overwrite each other. When writing it fails, the append returns its WriteResult along
+	// with an EnsureMetadataError, since the events were written.
+	EnsureMetadata *StreamMetadata
+	// Overrides Configuration.Compression, NoCompression disabling it.
+	Compression string
 }
 
 func (o *AppendToStreamOptions) kind() operationKind {
@@ -26,4 +50,20 @@ func (o *AppendToStreamOptions) setDefaults() {
 	if o.ExpectedRevision == nil {
 		o.ExpectedRevision = Any{}
 	}
+
+	if o.DeduplicationWindow == 0 {
+		o.DeduplicationWindow = 1000
+	}
+}
+
+func (o *AppendToStreamOptions) validate() error {
+	if o.MaxAppendPayloadBytes < 0 {
+		return invalidArgumentError("MaxAppendPayloadBytes must be positive, got %d", o.MaxAppendPayloadBytes)
+	}
+
+	if err := validateCompression(o.Compression); err != nil {
+		return err
+	}
+
+	return nil
 }
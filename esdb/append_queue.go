@@ -0,0 +1,312 @@
+package esdb
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// AppendQueueOptions configures an AppendQueue.
+type AppendQueueOptions struct {
+	// Interval at which pending events are flushed. Defaults to 100 milliseconds.
+	FlushInterval time.Duration
+	// Number of pending events of a stream triggering an immediate flush. Defaults to 500.
+	MaxBatchSize int
+	// Maximum number of BatchAppend sessions running concurrently. Defaults to 4.
+	MaxInFlight int
+	// Server-side deadline of every stream batch. Defaults to 10 seconds.
+	BatchDeadline *time.Duration
+	// Receives the result of every Enqueue call, in addition to its callback. The channel must be consumed, since a
+	// full channel blocks the delivery of further results.
+	Results       chan<- AppendQueueResult
+	Authenticated *Credentials
+	Deadline      *time.Duration
+}
+
+func (o *AppendQueueOptions) setDefaults() {
+	if o.FlushInterval == 0 {
+		o.FlushInterval = 100 * time.Millisecond
+	}
+
+	if o.MaxBatchSize == 0 {
+		o.MaxBatchSize = 500
+	}
+
+	if o.MaxInFlight == 0 {
+		o.MaxInFlight = 4
+	}
+
+	if o.BatchDeadline == nil {
+		deadline := 10 * time.Second
+		o.BatchDeadline = &deadline
+	}
+}
+
+func (o *AppendQueueOptions) validate() error {
+	if o.FlushInterval < 0 {
+		return invalidArgumentError("FlushInterval must be positive, got %v", o.FlushInterval)
+	}
+
+	if o.MaxBatchSize < 0 {
+		return invalidArgumentError("MaxBatchSize must be positive, got %d", o.MaxBatchSize)
+	}
+
+	if o.MaxInFlight < 0 {
+		return invalidArgumentError("MaxInFlight must be positive, got %d", o.MaxInFlight)
+	}
+
+	return nil
+}
+
+// AppendQueueResult is the outcome of an Enqueue call. WriteResult describes the whole stream batch the events
+// were written with.
+type AppendQueueResult struct {
+	StreamID    string
+	Events      []EventData
+	WriteResult *WriteResult
+	Err         error
+}
+
+// ErrAppendQueueDrained is wrapped in the ErrorAppendQueueDrained error returned by Enqueue once Drain was called.
+var ErrAppendQueueDrained = errors.New("append queue is drained")
+
+// AppendQueue appends events in the background. Events enqueued for the same stream are batched together and written
+// in order, with an Any expected revision, while batches of different streams are pipelined over BatchAppend
+// sessions. Servers without BatchAppend support get one regular append per stream batch.
+type AppendQueue struct {
+	client  *Client
+	options AppendQueueOptions
+
+	lock     sync.Mutex
+	pending  map[string]*queuedBatch
+	order    []string
+	inFlight map[string]struct{}
+	draining bool
+
+	slots       chan struct{}
+	wake        chan struct{}
+	stop        chan struct{}
+	stopOnce    sync.Once
+	stopped     chan struct{}
+	outstanding sync.WaitGroup
+}
+
+type queuedBatch struct {
+	events   []EventData
+	requests []queuedRequest
+}
+
+type queuedRequest struct {
+	events   []EventData
+	callback func(*WriteResult, error)
+}
+
+// NewAppendQueue starts an AppendQueue writing with the given client. Drain must be called to release it.
+func NewAppendQueue(client *Client, opts AppendQueueOptions) (*AppendQueue, error) {
+	opts.setDefaults()
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	queue := &AppendQueue{
+		client:   client,
+		options:  opts,
+		pending:  make(map[string]*queuedBatch),
+		inFlight: make(map[string]struct{}),
+		slots:    make(chan struct{}, opts.MaxInFlight),
+		wake:     make(chan struct{}, 1),
+		stop:     make(chan struct{}),
+		stopped:  make(chan struct{}),
+	}
+
+	go queue.run()
+	return queue, nil
+}
+
+// Enqueue adds events to the queue without waiting for them to be written. The callback, which may be nil, is called
+// from a background goroutine once the outcome is known.
+func (queue *AppendQueue) Enqueue(streamID string, callback func(*WriteResult, error), events ...EventData) error {
+	if streamID == "" {
+		return invalidArgumentError("streamID is required")
+	}
+
+	queue.lock.Lock()
+	defer queue.lock.Unlock()
+
+	if queue.draining {
+		return &Error{code: ErrorAppendQueueDrained, err: ErrAppendQueueDrained}
+	}
+
+	batch, ok := queue.pending[streamID]
+	if !ok {
+		batch = &queuedBatch{}
+		queue.pending[streamID] = batch
+		queue.order = append(queue.order, streamID)
+	}
+
+	batch.events = append(batch.events, events...)
+	batch.requests = append(batch.requests, queuedRequest{events: events, callback: callback})
+	queue.outstanding.Add(1)
+
+	if len(batch.events) >= queue.options.MaxBatchSize {
+		queue.signal()
+	}
+
+	return nil
+}
+
+// Drain stops accepting events, flushes everything pending and waits until every enqueued event has a result or the
+// context is done.
+func (queue *AppendQueue) Drain(ctx context.Context) error {
+	queue.lock.Lock()
+	alreadyDraining := queue.draining
+	queue.draining = true
+	queue.lock.Unlock()
+
+	if !alreadyDraining {
+		queue.signal()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		queue.outstanding.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	queue.stopOnce.Do(func() {
+		close(queue.stop)
+	})
+
+	<-queue.stopped
+	return nil
+}
+
+func (queue *AppendQueue) signal() {
+	select {
+	case queue.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (queue *AppendQueue) run() {
+	defer close(queue.stopped)
+
+	ticker := time.NewTicker(queue.options.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-queue.stop:
+			return
+		case <-ticker.C:
+		case <-queue.wake:
+		}
+
+		queue.flush()
+	}
+}
+
+// flush sends every pending stream batch that has no batch in flight. Batches of a stream are never in flight
+// concurrently, which keeps the events of a stream in the order they were enqueued.
+func (queue *AppendQueue) flush() {
+	queue.lock.Lock()
+	var streams []string
+	var batches []*queuedBatch
+	var remaining []string
+	for _, streamID := range queue.order {
+		if _, busy := queue.inFlight[streamID]; busy {
+			remaining = append(remaining, streamID)
+			continue
+		}
+
+		streams = append(streams, streamID)
+		batches = append(batches, queue.pending[streamID])
+		queue.inFlight[streamID] = struct{}{}
+		delete(queue.pending, streamID)
+	}
+	queue.order = remaining
+	queue.lock.Unlock()
+
+	if len(streams) == 0 {
+		return
+	}
+
+	queue.slots <- struct{}{}
+	go func() {
+		defer func() { <-queue.slots }()
+		queue.send(streams, batches)
+
+		queue.lock.Lock()
+		for _, streamID := range streams {
+			delete(queue.inFlight, streamID)
+		}
+		queue.lock.Unlock()
+
+		queue.signal()
+	}()
+}
+
+func (queue *AppendQueue) send(streams []string, batches []*queuedBatch) {
+	ctx := context.Background()
+	results := make([]batchAppendResult, len(streams))
+
+	handle, err := queue.client.grpcClient.getConnectionHandle()
+	switch {
+	case err != nil:
+		for i := range results {
+			results[i].err = err
+		}
+	case handle.SupportsFeature(FEATURE_BATCH_APPEND):
+		requests := make([]batchAppendRequest, len(streams))
+		for i, streamID := range streams {
+			requests[i] = batchAppendRequest{streamID: streamID, expectedRevision: Any{}, events: batches[i].events}
+		}
+
+		opts := AppendToStreamsOptions{Authenticated: queue.options.Authenticated, Deadline: queue.options.Deadline}
+		sent, err := queue.client.batchAppend(ctx, handle, &opts, queue.options.MaxBatchSize, *queue.options.BatchDeadline, requests)
+		if err != nil {
+			for i := range results {
+				results[i].err = err
+			}
+		} else {
+			results = sent
+		}
+	default:
+		for i, streamID := range streams {
+			results[i].result, results[i].err = queue.client.AppendToStream(ctx, streamID, AppendToStreamOptions{
+				Authenticated: queue.options.Authenticated,
+				Deadline:      queue.options.Deadline,
+			}, batches[i].events...)
+		}
+	}
+
+	for i, streamID := range streams {
+		for _, request := range batches[i].requests {
+			queue.deliver(streamID, request, results[i])
+		}
+	}
+}
+
+func (queue *AppendQueue) deliver(streamID string, request queuedRequest, result batchAppendResult) {
+	defer queue.outstanding.Done()
+
+	if request.callback != nil {
+		request.callback(result.result, result.err)
+	}
+
+	if queue.options.Results != nil {
+		queue.options.Results <- AppendQueueResult{
+			StreamID:    streamID,
+			Events:      request.events,
+			WriteResult: result.result,
+			Err:         result.err,
+		}
+	}
+}
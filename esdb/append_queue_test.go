@@ -0,0 +1,38 @@
+package esdb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppendQueueOptionsDefaults(t *testing.T) {
+	opts := AppendQueueOptions{}
+	opts.setDefaults()
+	assert.Equal(t, 100*time.Millisecond, opts.FlushInterval)
+	assert.Equal(t, 500, opts.MaxBatchSize)
+	assert.Equal(t, 4, opts.MaxInFlight)
+	assert.NoError(t, opts.validate())
+
+	opts.MaxInFlight = -1
+	assertInvalidArgument(t, opts.validate())
+}
+
+func TestAppendQueueRejectsEventsOnceDrained(t *testing.T) {
+	queue, err := NewAppendQueue(nil, AppendQueueOptions{})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	require.NoError(t, queue.Drain(ctx))
+	require.NoError(t, queue.Drain(ctx))
+	err = queue.Enqueue("stream", nil, EventData{})
+	assert.ErrorIs(t, err, ErrAppendQueueDrained)
+	var esdbErr *Error
+	require.ErrorAs(t, err, &esdbErr)
+	assert.Equal(t, ErrorAppendQueueDrained, esdbErr.Code())
+}
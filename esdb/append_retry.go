@@ -0,0 +1,150 @@
+package esdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	api "github.com/EventStore/EventStore-Client-Go/v2/protos/streams"
+	"github.com/gofrs/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// appendToStreamWithRetry retries the append according to the policy, which may be nil when only DeduplicateOnRetry
+// is set. In that case, a single retry is made. Every attempt sends the same proposed messages, so event ids generated
+// for events without one stay the same.
+func (client *Client) appendToStreamWithRetry(
+	ctx context.Context,
+	streamID string,
+	opts *AppendToStreamOptions,
+	policy *RetryPolicy,
+	messages []*api.AppendReq_ProposedMessage,
+) (*WriteResult, error) {
+	attempts := 2
+	if policy != nil {
+		attempts = policy.MaxAttempts
+	}
+
+	for attempt := 1; ; attempt++ {
+		result, err := client.appendToStream(ctx, streamID, opts, messages)
+		if err == nil || attempt >= attempts || ctx.Err() != nil {
+			return result, err
+		}
+
+		retryable := policy != nil && policy.retryable(err)
+		if opts.DeduplicateOnRetry && isAmbiguousAppendError(err) {
+			retryable = true
+		}
+
+		if !retryable {
+			return nil, err
+		}
+
+		client.grpcClient.logger.warn("append to stream '%s' failed on attempt %d, retrying: %v", streamID, attempt, err)
+		if policy != nil {
+			if waitErr := policy.wait(ctx, attempt); waitErr != nil {
+				return nil, err
+			}
+		}
+
+		if !opts.DeduplicateOnRetry {
+			continue
+		}
+
+		committed, err := client.committedEvents(ctx, streamID, opts, messages)
+		if err != nil {
+			return nil, fmt.Errorf("could not deduplicate events of stream '%s' before retrying: %w", streamID, err)
+		}
+
+		remaining := make([]*api.AppendReq_ProposedMessage, 0, len(messages))
+		var last *RecordedEvent
+		for _, message := range messages {
+			if recorded, ok := committed[fromProtoUUID(message.Id)]; ok {
+				if last == nil || recorded.EventNumber > last.EventNumber {
+					last = recorded
+				}
+
+				continue
+			}
+
+			remaining = append(remaining, message)
+		}
+
+		if len(remaining) == 0 {
+			position := last.Position
+			return newWriteResult(Revision(last.EventNumber), &position), nil
+		}
+
+		messages = remaining
+	}
+}
+
+// committedEvents looks for the given events within the last DeduplicationWindow events of the stream.
+func (client *Client) committedEvents(
+	ctx context.Context,
+	streamID string,
+	opts *AppendToStreamOptions,
+	messages []*api.AppendReq_ProposedMessage,
+) (map[uuid.UUID]*RecordedEvent, error) {
+	wanted := make(map[uuid.UUID]struct{}, len(messages))
+	for _, message := range messages {
+		wanted[fromProtoUUID(message.Id)] = struct{}{}
+	}
+
+	committed := make(map[uuid.UUID]*RecordedEvent)
+	stream, err := client.ReadStream(ctx, streamID, ReadStreamOptions{
+		Direction:     Backwards,
+		From:          End{},
+		Authenticated: opts.Authenticated,
+		Deadline:      opts.Deadline,
+	}, opts.DeduplicationWindow)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer stream.Close()
+	for len(committed) < len(wanted) {
+		event, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			var esdbErr *Error
+			if errors.As(err, &esdbErr) && esdbErr.Code() == ErrorResourceNotFound {
+				break
+			}
+
+			return nil, err
+		}
+
+		if _, ok := wanted[event.Event.EventID]; ok {
+			committed[event.Event.EventID] = event.Event
+		}
+	}
+
+	return committed, nil
+}
+
+// isAmbiguousAppendError reports whether an append may have been committed even though it failed. Errors the client
+// couldn't map to an error code, such as a lost connection reported as UNAVAILABLE, are ambiguous.
+func isAmbiguousAppendError(err error) bool {
+	if status.Code(err) == codes.Unavailable {
+		return true
+	}
+
+	var esdbErr *Error
+	if !errors.As(err, &esdbErr) {
+		return true
+	}
+
+	switch esdbErr.Code() {
+	case ErrorDeadlineExceeded, ErrorConnectionClosed, ErrorNotLeader, ErrorUnknown:
+		return true
+	}
+
+	return false
+}
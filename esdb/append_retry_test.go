@@ -0,0 +1,30 @@
+package esdb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestIsAmbiguousAppendError(t *testing.T) {
+	assert.True(t, isAmbiguousAppendError(&Error{code: ErrorDeadlineExceeded}))
+	assert.True(t, isAmbiguousAppendError(fmt.Errorf("could not send append request. Reason: %w", &Error{code: ErrorNotLeader})))
+	assert.False(t, isAmbiguousAppendError(&Error{code: ErrorWrongExpectedVersion}))
+	assert.False(t, isAmbiguousAppendError(&Error{code: ErrorAccessDenied}))
+	assert.True(t, isAmbiguousAppendError(fmt.Errorf("connection lost")))
+	assert.False(t, isAmbiguousAppendError(invalidArgumentError("too large")))
+}
+
+func TestLostConnectionDuringAppendIsAmbiguous(t *testing.T) {
+	client := &grpcClient{channel: make(chan msg, 1), logger: &logger{}}
+
+	// The same handling and wrapping as a failed send in appendToStream.
+	err := client.handleError(&connectionHandle{}, metadata.MD{}, metadata.MD{}, status.Error(codes.Unavailable, "connection lost"))
+	err = fmt.Errorf("could not send append request. Reason: %w", err)
+
+	assert.True(t, isAmbiguousAppendError(err))
+}
@@ -0,0 +1,55 @@
+package esdb
+
+import (
+	api "github.com/EventStore/EventStore-Client-Go/v2/protos/streams"
+	"google.golang.org/protobuf/proto"
+)
+
+// appendSender walks the proposed messages of an append, reusing the same request wrapper for every Send. gRPC
+// encodes a message before Send returns and already coalesces the writes of a stream, so reusing the wrapper is safe
+// and the sender doesn't need its own buffering. The Append RPC takes one message per event, whose gRPC overhead bounds
+// the throughput; BatchAppendToStream sends many events per message and is about twice as fast on large appends.
+type appendSender struct {
+	messages []*api.AppendReq_ProposedMessage
+	index    int
+	content  api.AppendReq_ProposedMessage_
+	request  *api.AppendReq
+}
+
+func newAppendSender(messages []*api.AppendReq_ProposedMessage) *appendSender {
+	sender := &appendSender{messages: messages, index: -1}
+	sender.request = &api.AppendReq{Content: &sender.content}
+	return sender
+}
+
+// next points the request at the next proposed message, returning false once every message was sent.
+func (sender *appendSender) next() bool {
+	sender.index++
+	if sender.index >= len(sender.messages) {
+		return false
+	}
+
+	sender.content.ProposedMessage = sender.messages[sender.index]
+	return true
+}
+
+// proposedMessageSize returns the encoded size of a proposed message wrapped in an append request. Computing the
+// exact size is as expensive as encoding the message, so it is only done when a cheap upper bound exceeds the limit.
+func proposedMessageSize(message *api.AppendReq_ProposedMessage, limit int) int {
+	// Every length-delimited field costs at most a tag byte and a 5 bytes length, and the id at most 48 bytes.
+	const fieldOverhead = 6
+	bound := 2*fieldOverhead + 48
+	bound += len(message.Data) + fieldOverhead
+	bound += len(message.CustomMetadata) + fieldOverhead
+	for key, value := range message.Metadata {
+		bound += len(key) + len(value) + 3*fieldOverhead
+	}
+
+	if bound <= limit {
+		return bound
+	}
+
+	return proto.Size(&api.AppendReq{
+		Content: &api.AppendReq_ProposedMessage_{ProposedMessage: message},
+	})
+}
@@ -0,0 +1,104 @@
+package esdb
+
+import (
+	"context"
+)
+
+// StreamAppend describes the events appended to a single stream by AppendToStreams.
+type StreamAppend struct {
+	StreamID         string
+	ExpectedRevision ExpectedRevision
+	Events           []EventData
+}
+
+// StreamAppendResult is the outcome of a StreamAppend. Exactly one of WriteResult and Err is set.
+type StreamAppendResult struct {
+	StreamID    string
+	WriteResult *WriteResult
+	Err         error
+}
+
+// AppendToStreamsResult holds the per-stream results of AppendToStreams, in the order of the appends.
+type AppendToStreamsResult struct {
+	Results []StreamAppendResult
+	// Atomic is true when the server guaranteed that either every append was written or none was. EventStoreDB
+	// currently commits each stream of a BatchAppend session independently, so partial failures are possible when it
+	// is false.
+	Atomic bool
+}
+
+// Failed returns the results of the appends that were rejected.
+func (r *AppendToStreamsResult) Failed() []StreamAppendResult {
+	var failed []StreamAppendResult
+	for _, result := range r.Results {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+
+	return failed
+}
+
+// AppendToStreams appends to several streams over a single BatchAppend session. Each stream append is correlated
+// separately and gets its own result; the returned error is only set when the session itself failed.
+func (client *Client) AppendToStreams(
+	context context.Context,
+	appends []StreamAppend,
+	opts AppendToStreamsOptions,
+) (*AppendToStreamsResult, error) {
+	opts.setDefaults()
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	requests := make([]batchAppendRequest, 0, len(appends))
+	for _, streamAppend := range appends {
+		if streamAppend.StreamID == "" {
+			return nil, invalidArgumentError("StreamID is required")
+		}
+
+		expectedRevision := streamAppend.ExpectedRevision
+		if expectedRevision == nil {
+			expectedRevision = Any{}
+		}
+
+		requests = append(requests, batchAppendRequest{
+			streamID:         streamAppend.StreamID,
+			expectedRevision: expectedRevision,
+			events:           streamAppend.Events,
+		})
+	}
+
+	if len(requests) == 0 {
+		return &AppendToStreamsResult{Atomic: true}, nil
+	}
+
+	handle, err := client.grpcClient.getConnectionHandle()
+	if err != nil {
+		return nil, err
+	}
+
+	if !handle.SupportsFeature(FEATURE_BATCH_APPEND) {
+		return nil, unsupportedFeatureError()
+	}
+
+	results, err := client.batchAppend(context, handle, &opts, opts.BatchSize, *opts.BatchDeadline, requests)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &AppendToStreamsResult{
+		Results: make([]StreamAppendResult, len(results)),
+		Atomic:  len(results) == 1,
+	}
+
+	for i, result := range results {
+		output.Results[i] = StreamAppendResult{
+			StreamID:    requests[i].streamID,
+			WriteResult: result.result,
+			Err:         result.err,
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,50 @@
+package esdb
+
+import (
+	"time"
+)
+
+// AppendToStreamsOptions configures AppendToStreams.
+type AppendToStreamsOptions struct {
+	// Maximum number of events sent in a single BatchAppend request message. Defaults to 50.
+	BatchSize int
+	// Server-side deadline of every stream append. Defaults to 10 seconds.
+	BatchDeadline *time.Duration
+	Authenticated *Credentials
+	Deadline      *time.Duration
+}
+
+func (o *AppendToStreamsOptions) kind() operationKind {
+	return RegularOperation
+}
+
+func (o *AppendToStreamsOptions) credentials() *Credentials {
+	return o.Authenticated
+}
+
+func (o *AppendToStreamsOptions) deadline() *time.Duration {
+	return o.Deadline
+}
+
+func (o *AppendToStreamsOptions) setDefaults() {
+	if o.BatchSize == 0 {
+		o.BatchSize = 50
+	}
+
+	if o.BatchDeadline == nil {
+		deadline := 10 * time.Second
+		o.BatchDeadline = &deadline
+	}
+}
+
+func (o *AppendToStreamsOptions) validate() error {
+	if o.BatchSize < 0 {
+		return invalidArgumentError("BatchSize must be positive, got %d", o.BatchSize)
+	}
+
+	if *o.BatchDeadline <= 0 {
+		return invalidArgumentError("BatchDeadline must be positive, got %v", *o.BatchDeadline)
+	}
+
+	return nil
+}
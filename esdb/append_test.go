@@ -2,6 +2,7 @@ package esdb_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"testing"
@@ -10,6 +11,7 @@ import (
 	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
 	uuid "github.com/gofrs/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func createTestEvent() esdb.EventData {
@@ -51,6 +53,17 @@ func AppendTests(t *testing.T, emptyDB *Container, emptyDBClient *esdb.Client) {
 		t.Run("appendWithInvalidStreamRevision", appendWithInvalidStreamRevision(emptyDBClient))
 		t.Run("appendToSystemStreamWithIncorrectCredentials", appendToSystemStreamWithIncorrectCredentials(emptyDB))
 		t.Run("metadataOperation", metadataOperation(emptyDBClient))
+		t.Run("truncateStream", truncateStream(emptyDBClient))
+		t.Run("updateStreamMetadata", updateStreamMetadata(emptyDBClient))
+		t.Run("returnResponseMetadata", returnResponseMetadata(emptyDBClient))
+		t.Run("batchAppendToStream", batchAppendToStream(emptyDBClient))
+		t.Run("appendToStreams", appendToStreams(emptyDBClient))
+		t.Run("appendEventLargerThanMaxPayload", appendEventLargerThanMaxPayload(emptyDBClient))
+		t.Run("appendQueue", appendQueue(emptyDBClient))
+		t.Run("conditionalAppendToStream", conditionalAppendToStream(emptyDBClient))
+		t.Run("appendRawToStream", appendRawToStream(emptyDBClient))
+		t.Run("appendWithEnsureMetadata", appendWithEnsureMetadata(emptyDBClient))
+		t.Run("appendTypedValues", appendTypedValues(emptyDBClient))
 	})
 }
 
@@ -110,6 +123,11 @@ func appendWithInvalidStreamRevision(db *esdb.Client) TestCall {
 		esdbErr, ok := esdb.FromError(err)
 		assert.False(t, ok)
 		assert.Equal(t, esdbErr.Code(), esdb.ErrorWrongExpectedVersion)
+
+		var wrongErr *esdb.WrongExpectedVersionError
+		require.True(t, errors.As(err, &wrongErr))
+		assert.Equal(t, esdb.StreamExists{}, wrongErr.Expected())
+		assert.Equal(t, esdb.NoStream{}, wrongErr.Actual())
 	}
 }
 
@@ -180,3 +198,328 @@ func metadataOperation(db *esdb.Client) TestCall {
 		assert.Equal(t, meta, *metaActual, "matching metadata")
 	}
 }
+
+func truncateStream(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		streamID := NAME_GENERATOR.Generate()
+		context, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+		defer cancel()
+
+		_, err := db.AppendToStream(context, streamID, esdb.AppendToStreamOptions{}, createTestEvent(), createTestEvent(), createTestEvent())
+		assert.Nil(t, err, "error when writing events")
+
+		meta := esdb.StreamMetadata{}
+		meta.AddCustomProperty("foo", "bar")
+
+		_, err = db.SetStreamMetadata(context, streamID, esdb.AppendToStreamOptions{}, meta)
+		assert.Nil(t, err, "error when writing stream metadata")
+
+		result, err := db.TruncateStream(context, streamID, 2, esdb.TruncateStreamOptions{})
+		assert.Nil(t, err, "error when truncating stream")
+		assert.Equal(t, esdb.StreamRevision{Value: 1}, result.NextExpectedRevision, "metadata revision")
+
+		metaActual, err := db.GetStreamMetadata(context, streamID, esdb.ReadStreamOptions{Direction: esdb.Backwards, From: esdb.End{}})
+		assert.Nil(t, err, "error when reading stream metadata")
+
+		meta.SetTruncateBefore(2)
+		assert.Equal(t, meta, *metaActual, "matching metadata")
+	}
+}
+
+func updateStreamMetadata(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		streamID := NAME_GENERATOR.Generate()
+		context, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+		defer cancel()
+
+		meta := esdb.StreamMetadata{}
+		meta.SetMaxCount(42)
+
+		_, err := db.SetStreamMetadata(context, streamID, esdb.AppendToStreamOptions{}, meta)
+		assert.Nil(t, err, "error when writing stream metadata")
+
+		_, err = db.UpdateStreamMetadata(context, streamID, esdb.UpdateStreamMetadataOptions{}, func(current esdb.StreamMetadata) (esdb.StreamMetadata, error) {
+			current.SetMaxAge(time.Minute)
+			return current, nil
+		})
+		assert.Nil(t, err, "error when updating stream metadata")
+
+		abort := errors.New("abort")
+		_, err = db.UpdateStreamMetadata(context, streamID, esdb.UpdateStreamMetadataOptions{}, func(current esdb.StreamMetadata) (esdb.StreamMetadata, error) {
+			return current, abort
+		})
+		assert.ErrorIs(t, err, abort)
+
+		metaActual, err := db.GetStreamMetadata(context, streamID, esdb.ReadStreamOptions{Direction: esdb.Backwards, From: esdb.End{}})
+		assert.Nil(t, err, "error when reading stream metadata")
+
+		meta.SetMaxAge(time.Minute)
+		assert.Equal(t, meta, *metaActual, "matching metadata")
+	}
+}
+
+func returnResponseMetadata(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		streamID := NAME_GENERATOR.Generate()
+		context, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+		defer cancel()
+
+		result, err := db.AppendToStream(context, streamID, esdb.AppendToStreamOptions{}, createTestEvent())
+		require.NoError(t, err)
+		assert.Nil(t, result.ResponseMetadata)
+
+		result, err = db.AppendToStream(context, streamID, esdb.AppendToStreamOptions{ReturnResponseMetadata: true}, createTestEvent())
+		require.NoError(t, err)
+		require.NotNil(t, result.ResponseMetadata)
+		assert.NotEmpty(t, result.ResponseMetadata.Get("content-type"))
+
+		stream, err := db.ReadStream(context, streamID, esdb.ReadStreamOptions{}, 10)
+		require.NoError(t, err)
+		defer stream.Close()
+
+		assert.Empty(t, stream.ResponseMetadata().Headers)
+		_, err = collectStreamEvents(stream)
+		require.NoError(t, err)
+		assert.NotEmpty(t, stream.ResponseMetadata().Get("content-type"))
+
+		deleteResult, err := db.DeleteStream(context, streamID, esdb.DeleteStreamOptions{ReturnResponseMetadata: true})
+		require.NoError(t, err)
+		assert.NotNil(t, deleteResult.ResponseMetadata)
+	}
+}
+
+func batchAppendToStream(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		streamID := NAME_GENERATOR.Generate()
+		context, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+		defer cancel()
+
+		events := make([]esdb.EventData, 0, 7)
+		for i := 0; i < 7; i++ {
+			events = append(events, createTestEvent())
+		}
+
+		opts := esdb.BatchAppendToStreamOptions{
+			ExpectedRevision: esdb.NoStream{},
+			BatchSize:        3,
+			FallbackToAppend: true,
+		}
+
+		result, err := db.BatchAppendToStream(context, streamID, opts, events...)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(6), result.NextExpectedVersion)
+
+		_, err = db.BatchAppendToStream(context, streamID, opts, createTestEvent())
+		esdbErr, ok := esdb.FromError(err)
+		assert.False(t, ok)
+		assert.Equal(t, esdb.ErrorWrongExpectedVersion, esdbErr.Code())
+
+		stream, err := db.ReadStream(context, streamID, esdb.ReadStreamOptions{}, 100)
+		require.NoError(t, err)
+		defer stream.Close()
+
+		read, err := collectStreamEvents(stream)
+		require.NoError(t, err)
+		assert.Len(t, read, 7)
+	}
+}
+
+func appendToStreams(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		first := NAME_GENERATOR.Generate()
+		second := NAME_GENERATOR.Generate()
+		context, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+		defer cancel()
+
+		result, err := db.AppendToStreams(context, []esdb.StreamAppend{
+			{StreamID: first, ExpectedRevision: esdb.NoStream{}, Events: []esdb.EventData{createTestEvent(), createTestEvent()}},
+			{StreamID: second, ExpectedRevision: esdb.StreamExists{}, Events: []esdb.EventData{createTestEvent()}},
+		}, esdb.AppendToStreamsOptions{})
+		require.NoError(t, err)
+		require.Len(t, result.Results, 2)
+		assert.False(t, result.Atomic)
+
+		assert.Equal(t, first, result.Results[0].StreamID)
+		require.NoError(t, result.Results[0].Err)
+		assert.Equal(t, uint64(1), result.Results[0].WriteResult.NextExpectedVersion)
+
+		failed := result.Failed()
+		require.Len(t, failed, 1)
+		assert.Equal(t, second, failed[0].StreamID)
+		esdbErr, _ := esdb.FromError(failed[0].Err)
+		assert.Equal(t, esdb.ErrorWrongExpectedVersion, esdbErr.Code())
+	}
+}
+
+func appendEventLargerThanMaxPayload(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		streamID := NAME_GENERATOR.Generate()
+		context, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+		defer cancel()
+
+		event := createTestEvent()
+		event.Data = make([]byte, 2048)
+
+		_, err := db.AppendToStream(context, streamID, esdb.AppendToStreamOptions{MaxAppendPayloadBytes: 1024}, createTestEvent(), event)
+		esdbErr, ok := esdb.FromError(err)
+		assert.False(t, ok)
+		assert.Equal(t, esdb.ErrorInvalidArgument, esdbErr.Code())
+
+		_, err = db.AppendToStream(context, streamID, esdb.AppendToStreamOptions{MaxAppendPayloadBytes: 4096}, createTestEvent(), event)
+		require.NoError(t, err)
+	}
+}
+
+func appendQueue(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		streams := []string{NAME_GENERATOR.Generate(), NAME_GENERATOR.Generate()}
+		results := make(chan esdb.AppendQueueResult, 10)
+
+		queue, err := esdb.NewAppendQueue(db, esdb.AppendQueueOptions{MaxBatchSize: 3, Results: results})
+		require.NoError(t, err)
+
+		for i := 0; i < 5; i++ {
+			require.NoError(t, queue.Enqueue(streams[i%2], nil, createTestEvent()))
+		}
+
+		context, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+		defer cancel()
+
+		require.NoError(t, queue.Drain(context))
+		close(results)
+
+		count := 0
+		for result := range results {
+			require.NoError(t, result.Err)
+			count++
+		}
+		assert.Equal(t, 5, count)
+
+		stream, err := db.ReadStream(context, streams[0], esdb.ReadStreamOptions{}, 10)
+		require.NoError(t, err)
+		defer stream.Close()
+
+		events, err := collectStreamEvents(stream)
+		require.NoError(t, err)
+		assert.Len(t, events, 3)
+	}
+}
+
+func conditionalAppendToStream(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		streamID := NAME_GENERATOR.Generate()
+		context, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+		defer cancel()
+
+		opts := esdb.AppendToStreamOptions{ExpectedRevision: esdb.NoStream{}}
+		result, err := db.ConditionalAppendToStream(context, streamID, opts, createTestEvent(), createTestEvent())
+		require.NoError(t, err)
+		assert.Equal(t, esdb.ConditionalWriteSucceeded, result.Status)
+		assert.Equal(t, esdb.Revision(1), result.CurrentRevision)
+
+		result, err = db.ConditionalAppendToStream(context, streamID, opts, createTestEvent())
+		require.NoError(t, err)
+		assert.Equal(t, esdb.ConditionalWriteVersionMismatch, result.Status)
+		assert.Equal(t, esdb.Revision(1), result.CurrentRevision)
+
+		_, err = db.TombstoneStream(context, streamID, esdb.TombstoneStreamOptions{})
+		require.NoError(t, err)
+
+		result, err = db.ConditionalAppendToStream(context, streamID, esdb.AppendToStreamOptions{}, createTestEvent())
+		require.NoError(t, err)
+		assert.Equal(t, esdb.ConditionalWriteStreamDeleted, result.Status)
+	}
+}
+
+func appendRawToStream(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		source := NAME_GENERATOR.Generate()
+		destination := NAME_GENERATOR.Generate()
+		context, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+		defer cancel()
+
+		_, err := db.AppendToStream(context, source, esdb.AppendToStreamOptions{}, createTestEvent())
+		require.NoError(t, err)
+
+		stream, err := db.ReadStream(context, source, esdb.ReadStreamOptions{}, 1)
+		require.NoError(t, err)
+		original, err := stream.Recv()
+		stream.Close()
+		require.NoError(t, err)
+
+		_, err = db.AppendRawToStream(context, destination, esdb.AppendToStreamOptions{ExpectedRevision: esdb.NoStream{}}, esdb.RawEventDataFromRecordedEvent(original.Event))
+		require.NoError(t, err)
+
+		stream, err = db.ReadStream(context, destination, esdb.ReadStreamOptions{}, 1)
+		require.NoError(t, err)
+		copied, err := stream.Recv()
+		stream.Close()
+		require.NoError(t, err)
+
+		assert.Equal(t, original.Event.EventID, copied.Event.EventID)
+		assert.Equal(t, original.Event.EventType, copied.Event.EventType)
+		assert.Equal(t, original.Event.Data, copied.Event.Data)
+	}
+}
+
+func appendWithEnsureMetadata(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		context, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+		defer cancel()
+
+		metadata := esdb.StreamMetadata{}
+		metadata.SetMaxCount(10)
+
+		for _, expectedRevision := range []esdb.ExpectedRevision{esdb.NoStream{}, esdb.Any{}} {
+			streamID := NAME_GENERATOR.Generate()
+			opts := esdb.AppendToStreamOptions{ExpectedRevision: expectedRevision, EnsureMetadata: &metadata}
+
+			_, err := db.AppendToStream(context, streamID, opts, createTestEvent(), createTestEvent())
+			require.NoError(t, err)
+
+			stored, err := db.GetStreamMetadata(context, streamID, esdb.ReadStreamOptions{})
+			require.NoError(t, err)
+			require.NotNil(t, stored.MaxCount())
+			assert.Equal(t, uint64(10), *stored.MaxCount())
+
+			_, err = db.AppendToStream(context, streamID, esdb.AppendToStreamOptions{EnsureMetadata: &metadata}, createTestEvent())
+			require.NoError(t, err)
+		}
+
+		streamID := NAME_GENERATOR.Generate()
+		_, err := db.AppendToStream(context, streamID, esdb.AppendToStreamOptions{}, createTestEvent())
+		require.NoError(t, err)
+
+		opts := esdb.AppendToStreamOptions{ExpectedRevision: esdb.NoStream{}, EnsureMetadata: &metadata}
+		_, err = db.AppendToStream(context, streamID, opts, createTestEvent())
+		require.Error(t, err)
+
+		_, err = db.GetStreamMetadata(context, streamID, esdb.ReadStreamOptions{})
+		require.Error(t, err, "a failed append must not write the metadata")
+	}
+}
+
+type AccountOpened struct {
+	Owner string `json:"owner"`
+}
+
+func appendTypedValues(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		streamID := NAME_GENERATOR.Generate()
+		context, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+		defer cancel()
+
+		_, err := esdb.Append(context, db, streamID, esdb.TypedAppendOptions{}, AccountOpened{Owner: "alice"})
+		require.NoError(t, err)
+
+		stream, err := db.ReadStream(context, streamID, esdb.ReadStreamOptions{}, 1)
+		require.NoError(t, err)
+		defer stream.Close()
+
+		event, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, "AccountOpened", event.Event.EventType)
+		assert.Equal(t, "application/json", event.Event.ContentType)
+		assert.JSONEq(t, `{"owner":"alice"}`, string(event.Event.Data))
+	}
+}
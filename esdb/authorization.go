@@ -0,0 +1,264 @@
+package esdb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+)
+
+const settingsStreamName = "$settings"
+
+// Identity describes the user the client acts as.
+type Identity struct {
+	Login    string
+	FullName string
+	Groups   []string
+	Disabled bool
+}
+
+// Anonymous tells if the identity carries no credentials.
+func (i *Identity) Anonymous() bool {
+	return i.Login == ""
+}
+
+// roles returns the roles ACLs are matched against: the login, the groups and $all.
+func (i *Identity) roles() []string {
+	roles := []string{RoleAll}
+
+	if i.Anonymous() {
+		return roles
+	}
+
+	return append(append(roles, i.Login), i.Groups...)
+}
+
+func (i *Identity) isAdmin() bool {
+	for _, group := range i.Groups {
+		if group == RoleAdmins {
+			return true
+		}
+	}
+
+	return false
+}
+
+type userDetailsHttpJson struct {
+	Data struct {
+		LoginName string   `json:"loginName"`
+		FullName  string   `json:"fullName"`
+		Groups    []string `json:"groups"`
+		Disabled  bool     `json:"disabled"`
+	} `json:"data"`
+}
+
+// WhoAmI returns the user the given credentials, or the connection string ones, belong to, along with their groups.
+// Without credentials, it returns an anonymous identity.
+func (client *Client) WhoAmI(ctx context.Context, opts WhoAmIOptions) (*Identity, error) {
+	creds := opts.Authenticated
+	if creds == nil && client.Config.Username != "" {
+		creds = &Credentials{Login: client.Config.Username, Password: client.Config.Password}
+	}
+
+	if creds == nil {
+		return &Identity{}, nil
+	}
+
+	body, err := client.httpExecute("GET", fmt.Sprintf("/users/%s", url.PathEscape(creds.Login)), creds, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var details userDetailsHttpJson
+	if err := json.Unmarshal(body, &details); err != nil {
+		return nil, &Error{code: ErrorParsing, err: fmt.Errorf("error when parsing JSON payload: %w", err)}
+	}
+
+	return &Identity{
+		Login:    details.Data.LoginName,
+		FullName: details.Data.FullName,
+		Groups:   details.Data.Groups,
+		Disabled: details.Data.Disabled,
+	}, nil
+}
+
+type aclOperation int
+
+const (
+	aclRead aclOperation = iota
+	aclWrite
+	aclDelete
+	aclMetaRead
+	aclMetaWrite
+)
+
+func (a *Acl) rolesFor(operation aclOperation) []string {
+	switch operation {
+	case aclRead:
+		return a.readRoles
+	case aclWrite:
+		return a.writeRoles
+	case aclDelete:
+		return a.deleteRoles
+	case aclMetaRead:
+		return a.metaReadRoles
+	default:
+		return a.metaWriteRoles
+	}
+}
+
+// defaultAcls holds the ACLs applying to streams without their own, as set in the $settings stream.
+type defaultAcls struct {
+	user   Acl
+	system Acl
+}
+
+func builtinDefaultAcls() defaultAcls {
+	all := []string{RoleAll}
+	admins := []string{RoleAdmins}
+
+	return defaultAcls{
+		user:   Acl{readRoles: all, writeRoles: all, deleteRoles: all, metaReadRoles: all, metaWriteRoles: all},
+		system: Acl{readRoles: admins, writeRoles: admins, deleteRoles: admins, metaReadRoles: admins, metaWriteRoles: admins},
+	}
+}
+
+// CanRead tells if the identity can read the stream, according to the stream ACL and the default ACLs. Nothing gets
+// read from the stream itself. Checking a metadata stream checks the metadata read permission of its stream.
+func (client *Client) CanRead(ctx context.Context, streamID string, opts AuthorizationCheckOptions) (bool, error) {
+	if IsMetadataStream(streamID) {
+		return client.isAuthorized(ctx, streamID[2:], aclMetaRead, opts)
+	}
+
+	return client.isAuthorized(ctx, streamID, aclRead, opts)
+}
+
+// CanWrite tells if the identity can append to the stream, according to the stream ACL and the default ACLs. Nothing
+// gets written. Checking a metadata stream checks the metadata write permission of its stream.
+func (client *Client) CanWrite(ctx context.Context, streamID string, opts AuthorizationCheckOptions) (bool, error) {
+	if IsMetadataStream(streamID) {
+		return client.isAuthorized(ctx, streamID[2:], aclMetaWrite, opts)
+	}
+
+	return client.isAuthorized(ctx, streamID, aclWrite, opts)
+}
+
+func (client *Client) isAuthorized(ctx context.Context, streamID string, operation aclOperation, opts AuthorizationCheckOptions) (bool, error) {
+	identity, err := client.WhoAmI(ctx, WhoAmIOptions{Authenticated: opts.Authenticated})
+	if err != nil {
+		return false, fmt.Errorf("failed to resolve the current identity: %w", err)
+	}
+
+	if identity.Disabled {
+		return false, nil
+	}
+
+	if identity.isAdmin() {
+		return true, nil
+	}
+
+	meta, _, err := client.getStreamMetadataOrEmpty(ctx, streamID, ReadStreamOptions{
+		Direction:     Backwards,
+		From:          End{},
+		Authenticated: opts.Authenticated,
+		Deadline:      opts.Deadline,
+	})
+
+	// Without the permission to read the metadata, there is no way to know the stream ACL.
+	if err != nil {
+		return false, err
+	}
+
+	defaults, err := client.readDefaultAcls(ctx, opts)
+	if err != nil {
+		return false, err
+	}
+
+	return authorize(identity, streamID, meta, defaults, operation), nil
+}
+
+func authorize(identity *Identity, streamID string, meta *StreamMetadata, defaults defaultAcls, operation aclOperation) bool {
+	acl := defaults.user
+	if IsSystemStream(streamID) {
+		acl = defaults.system
+	}
+
+	switch {
+	case meta.IsUserStreamAcl():
+		acl = defaults.user
+	case meta.IsSystemStreamAcl():
+		acl = defaults.system
+	case meta.StreamAcl() != nil:
+		if roles := meta.StreamAcl().rolesFor(operation); len(roles) > 0 {
+			return matchRoles(identity.roles(), roles)
+		}
+	}
+
+	return matchRoles(identity.roles(), acl.rolesFor(operation))
+}
+
+// readDefaultAcls reads the default ACLs from the $settings stream, falling back to the server built-in defaults when
+// the stream is empty or can't be read.
+func (client *Client) readDefaultAcls(ctx context.Context, opts AuthorizationCheckOptions) (defaultAcls, error) {
+	acls := builtinDefaultAcls()
+
+	stream, err := client.ReadStream(ctx, settingsStreamName, ReadStreamOptions{
+		Direction:     Backwards,
+		From:          End{},
+		Authenticated: opts.Authenticated,
+		Deadline:      opts.Deadline,
+	}, 1)
+
+	if err != nil {
+		return acls, err
+	}
+
+	defer stream.Close()
+
+	event, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return acls, nil
+	}
+
+	var esdbErr *Error
+	if errors.As(err, &esdbErr) && (esdbErr.Code() == ErrorResourceNotFound || esdbErr.Code() == ErrorAccessDenied) {
+		return acls, nil
+	}
+
+	if err != nil {
+		return acls, fmt.Errorf("failed to read default ACLs: %w", err)
+	}
+
+	var settings map[string]map[string]interface{}
+	if err := json.Unmarshal(event.Event.Data, &settings); err != nil {
+		return acls, &Error{code: ErrorParsing, err: fmt.Errorf("invalid default ACLs: %w", err)}
+	}
+
+	if props, ok := settings[UserStreamAcl]; ok {
+		if acls.user, err = AclFromMap(props); err != nil {
+			return acls, &Error{code: ErrorParsing, err: fmt.Errorf("invalid default user stream ACL: %w", err)}
+		}
+	}
+
+	if props, ok := settings[SystemStreamAcl]; ok {
+		if acls.system, err = AclFromMap(props); err != nil {
+			return acls, &Error{code: ErrorParsing, err: fmt.Errorf("invalid default system stream ACL: %w", err)}
+		}
+	}
+
+	return acls, nil
+}
+
+func matchRoles(identityRoles []string, allowed []string) bool {
+	for _, role := range allowed {
+		for _, identityRole := range identityRoles {
+			if role == identityRole {
+				return true
+			}
+		}
+	}
+
+	return false
+}
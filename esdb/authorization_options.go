@@ -0,0 +1,13 @@
+package esdb
+
+import "time"
+
+type WhoAmIOptions struct {
+	Authenticated *Credentials
+}
+
+type AuthorizationCheckOptions struct {
+	// Identity whose permissions are checked. Defaults to the connection string credentials.
+	Authenticated *Credentials
+	Deadline      *time.Duration
+}
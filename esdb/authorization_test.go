@@ -0,0 +1,38 @@
+package esdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthorize(t *testing.T) {
+	defaults := builtinDefaultAcls()
+	anonymous := &Identity{}
+	ops := &Identity{Login: "ouro", Groups: []string{RoleOperations}}
+
+	assert.True(t, authorize(anonymous, "orders", &StreamMetadata{}, defaults, aclRead))
+	assert.False(t, authorize(anonymous, "$settings", &StreamMetadata{}, defaults, aclRead))
+
+	restricted := &StreamMetadata{}
+	acl := Acl{}
+	acl.AddReadRoles(RoleOperations)
+	restricted.SetAcl(acl)
+
+	assert.False(t, authorize(anonymous, "orders", restricted, defaults, aclRead))
+	assert.True(t, authorize(ops, "orders", restricted, defaults, aclRead))
+	// No write roles in the stream ACL, the default ACL applies.
+	assert.True(t, authorize(anonymous, "orders", restricted, defaults, aclWrite))
+
+	byLogin := &StreamMetadata{}
+	acl = Acl{}
+	acl.AddWriteRoles("ouro")
+	byLogin.SetAcl(acl)
+	assert.True(t, authorize(ops, "orders", byLogin, defaults, aclWrite))
+	assert.False(t, authorize(&Identity{Login: "other"}, "orders", byLogin, defaults, aclWrite))
+
+	system := &StreamMetadata{}
+	system.SetAcl(SystemStreamAcl)
+	assert.False(t, authorize(ops, "orders", system, defaults, aclRead))
+	assert.True(t, authorize(&Identity{Login: "admin", Groups: []string{RoleAdmins}}, "orders", system, defaults, aclRead))
+}
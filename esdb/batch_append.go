@@ -0,0 +1,265 @@
+package esdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/protos/shared"
+	api "github.com/EventStore/EventStore-Client-Go/v2/protos/streams"
+	"github.com/gofrs/uuid"
+	rpcStatus "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// batchAppendRequest is a single stream append sent over a BatchAppend session.
+type batchAppendRequest struct {
+	streamID         string
+	expectedRevision ExpectedRevision
+	events           []EventData
+}
+
+// batchAppendResult is the outcome of a batchAppendRequest. Exactly one of result and err is set.
+type batchAppendResult struct {
+	result *WriteResult
+	err    error
+}
+
+// BatchAppendToStream appends events to a stream using the BatchAppend RPC. Events are sent in messages of at most
+// BatchSize events, all correlated to the same batch, and are written atomically by the server once the final message
+// is received.
+func (client *Client) BatchAppendToStream(
+	context context.Context,
+	streamID string,
+	opts BatchAppendToStreamOptions,
+	events ...EventData,
+) (*WriteResult, error) {
+	opts.setDefaults()
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	handle, err := client.grpcClient.getConnectionHandle()
+	if err != nil {
+		return nil, err
+	}
+
+	if !handle.SupportsFeature(FEATURE_BATCH_APPEND) {
+		if opts.FallbackToAppend {
+			return client.AppendToStream(context, streamID, AppendToStreamOptions{
+				ExpectedRevision: opts.ExpectedRevision,
+				Authenticated:    opts.Authenticated,
+				Deadline:         opts.Deadline,
+			}, events...)
+		}
+
+		return nil, unsupportedFeatureError()
+	}
+
+	results, err := client.batchAppend(context, handle, &opts, opts.BatchSize, *opts.BatchDeadline, []batchAppendRequest{{
+		streamID:         streamID,
+		expectedRevision: opts.ExpectedRevision,
+		events:           events,
+	}})
+	if err != nil {
+		return nil, err
+	}
+
+	return results[0].result, results[0].err
+}
+
+// batchAppend sends every request over a single BatchAppend session and waits for the server to answer each of them.
+// The returned results are in the same order as the requests.
+func (client *Client) batchAppend(
+	parent context.Context,
+	handle *connectionHandle,
+	opts options,
+	batchSize int,
+	batchDeadline time.Duration,
+	requests []batchAppendRequest,
+) ([]batchAppendResult, error) {
+	for i := range requests {
+		events, err := client.interceptEvents(parent, requests[i].events)
+		if err != nil {
+			return nil, err
+		}
+
+		requests[i].events = events
+	}
+
+	streamsClient := api.NewStreamsClient(handle.Connection())
+	var headers, trailers metadata.MD
+	callOptions := []grpc.CallOption{grpc.Header(&headers), grpc.Trailer(&trailers)}
+	callOptions, ctx, cancel := configureGrpcCall(parent, client.Config, opts, callOptions)
+	defer cancel()
+
+	session, err := streamsClient.BatchAppend(ctx, callOptions...)
+	if err != nil {
+		err = client.grpcClient.handleError(handle, headers, trailers, err)
+		return nil, fmt.Errorf("could not construct batch append operation. Reason: %w", err)
+	}
+
+	deadline := timestamppb.New(time.Now().Add(batchDeadline))
+	pending := make(map[uuid.UUID]int, len(requests))
+	for i, request := range requests {
+		correlationID := uuid.Must(uuid.NewV4())
+		pending[correlationID] = i
+
+		for _, message := range toBatchAppendRequests(correlationID, request, batchSize, deadline) {
+			if err := session.Send(message); err != nil {
+				err = client.grpcClient.handleError(handle, headers, trailers, err)
+				return nil, fmt.Errorf("could not send batch append request. Reason: %w", err)
+			}
+		}
+	}
+
+	if err := session.CloseSend(); err != nil {
+		err = client.grpcClient.handleError(handle, headers, trailers, err)
+		return nil, fmt.Errorf("could not close batch append session. Reason: %w", err)
+	}
+
+	results := make([]batchAppendResult, len(requests))
+	for len(pending) > 0 {
+		response, err := session.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, &Error{code: ErrorInternalServer, err: fmt.Errorf("batch append session ended with %d unanswered requests", len(pending))}
+			}
+
+			return nil, client.grpcClient.handleError(handle, headers, trailers, err)
+		}
+
+		correlationID := fromProtoUUID(response.GetCorrelationId())
+		i, ok := pending[correlationID]
+		if !ok {
+			return nil, &Error{code: ErrorInternalServer, err: fmt.Errorf("unexpected batch append correlation id '%s'", correlationID)}
+		}
+
+		delete(pending, correlationID)
+		results[i] = batchAppendResultFromProto(response)
+	}
+
+	return results, nil
+}
+
+// toBatchAppendRequests splits the events of a request into messages of at most batchSize events. Every message
+// carries the stream options and only the last one is final.
+func toBatchAppendRequests(correlationID uuid.UUID, request batchAppendRequest, batchSize int, deadline *timestamppb.Timestamp) []*api.BatchAppendReq {
+	options := &api.BatchAppendReq_Options{
+		StreamIdentifier: &shared.StreamIdentifier{
+			StreamName: []byte(request.streamID),
+		},
+		Deadline: deadline,
+	}
+
+	switch value := request.expectedRevision.(type) {
+	case Any:
+		options.ExpectedStreamPosition = &api.BatchAppendReq_Options_Any{Any: &emptypb.Empty{}}
+	case NoStream:
+		options.ExpectedStreamPosition = &api.BatchAppendReq_Options_NoStream{NoStream: &emptypb.Empty{}}
+	case StreamExists:
+		options.ExpectedStreamPosition = &api.BatchAppendReq_Options_StreamExists{StreamExists: &emptypb.Empty{}}
+	case StreamRevision:
+		options.ExpectedStreamPosition = &api.BatchAppendReq_Options_StreamPosition{StreamPosition: value.Value}
+	}
+
+	var messages []*api.BatchAppendReq
+	events := request.events
+	for {
+		count := len(events)
+		if count > batchSize {
+			count = batchSize
+		}
+
+		proposed := make([]*api.BatchAppendReq_ProposedMessage, 0, count)
+		for _, event := range events[:count] {
+			message := toProposedMessage(event)
+			proposed = append(proposed, &api.BatchAppendReq_ProposedMessage{
+				Id:             message.Id,
+				Metadata:       message.Metadata,
+				CustomMetadata: message.CustomMetadata,
+				Data:           message.Data,
+			})
+		}
+
+		events = events[count:]
+		messages = append(messages, &api.BatchAppendReq{
+			CorrelationId:    toProtoUUID(correlationID),
+			Options:          options,
+			ProposedMessages: proposed,
+			IsFinal:          len(events) == 0,
+		})
+
+		if len(events) == 0 {
+			return messages
+		}
+	}
+}
+
+func batchAppendResultFromProto(response *api.BatchAppendResp) batchAppendResult {
+	if status := response.GetError(); status != nil {
+		return batchAppendResult{err: batchAppendErrorFromStatus(status)}
+	}
+
+	result := writeResultFromBatchAppendProto(response.GetSuccess())
+
+	return batchAppendResult{result: result}
+}
+
+// batchAppendErrorFromStatus maps a batch-level failure to an *Error, using the typed status details when the server
+// provides them.
+func batchAppendErrorFromStatus(status *rpcStatus.Status) error {
+	for _, detail := range status.GetDetails() {
+		wrong := &shared.WrongExpectedVersion{}
+		if detail.MessageIs(wrong) && detail.UnmarshalTo(wrong) == nil {
+			return wrongExpectedVersionFromProto(wrong)
+		}
+
+		if detail.MessageIs(&shared.AccessDenied{}) {
+			return &Error{code: ErrorAccessDenied, err: errors.New(status.GetMessage())}
+		}
+
+		deleted := &shared.StreamDeleted{}
+		if detail.MessageIs(deleted) && detail.UnmarshalTo(deleted) == nil {
+			return &Error{code: ErrorStreamDeleted, err: fmt.Errorf("stream '%s' is deleted", string(deleted.GetStreamIdentifier().GetStreamName()))}
+		}
+
+		if detail.MessageIs(&shared.Timeout{}) {
+			return &Error{code: ErrorDeadlineExceeded, err: errors.New(status.GetMessage())}
+		}
+
+		tooBig := &shared.MaximumAppendSizeExceeded{}
+		if detail.MessageIs(tooBig) && detail.UnmarshalTo(tooBig) == nil {
+			return &Error{code: ErrorInvalidArgument, err: fmt.Errorf("maximum append size of %d bytes exceeded", tooBig.GetMaxAppendSize())}
+		}
+
+		badRequest := &shared.BadRequest{}
+		if detail.MessageIs(badRequest) && detail.UnmarshalTo(badRequest) == nil {
+			return &Error{code: ErrorInvalidArgument, err: errors.New(badRequest.GetMessage())}
+		}
+	}
+
+	var code ErrorCode
+	switch codes.Code(status.GetCode()) {
+	case codes.PermissionDenied:
+		code = ErrorAccessDenied
+	case codes.DeadlineExceeded:
+		code = ErrorDeadlineExceeded
+	case codes.Unauthenticated:
+		code = ErrorUnauthenticated
+	case codes.InvalidArgument:
+		code = ErrorInvalidArgument
+	case codes.NotFound:
+		code = ErrorResourceNotFound
+	default:
+		code = ErrorInternalServer
+	}
+
+	return &Error{code: code, err: fmt.Errorf("batch append failed: %s", status.GetMessage())}
+}
@@ -0,0 +1,61 @@
+package esdb
+
+import (
+	"time"
+)
+
+// BatchAppendToStreamOptions configures BatchAppendToStream.
+type BatchAppendToStreamOptions struct {
+	ExpectedRevision ExpectedRevision
+	// Maximum number of events sent in a single BatchAppend request message. Larger
+	// appends are split across several messages sharing the same correlation id.
+	// Defaults to 50.
+	BatchSize int
+	// Server-side deadline of the batch. The server stops waiting for the remaining
+	// messages of the batch once it expires. Defaults to 10 seconds.
+	BatchDeadline *time.Duration
+	// Falls back to the classic Append RPC when the server doesn't support BatchAppend,
+	// instead of failing with ErrorUnsupportedFeature.
+	FallbackToAppend bool
+	Authenticated    *Credentials
+	Deadline         *time.Duration
+}
+
+func (o *BatchAppendToStreamOptions) kind() operationKind {
+	return RegularOperation
+}
+
+func (o *BatchAppendToStreamOptions) credentials() *Credentials {
+	return o.Authenticated
+}
+
+func (o *BatchAppendToStreamOptions) deadline() *time.Duration {
+	return o.Deadline
+}
+
+func (o *BatchAppendToStreamOptions) setDefaults() {
+	if o.ExpectedRevision == nil {
+		o.ExpectedRevision = Any{}
+	}
+
+	if o.BatchSize == 0 {
+		o.BatchSize = 50
+	}
+
+	if o.BatchDeadline == nil {
+		deadline := 10 * time.Second
+		o.BatchDeadline = &deadline
+	}
+}
+
+func (o *BatchAppendToStreamOptions) validate() error {
+	if o.BatchSize < 0 {
+		return invalidArgumentError("BatchSize must be positive, got %d", o.BatchSize)
+	}
+
+	if *o.BatchDeadline <= 0 {
+		return invalidArgumentError("BatchDeadline must be positive, got %v", *o.BatchDeadline)
+	}
+
+	return nil
+}
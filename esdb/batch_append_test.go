@@ -0,0 +1,71 @@
+package esdb
+
+import (
+	"testing"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/protos/shared"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	rpcStatus "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestToBatchAppendRequestsSplitsEvents(t *testing.T) {
+	events := make([]EventData, 5)
+	for i := range events {
+		events[i] = EventData{EventType: "test", ContentType: JsonContentType, Data: []byte("{}")}
+	}
+
+	correlationID := uuid.Must(uuid.NewV4())
+	messages := toBatchAppendRequests(correlationID, batchAppendRequest{
+		streamID:         "batch-stream",
+		expectedRevision: StreamRevision{Value: 3},
+		events:           events,
+	}, 2, nil)
+
+	require.Len(t, messages, 3)
+	for i, message := range messages {
+		assert.Equal(t, correlationID, fromProtoUUID(message.CorrelationId))
+		assert.Equal(t, "batch-stream", string(message.Options.StreamIdentifier.StreamName))
+		assert.Equal(t, uint64(3), message.Options.GetStreamPosition())
+		assert.Equal(t, i == 2, message.IsFinal)
+	}
+
+	assert.Len(t, messages[0].ProposedMessages, 2)
+	assert.Len(t, messages[2].ProposedMessages, 1)
+}
+
+func TestToBatchAppendRequestsWithoutEvents(t *testing.T) {
+	messages := toBatchAppendRequests(uuid.Must(uuid.NewV4()), batchAppendRequest{
+		streamID:         "batch-stream",
+		expectedRevision: NoStream{},
+	}, 50, nil)
+
+	require.Len(t, messages, 1)
+	assert.True(t, messages[0].IsFinal)
+	assert.NotNil(t, messages[0].Options.GetNoStream())
+}
+
+func TestBatchAppendErrorFromStatus(t *testing.T) {
+	detail, err := anypb.New(&shared.WrongExpectedVersion{
+		CurrentStreamRevisionOption:  &shared.WrongExpectedVersion_CurrentStreamRevision{CurrentStreamRevision: 4},
+		ExpectedStreamPositionOption: &shared.WrongExpectedVersion_ExpectedNoStream{ExpectedNoStream: &emptypb.Empty{}},
+	})
+	require.NoError(t, err)
+
+	esdbErr, _ := FromError(batchAppendErrorFromStatus(&rpcStatus.Status{
+		Code:    int32(codes.FailedPrecondition),
+		Details: []*anypb.Any{detail},
+	}))
+	assert.Equal(t, ErrorWrongExpectedVersion, esdbErr.Code())
+	assert.Contains(t, esdbErr.Error(), "expecting 'no_stream' but got '4'")
+
+	esdbErr, _ = FromError(batchAppendErrorFromStatus(&rpcStatus.Status{Code: int32(codes.PermissionDenied), Message: "denied"}))
+	assert.Equal(t, ErrorAccessDenied, esdbErr.Code())
+
+	esdbErr, _ = FromError(batchAppendErrorFromStatus(&rpcStatus.Status{Code: int32(codes.Internal), Message: "boom"}))
+	assert.Equal(t, ErrorInternalServer, esdbErr.Code())
+}
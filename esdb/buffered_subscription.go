@@ -0,0 +1,156 @@
+package esdb
+
+import (
+	"fmt"
+	"sync"
+)
+
+// BufferedSubscription receives the messages of a subscription in a background goroutine, buffering a bounded number
+// of them ahead of Recv. The goroutine stops receiving while the buffer is full or the subscription is paused, which
+// lets the gRPC flow control slow the server down. Watermark callbacks tell when the buffer fills up and drains, so a
+// slow consumer can react before the server gets throttled.
+type BufferedSubscription struct {
+	sub  *Subscription
+	opts BufferedSubscriptionOptions
+
+	lock     sync.Mutex
+	cond     *sync.Cond
+	queue    []*SubscriptionEvent
+	paused   bool
+	closed   bool
+	finished bool
+	high     bool
+}
+
+// NewBufferedSubscription starts buffering the messages of the subscription. The subscription must not be used
+// directly afterwards.
+func NewBufferedSubscription(sub *Subscription, opts BufferedSubscriptionOptions) (*BufferedSubscription, error) {
+	opts.setDefaults()
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	buffered := &BufferedSubscription{
+		sub:  sub,
+		opts: opts,
+	}
+	buffered.cond = sync.NewCond(&buffered.lock)
+
+	go buffered.run()
+	return buffered, nil
+}
+
+func (b *BufferedSubscription) run() {
+	for {
+		b.lock.Lock()
+		for !b.closed && (b.paused || len(b.queue) >= b.opts.Capacity) {
+			b.cond.Wait()
+		}
+
+		closed := b.closed
+		b.lock.Unlock()
+
+		if closed {
+			return
+		}
+
+		event := b.sub.Recv()
+
+		b.lock.Lock()
+		b.queue = append(b.queue, event)
+		reachedHigh := !b.high && len(b.queue) >= b.opts.HighWatermark
+		if reachedHigh {
+			b.high = true
+		}
+
+		dropped := event.SubscriptionDropped != nil
+		if dropped {
+			b.finished = true
+		}
+
+		b.cond.Broadcast()
+		b.lock.Unlock()
+
+		if reachedHigh && b.opts.OnHighWatermark != nil {
+			b.opts.OnHighWatermark()
+		}
+
+		if dropped {
+			return
+		}
+	}
+}
+
+// Recv returns the next buffered message, waiting for one if the buffer is empty. Buffered messages are still
+// returned while the subscription is paused. The last message is the SubscriptionDropped of the subscription.
+func (b *BufferedSubscription) Recv() *SubscriptionEvent {
+	b.lock.Lock()
+	for len(b.queue) == 0 && !b.closed && !b.finished {
+		b.cond.Wait()
+	}
+
+	if len(b.queue) == 0 {
+		b.lock.Unlock()
+
+		return &SubscriptionEvent{
+			SubscriptionDropped: &SubscriptionDropped{
+				Error:  fmt.Errorf("subscription has been dropped"),
+				Reason: SubscriptionDropUnsubscribed,
+			},
+		}
+	}
+
+	event := b.queue[0]
+	b.queue[0] = nil
+	b.queue = b.queue[1:]
+
+	reachedLow := b.high && len(b.queue) <= b.opts.LowWatermark
+	if reachedLow {
+		b.high = false
+	}
+
+	b.cond.Broadcast()
+	b.lock.Unlock()
+
+	if reachedLow && b.opts.OnLowWatermark != nil {
+		b.opts.OnLowWatermark()
+	}
+
+	return event
+}
+
+// Pause stops receiving messages from the server. Recv keeps returning the buffered ones.
+func (b *BufferedSubscription) Pause() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.paused = true
+}
+
+// Resume receives messages from the server again after Pause.
+func (b *BufferedSubscription) Resume() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.paused = false
+	b.cond.Broadcast()
+}
+
+// Len returns the number of buffered messages.
+func (b *BufferedSubscription) Len() int {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	return len(b.queue)
+}
+
+// Close closes the subscription and discards the buffered messages.
+func (b *BufferedSubscription) Close() error {
+	b.lock.Lock()
+	b.closed = true
+	b.queue = nil
+	b.cond.Broadcast()
+	b.lock.Unlock()
+
+	return b.sub.Close()
+}
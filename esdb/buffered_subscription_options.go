@@ -0,0 +1,46 @@
+package esdb
+
+// BufferedSubscriptionOptions configures NewBufferedSubscription.
+type BufferedSubscriptionOptions struct {
+	// Maximum number of messages buffered ahead of Recv. Defaults to 1000.
+	Capacity int
+	// Number of buffered messages at which OnHighWatermark is called. Defaults to Capacity.
+	HighWatermark int
+	// Number of buffered messages at which OnLowWatermark is called, once the high watermark was reached. Defaults to
+	// half of HighWatermark.
+	LowWatermark int
+	// Called from the receiving goroutine when the buffer reaches HighWatermark.
+	OnHighWatermark func()
+	// Called from Recv when the buffer drains down to LowWatermark after reaching HighWatermark.
+	OnLowWatermark func()
+}
+
+func (o *BufferedSubscriptionOptions) setDefaults() {
+	if o.Capacity == 0 {
+		o.Capacity = 1000
+	}
+
+	if o.HighWatermark == 0 {
+		o.HighWatermark = o.Capacity
+	}
+
+	if o.LowWatermark == 0 {
+		o.LowWatermark = o.HighWatermark / 2
+	}
+}
+
+func (o *BufferedSubscriptionOptions) validate() error {
+	if o.Capacity < 1 {
+		return invalidArgumentError("Capacity must be strictly positive, got %d", o.Capacity)
+	}
+
+	if o.HighWatermark < 1 || o.HighWatermark > o.Capacity {
+		return invalidArgumentError("HighWatermark must be between 1 and Capacity, got %d", o.HighWatermark)
+	}
+
+	if o.LowWatermark < 0 || o.LowWatermark >= o.HighWatermark {
+		return invalidArgumentError("LowWatermark must be between 0 and HighWatermark excluded, got %d", o.LowWatermark)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package esdb
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBufferedSubscription(t *testing.T) {
+	client := &Client{grpcClient: &grpcClient{logger: &logger{}}}
+	sub := NewSubscription(client, func() {}, &scriptedReadClient{responses: readEventResponses(0, 1, 2, 3)}, "id")
+
+	var high, low int32
+	buffered, err := NewBufferedSubscription(sub, BufferedSubscriptionOptions{
+		Capacity:        2,
+		OnHighWatermark: func() { atomic.AddInt32(&high, 1) },
+		OnLowWatermark:  func() { atomic.AddInt32(&low, 1) },
+	})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool { return buffered.Len() == 2 }, time.Second, time.Millisecond)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&high))
+
+	buffered.Pause()
+	require.NotNil(t, buffered.Recv().EventAppeared)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&low))
+
+	// Paused, the buffer isn't refilled.
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, 1, buffered.Len())
+
+	buffered.Resume()
+	for revision := uint64(1); revision < 4; revision++ {
+		event := buffered.Recv()
+		require.NotNil(t, event.EventAppeared)
+		assert.Equal(t, revision, event.EventAppeared.OriginalEvent().EventNumber)
+	}
+
+	assert.NotNil(t, buffered.Recv().SubscriptionDropped)
+	assert.NoError(t, buffered.Close())
+}
+
+func TestBufferedSubscriptionOptionsValidation(t *testing.T) {
+	opts := BufferedSubscriptionOptions{Capacity: 10, HighWatermark: 20}
+	opts.setDefaults()
+	assertInvalidArgument(t, opts.validate())
+
+	opts = BufferedSubscriptionOptions{Capacity: 10}
+	opts.setDefaults()
+	assert.NoError(t, opts.validate())
+	assert.Equal(t, 5, opts.LowWatermark)
+}
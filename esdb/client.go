@@ -6,11 +6,13 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"strconv"
+	"time"
 
 	persistentProto "github.com/EventStore/EventStore-Client-Go/v2/protos/persistent"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	api "github.com/EventStore/EventStore-Client-Go/v2/protos/streams"
 )
@@ -23,6 +25,12 @@ type Client struct {
 
 // NewClient ...
 func NewClient(configuration *Configuration) (*Client, error) {
+	if !configuration.DisableTLS {
+		if _, err := configuration.tlsConfig(); err != nil {
+			return nil, err
+		}
+	}
+
 	grpcClient := NewGrpcClient(*configuration)
 	return &Client{
 		grpcClient: grpcClient,
@@ -44,14 +52,107 @@ func (client *Client) AppendToStream(
 	events ...EventData,
 ) (*WriteResult, error) {
 	opts.setDefaults()
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	events, err := client.interceptEvents(context, events)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.appendMessages(context, streamID, &opts, toProposedMessages(events))
+}
+
+// appendMessages applies the retry settings of the options to the append.
+func (client *Client) appendMessages(
+	context context.Context,
+	streamID string,
+	opts *AppendToStreamOptions,
+	messages []*api.AppendReq_ProposedMessage,
+) (*WriteResult, error) {
+	policy, err := client.retryPolicy(opts.RetryPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *WriteResult
+	if policy != nil || opts.DeduplicateOnRetry {
+		result, err = client.appendToStreamWithRetry(context, streamID, opts, policy, messages)
+	} else {
+		result, err = client.appendToStream(context, streamID, opts, messages)
+	}
+
+	if err != nil || opts.EnsureMetadata == nil || len(messages) == 0 {
+		return result, err
+	}
+
+	_, createdStream := opts.ExpectedRevision.(NoStream)
+	if createdStream || result.NextExpectedRevision == Revision(uint64(len(messages)-1)) {
+		if err := client.ensureStreamMetadata(context, streamID, opts); err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+// EnsureMetadataError is returned along with the WriteResult of an append whose events got written, but whose
+// EnsureMetadata couldn't be. The append must not be retried, only the metadata needs writing.
+type EnsureMetadataError struct {
+	StreamID string
+	err      error
+}
+
+func (e *EnsureMetadataError) Error() string {
+	return fmt.Sprintf("events were appended, but the initial metadata of stream '%s' couldn't be written: %v", e.StreamID, e.err)
+}
+
+func (e *EnsureMetadataError) Unwrap() error {
+	return e.err
+}
+
+// ensureStreamMetadata writes the EnsureMetadata of the options unless the stream already has metadata.
+func (client *Client) ensureStreamMetadata(context context.Context, streamID string, opts *AppendToStreamOptions) error {
+	_, err := client.SetStreamMetadata(context, streamID, AppendToStreamOptions{
+		ExpectedRevision: NoStream{},
+		Authenticated:    opts.Authenticated,
+		Deadline:         opts.Deadline,
+	}, *opts.EnsureMetadata)
+
+	var wrongErr *WrongExpectedVersionError
+	if err != nil && !errors.As(err, &wrongErr) {
+		return &EnsureMetadataError{StreamID: streamID, err: err}
+	}
+
+	return nil
+}
+
+func (client *Client) appendToStream(
+	context context.Context,
+	streamID string,
+	opts *AppendToStreamOptions,
+	messages []*api.AppendReq_ProposedMessage,
+) (*WriteResult, error) {
+	// Every proposed message is sent separately over the append session, so only individual events have to fit
+	// within the payload limit.
+	if opts.MaxAppendPayloadBytes > 0 {
+		for i, message := range messages {
+			if size := proposedMessageSize(message, opts.MaxAppendPayloadBytes); size > opts.MaxAppendPayloadBytes {
+				return nil, invalidArgumentError("event %d of type '%s' is %d bytes, which exceeds MaxAppendPayloadBytes (%d)", i, message.Metadata[systemMetadataKeysType], size, opts.MaxAppendPayloadBytes)
+			}
+		}
+	}
+
 	handle, err := client.grpcClient.getConnectionHandle()
 	if err != nil {
 		return nil, err
 	}
 	streamsClient := api.NewStreamsClient(handle.Connection())
 	var headers, trailers metadata.MD
-	callOptions := []grpc.CallOption{grpc.Header(&headers), grpc.Trailer(&trailers)}
-	callOptions, ctx, cancel := configureGrpcCall(context, client.Config, &opts, callOptions)
+	compressor := client.compressor(opts.Compression)
+	callOptions := withCompressor([]grpc.CallOption{grpc.Header(&headers), grpc.Trailer(&trailers)}, compressor)
+	callOptions, ctx, cancel := configureGrpcCall(context, client.Config, opts, callOptions)
 	defer cancel()
 
 	appendOperation, err := streamsClient.Append(ctx, callOptions...)
@@ -67,14 +168,13 @@ func (client *Client) AppendToStream(
 		return nil, fmt.Errorf("could not send append request header. Reason: %w", err)
 	}
 
-	for _, event := range events {
-		appendRequest := &api.AppendReq{
-			Content: &api.AppendReq_ProposedMessage_{
-				ProposedMessage: toProposedMessage(event),
-			},
-		}
+	sender := newAppendSender(messages)
+	for sender.next() {
+		if err = appendOperation.Send(sender.request); err != nil {
+			if status.Code(err) == codes.ResourceExhausted {
+				return nil, appendTooLargeError(err)
+			}
 
-		if err = appendOperation.Send(appendRequest); err != nil {
 			err = client.grpcClient.handleError(handle, headers, trailers, err)
 			return nil, fmt.Errorf("could not send append request. Reason: %w", err)
 		}
@@ -82,6 +182,16 @@ func (client *Client) AppendToStream(
 
 	response, err := appendOperation.CloseAndRecv()
 	if err != nil {
+		if status.Code(err) == codes.ResourceExhausted {
+			return nil, appendTooLargeError(err)
+		}
+
+		// Nothing was written, so the append is safe to send again. Compression is disabled from then on.
+		if compressor != "" && isCompressionRejected(err) {
+			client.grpcClient.rejectCompression(compressor)
+			return client.appendToStream(context, streamID, opts, messages)
+		}
+
 		return nil, client.grpcClient.handleError(handle, headers, trailers, err)
 	}
 
@@ -89,60 +199,22 @@ func (client *Client) AppendToStream(
 	switch result.(type) {
 	case *api.AppendResp_Success_:
 		{
-			success := result.(*api.AppendResp_Success_)
-			var streamRevision uint64
-			if _, ok := success.Success.GetCurrentRevisionOption().(*api.AppendResp_Success_NoStream); ok {
-				streamRevision = 1
-			} else {
-				streamRevision = success.Success.GetCurrentRevision()
-			}
+			writeResult := writeResultFromAppendProto(result.(*api.AppendResp_Success_).Success)
 
-			var commitPosition uint64
-			var preparePosition uint64
-			if position, ok := success.Success.GetPositionOption().(*api.AppendResp_Success_Position); ok {
-				commitPosition = position.Position.CommitPosition
-				preparePosition = position.Position.PreparePosition
-			} else {
-				streamRevision = success.Success.GetCurrentRevision()
+			if opts.ReturnResponseMetadata {
+				writeResult.ResponseMetadata = newResponseMetadata(headers, trailers)
 			}
 
-			return &WriteResult{
-				CommitPosition:      commitPosition,
-				PreparePosition:     preparePosition,
-				NextExpectedVersion: streamRevision,
-			}, nil
+			return writeResult, nil
 		}
 	case *api.AppendResp_WrongExpectedVersion_:
 		{
 			wrong := result.(*api.AppendResp_WrongExpectedVersion_).WrongExpectedVersion
-			expected := ""
-			current := ""
-
-			if wrong.GetExpectedAny() != nil {
-				expected = "any"
-			} else if wrong.GetExpectedNoStream() != nil {
-				expected = "no_stream"
-			} else if wrong.GetExpectedStreamExists() != nil {
-				expected = "stream_exists"
-			} else {
-				expected = strconv.Itoa(int(wrong.GetExpectedRevision()))
-			}
-
-			if wrong.GetCurrentNoStream() != nil {
-				current = "no_stream"
-			} else {
-				current = strconv.Itoa(int(wrong.GetCurrentRevision()))
-			}
-
-			return nil, &Error{code: ErrorWrongExpectedVersion, err: fmt.Errorf("wrong expected version: expecting '%s' but got '%s'", expected, current)}
+			return nil, wrongExpectedVersionFromAppendProto(wrong)
 		}
 	}
 
-	return &WriteResult{
-		CommitPosition:      0,
-		PreparePosition:     0,
-		NextExpectedVersion: 1,
-	}, nil
+	return newWriteResult(NoStream{}, nil), nil
 }
 
 func (client *Client) SetStreamMetadata(
@@ -151,7 +223,7 @@ func (client *Client) SetStreamMetadata(
 	opts AppendToStreamOptions,
 	metadata StreamMetadata,
 ) (*WriteResult, error) {
-	streamName := fmt.Sprintf("$$%v", streamID)
+	streamName := MetadataStreamName(streamID)
 	props, err := metadata.ToMap()
 
 	if err != nil {
@@ -166,7 +238,7 @@ func (client *Client) SetStreamMetadata(
 
 	result, err := client.AppendToStream(context, streamName, opts, EventData{
 		ContentType: JsonContentType,
-		EventType:   "$metadata",
+		EventType:   MetadataEventType,
 		Data:        data,
 	})
 
@@ -182,28 +254,156 @@ func (client *Client) GetStreamMetadata(
 	streamID string,
 	opts ReadStreamOptions,
 ) (*StreamMetadata, error) {
-	streamName := fmt.Sprintf("$$%v", streamID)
+	meta, _, err := client.getStreamMetadataWithRevision(context, streamID, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return meta, nil
+}
+
+// TruncateStream sets the truncate before ($tb) value of the stream metadata, leaving the other metadata properties
+// untouched. The metadata stream is written with the revision read beforehand, so a concurrent metadata update makes
+// the call fail with ErrorWrongExpectedVersion instead of being silently overwritten. The NextExpectedRevision of the
+// returned WriteResult is the revision of the metadata event that was written.
+func (client *Client) TruncateStream(
+	context context.Context,
+	streamID string,
+	beforeRevision uint64,
+	opts TruncateStreamOptions,
+) (*WriteResult, error) {
+	meta, revision, err := client.getStreamMetadataOrEmpty(context, streamID, ReadStreamOptions{
+		Direction:     Backwards,
+		From:          End{},
+		Authenticated: opts.Authenticated,
+		Deadline:      opts.Deadline,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	meta.SetTruncateBefore(beforeRevision)
+
+	return client.SetStreamMetadata(context, streamID, AppendToStreamOptions{
+		ExpectedRevision: revision,
+		Authenticated:    opts.Authenticated,
+		Deadline:         opts.Deadline,
+	}, *meta)
+}
+
+// UpdateStreamMetadata reads the current stream metadata, passes it to update and writes the result back using the
+// revision that was read as the expected revision. When the metadata got modified concurrently, the whole cycle is
+// retried until it succeeds or MaxAttempts is reached. An error returned by update aborts the cycle without writing
+// anything and is returned as is.
+func (client *Client) UpdateStreamMetadata(
+	context context.Context,
+	streamID string,
+	opts UpdateStreamMetadataOptions,
+	update func(StreamMetadata) (StreamMetadata, error),
+) (*WriteResult, error) {
+	opts.setDefaults()
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	var err error
+	for attempt := 0; attempt < opts.MaxAttempts; attempt++ {
+		var meta *StreamMetadata
+		var revision ExpectedRevision
+
+		meta, revision, err = client.getStreamMetadataOrEmpty(context, streamID, ReadStreamOptions{
+			Direction:     Backwards,
+			From:          End{},
+			Authenticated: opts.Authenticated,
+			Deadline:      opts.Deadline,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		var updated StreamMetadata
+		updated, err = update(*meta)
+		if err != nil {
+			return nil, err
+		}
+
+		var result *WriteResult
+		result, err = client.SetStreamMetadata(context, streamID, AppendToStreamOptions{
+			ExpectedRevision: revision,
+			Authenticated:    opts.Authenticated,
+			Deadline:         opts.Deadline,
+		}, updated)
+
+		if esdbErr, ok := FromError(err); !ok && esdbErr.Code() == ErrorWrongExpectedVersion {
+			client.grpcClient.logger.debug("stream metadata of '%s' updated concurrently, retrying", streamID)
+			continue
+		}
+
+		return result, err
+	}
+
+	return nil, err
+}
+
+// getStreamMetadataOrEmpty is getStreamMetadataWithRevision for the helpers updating or interpreting the metadata,
+// where a stream without metadata yields empty metadata and NoStream instead of failing with ErrorResourceNotFound.
+func (client *Client) getStreamMetadataOrEmpty(
+	context context.Context,
+	streamID string,
+	opts ReadStreamOptions,
+) (*StreamMetadata, ExpectedRevision, error) {
+	meta, revision, err := client.getStreamMetadataWithRevision(context, streamID, opts)
+
+	var esdbErr *Error
+	if errors.As(err, &esdbErr) && esdbErr.Code() == ErrorResourceNotFound {
+		return &StreamMetadata{}, NoStream{}, nil
+	}
+
+	return meta, revision, err
+}
+
+// getStreamMetadataWithRevision returns the stream metadata along with the expected revision to use when writing it
+// back. A metadata stream without events yields empty metadata and NoStream.
+func (client *Client) getStreamMetadataWithRevision(
+	context context.Context,
+	streamID string,
+	opts ReadStreamOptions,
+) (*StreamMetadata, ExpectedRevision, error) {
+	streamName := MetadataStreamName(streamID)
 
 	stream, err := client.ReadStream(context, streamName, opts, 1)
 
 	if esdbErr, ok := FromError(err); !ok {
-		return nil, esdbErr
+		return nil, nil, esdbErr
 	}
 
 	defer stream.Close()
 	event, err := stream.Recv()
 
 	if errors.Is(err, io.EOF) {
-		return &StreamMetadata{}, nil
+		return &StreamMetadata{}, NoStream{}, nil
 	}
 
 	if err != nil {
-		return nil, fmt.Errorf("unexpected error when reading stream metadata: %w", err)
+		return nil, nil, fmt.Errorf("unexpected error when reading stream metadata: %w", err)
 	}
 
+	meta, err := streamMetadataFromEvent(event)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return meta, Revision(event.OriginalEvent().EventNumber), nil
+}
+
+func streamMetadataFromEvent(event *ResolvedEvent) (*StreamMetadata, error) {
 	var props map[string]interface{}
 
-	err = json.Unmarshal(event.OriginalEvent().Data, &props)
+	err := json.Unmarshal(event.OriginalEvent().Data, &props)
 
 	if err != nil {
 		return nil, &Error{code: ErrorParsing, err: fmt.Errorf("error when deserializing stream metadata json: %w", err)}
@@ -225,6 +425,17 @@ func (client *Client) DeleteStream(
 	opts DeleteStreamOptions,
 ) (*DeleteResult, error) {
 	opts.setDefaults()
+
+	var lastRevision *uint64
+	if opts.ReturnLastRevision {
+		revision, err := client.deleteLastRevision(parent, streamID, &opts)
+		if err != nil {
+			return nil, err
+		}
+
+		lastRevision = revision
+	}
+
 	handle, err := client.grpcClient.getConnectionHandle()
 	if err != nil {
 		return nil, err
@@ -241,7 +452,93 @@ func (client *Client) DeleteStream(
 		return nil, fmt.Errorf("failed to perform delete, details: %w", err)
 	}
 
-	return &DeleteResult{Position: deletePositionFromProto(deleteResponse)}, nil
+	result := &DeleteResult{Position: deletePositionFromProto(deleteResponse), LastRevision: lastRevision}
+	if opts.ReturnResponseMetadata {
+		result.ResponseMetadata = newResponseMetadata(headers, trailers)
+	}
+
+	return result, nil
+}
+
+// deleteLastRevision returns the revision of the last event of the stream about to be deleted, pinning the expected
+// revision of the delete to it. A stream without events keeps its expected revision and has no last revision.
+func (client *Client) deleteLastRevision(parent context.Context, streamID string, opts *DeleteStreamOptions) (*uint64, error) {
+	switch expected := opts.ExpectedRevision.(type) {
+	case StreamRevision:
+		return &expected.Value, nil
+	case NoStream:
+		return nil, nil
+	}
+
+	revision, err := client.readStreamHeadRevision(parent, streamID, opts.Authenticated, opts.Deadline)
+	if err != nil {
+		var esdbErr *Error
+		if errors.As(err, &esdbErr) && esdbErr.Code() == ErrorResourceNotFound {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	opts.ExpectedRevision = Revision(revision)
+	return &revision, nil
+}
+
+// DeleteStreamAtHead reads the current revision of the stream and deletes it using that revision as the expected
+// revision. If the stream got written to in between, the delete fails with ErrorWrongExpectedVersion. Setting Force
+// skips the lookup and deletes the stream regardless of its revision.
+func (client *Client) DeleteStreamAtHead(
+	parent context.Context,
+	streamID string,
+	opts DeleteStreamAtHeadOptions,
+) (*DeleteResult, error) {
+	deleteOpts := DeleteStreamOptions{
+		ExpectedRevision: Any{},
+		Authenticated:    opts.Authenticated,
+		Deadline:         opts.Deadline,
+	}
+
+	if !opts.Force {
+		revision, err := client.readStreamHeadRevision(parent, streamID, opts.Authenticated, opts.Deadline)
+		if err != nil {
+			return nil, err
+		}
+
+		deleteOpts.ExpectedRevision = Revision(revision)
+	}
+
+	return client.DeleteStream(parent, streamID, deleteOpts)
+}
+
+func (client *Client) readStreamHeadRevision(
+	parent context.Context,
+	streamID string,
+	credentials *Credentials,
+	deadline *time.Duration,
+) (uint64, error) {
+	stream, err := client.ReadStream(parent, streamID, ReadStreamOptions{
+		Direction:     Backwards,
+		From:          End{},
+		Authenticated: credentials,
+		Deadline:      deadline,
+	}, 1)
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer stream.Close()
+	event, err := stream.Recv()
+
+	if errors.Is(err, io.EOF) {
+		return 0, &Error{code: ErrorResourceNotFound, err: fmt.Errorf("stream '%s' is not found", streamID)}
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return event.Event.EventNumber, nil
 }
 
 // Tombstone ...
@@ -268,7 +565,12 @@ func (client *Client) TombstoneStream(
 		return nil, fmt.Errorf("failed to perform delete, details: %w", err)
 	}
 
-	return &DeleteResult{Position: tombstonePositionFromProto(tombstoneResponse)}, nil
+	result := &DeleteResult{Position: tombstonePositionFromProto(tombstoneResponse)}
+	if opts.ReturnResponseMetadata {
+		result.ResponseMetadata = newResponseMetadata(headers, trailers)
+	}
+
+	return result, nil
 }
 
 // ReadStream ...
@@ -279,14 +581,134 @@ func (client *Client) ReadStream(
 	count uint64,
 ) (*ReadStream, error) {
 	opts.setDefaults()
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
 	readRequest := toReadStreamRequest(streamID, opts.Direction, opts.From, count, opts.ResolveLinkTos)
-	handle, err := client.grpcClient.getConnectionHandle()
+	policy, err := client.readRetryPolicy(opts.RetryPolicy)
 	if err != nil {
 		return nil, err
 	}
-	streamsClient := api.NewStreamsClient(handle.Connection())
 
-	return readInternal(context, client, &opts, handle, streamsClient, readRequest)
+	if opts.ResumeOnReconnect && policy == nil {
+		policy = DefaultRetryPolicy()
+	}
+
+	stream, err := client.readWithRetry(context, &opts, policy, opts.Compression, readRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.ResumeOnReconnect {
+		stream.retry.resume = true
+		stream.retry.count = count
+	}
+
+	if opts.ReuseBuffers {
+		stream.buffers = &readBuffers{}
+	}
+
+	stream.prefetch = opts.PrefetchCount
+	stream.idleTimeout = opts.IdleTimeout
+	stream.wrapCall()
+	return stream, nil
+}
+
+// ReadStreamAll reads the events of a stream into a slice. Reads holding more than MaxCount events fail with
+// ErrorReadLimitExceeded, and a stream which doesn't exist fails with ErrorResourceNotFound.
+func (client *Client) ReadStreamAll(
+	context context.Context,
+	streamID string,
+	opts ReadStreamAllOptions,
+) ([]ResolvedEvent, error) {
+	opts.setDefaults()
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	// One event more than allowed is requested, so an oversized read is told apart from one holding exactly MaxCount
+	// events.
+	stream, err := client.ReadStream(context, streamID, opts.ReadStreamOptions, uint64(opts.MaxCount)+1)
+	if err != nil {
+		return nil, err
+	}
+
+	return stream.Collect(opts.MaxCount)
+}
+
+// ReadEvent reads the event of a stream at the given revision. When the revision doesn't exist, because the stream
+// is shorter, truncated or missing, it fails with an ErrorEventNotFound error wrapping ErrEventNotFound.
+func (client *Client) ReadEvent(
+	context context.Context,
+	streamID string,
+	revision uint64,
+	opts ReadEventOptions,
+) (*ResolvedEvent, error) {
+	stream, err := client.ReadStream(context, streamID, ReadStreamOptions{
+		Direction:      Forwards,
+		From:           Revision(revision),
+		ResolveLinkTos: opts.ResolveLinkTos,
+		Authenticated:  opts.Authenticated,
+		Deadline:       opts.Deadline,
+	}, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stream.Close()
+	event, err := stream.Recv()
+
+	notFound := &Error{code: ErrorEventNotFound, err: fmt.Errorf("%w: revision %d of stream '%s'", ErrEventNotFound, revision, streamID)}
+	if errors.Is(err, io.EOF) {
+		return nil, notFound
+	}
+
+	if err != nil {
+		var esdbErr *Error
+		if errors.As(err, &esdbErr) && esdbErr.Code() == ErrorResourceNotFound {
+			return nil, notFound
+		}
+
+		return nil, err
+	}
+
+	// Reading from a truncated revision yields the first event still readable instead.
+	if event.OriginalEvent().EventNumber != revision {
+		return nil, notFound
+	}
+
+	return event, nil
+}
+
+// ReadAllLatest returns the last n events of $all matching the optional filter, ordered from the oldest to the newest.
+func (client *Client) ReadAllLatest(
+	context context.Context,
+	n uint64,
+	opts ReadAllLatestOptions,
+) ([]ResolvedEvent, error) {
+	stream, err := client.ReadAll(context, ReadAllOptions{
+		Direction:       Backwards,
+		From:            End{},
+		ResolveLinkTos:  opts.ResolveLinkTos,
+		Authenticated:   opts.Authenticated,
+		Deadline:        opts.Deadline,
+		Filter:          opts.Filter,
+		MaxSearchWindow: opts.MaxSearchWindow,
+	}, n)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := stream.Collect(int(n))
+	if err != nil {
+		return nil, err
+	}
+
+	for i, j := 0, len(events)-1; i < j; i, j = i+1, j-1 {
+		events[i], events[j] = events[j], events[i]
+	}
+
+	return events, nil
 }
 
 // ReadAll ...
@@ -296,13 +718,101 @@ func (client *Client) ReadAll(
 	count uint64,
 ) (*ReadStream, error) {
 	opts.setDefaults()
-	handle, err := client.grpcClient.getConnectionHandle()
-	if err != nil {
+	if err := opts.validate(); err != nil {
 		return nil, err
 	}
-	streamsClient := api.NewStreamsClient(handle.Connection())
 	readRequest := toReadAllRequest(opts.Direction, opts.From, count, opts.ResolveLinkTos)
-	return readInternal(context, client, &opts, handle, streamsClient, readRequest)
+	if opts.Filter != nil {
+		filterOptions, err := toFilterOptions(&SubscriptionFilterOptions{
+			MaxSearchWindow:    opts.MaxSearchWindow,
+			CheckpointInterval: 1,
+			SubscriptionFilter: opts.Filter,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		readRequest.Options.FilterOption = &api.ReadReq_Options_Filter{Filter: filterOptions}
+	}
+
+	policy, err := client.readRetryPolicy(opts.RetryPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.ResumeOnReconnect && policy == nil {
+		policy = DefaultRetryPolicy()
+	}
+
+	stream, err := client.readWithRetry(context, &opts, policy, opts.Compression, readRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.ResumeOnReconnect {
+		stream.retry.resume = true
+		stream.retry.count = count
+	}
+
+	if opts.ReuseBuffers {
+		stream.buffers = &readBuffers{}
+	}
+
+	stream.prefetch = opts.PrefetchCount
+	stream.idleTimeout = opts.IdleTimeout
+	stream.wrapCall()
+	return stream, nil
+}
+
+// readWithRetry opens the read, retrying according to the policy when it is not nil. The policy keeps applying to
+// failures happening before the first event is received, or to every failure once the read resumes on reconnection.
+func (client *Client) readWithRetry(
+	ctx context.Context,
+	opts options,
+	policy *RetryPolicy,
+	compression string,
+	readRequest *api.ReadReq,
+) (*ReadStream, error) {
+	open := func(request *api.ReadReq) (*ReadStream, error) {
+		handle, err := client.grpcClient.getConnectionHandle()
+		if err != nil {
+			return nil, err
+		}
+
+		return readInternal(ctx, client, opts, handle, api.NewStreamsClient(handle.Connection()), request, client.compressor(compression))
+	}
+
+	stream, err := open(readRequest)
+	if policy == nil {
+		if err == nil {
+			stream.retry = &readRetry{ctx: ctx, openFrom: open, request: readRequest}
+		}
+
+		return stream, err
+	}
+
+	attempt := 1
+	for ; err != nil && attempt < policy.MaxAttempts && policy.retryable(err); attempt++ {
+		if policy.wait(ctx, attempt) != nil {
+			return nil, err
+		}
+
+		stream, err = open(readRequest)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	stream.retry = &readRetry{
+		ctx:      ctx,
+		policy:   policy,
+		openFrom: open,
+		request:  readRequest,
+		attempt:  attempt,
+	}
+
+	return stream, nil
 }
 
 // SubscribeToStream ...
@@ -312,17 +822,87 @@ func (client *Client) SubscribeToStream(
 	opts SubscribeToStreamOptions,
 ) (*Subscription, error) {
 	opts.setDefaults()
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	var filter *eventFilter
+	if opts.Filter != nil {
+		var err error
+		if filter, err = newEventFilter(opts.Filter); err != nil {
+			return nil, err
+		}
+	}
+
+	live, err := client.streamLive(parent, streamID, &opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the head of stream '%s'. Reason: %w", streamID, err)
+	}
+
+	if opts.From, err = client.resolveStreamStart(parent, streamID, &opts); err != nil {
+		return nil, fmt.Errorf("failed to read the end of stream '%s'. Reason: %w", streamID, err)
+	}
+
+	// A call reopened before the first event resumes from the end of the stream when subscribing, so the events
+	// written in the meantime aren't missed.
+	reopenFrom := opts.From
+	if _, ok := opts.From.(End); ok {
+		if reopenFrom, err = client.streamEnd(parent, streamID, &opts); err != nil {
+			return nil, fmt.Errorf("failed to read the end of stream '%s'. Reason: %w", streamID, err)
+		}
+	}
+
+	opened, err := client.openStreamSubscription(parent, streamID, opts.From, &opts)
+	if err != nil {
+		return nil, err
+	}
+
+	sub := NewSubscription(client, opened.cancel, opened.inner, opened.id)
+	sub.confirmation = streamConfirmation(opened.id, opts.From)
+	sub.readHead = client.streamHead(streamID, &opts)
+	if revision, ok := reopenFrom.(StreamRevision); ok {
+		sub.start = &resumePoint{revision: revision.Value}
+	}
+	sub.connection = opened.handle
+	sub.trailers = opened.trailers
+	sub.filter = filter
+	sub.live = live
+	sub.reopen = func(from *resumePoint) (*openedSubscription, error) {
+		if from == nil {
+			return client.openStreamSubscription(parent, streamID, reopenFrom, &opts)
+		}
+
+		return client.openStreamSubscription(parent, streamID, Revision(from.revision), &opts)
+	}
+	if opts.OnStalled != nil {
+		sub.monitorStalls(opts.StallThreshold, opts.OnStalled)
+	}
+	sub.releaseOnPause = opts.ReleaseOnPause
+	if opts.OnConfirmed != nil {
+		opts.OnConfirmed(sub.confirmation)
+	}
+
+	return sub, nil
+}
+
+func (client *Client) openStreamSubscription(
+	parent context.Context,
+	streamID string,
+	from StreamPosition,
+	opts *SubscribeToStreamOptions,
+) (*openedSubscription, error) {
 	handle, err := client.grpcClient.getConnectionHandle()
 	if err != nil {
 		return nil, err
 	}
 	var headers, trailers metadata.MD
 	callOptions := []grpc.CallOption{grpc.Header(&headers), grpc.Trailer(&trailers)}
-	callOptions, ctx, cancel := configureGrpcCall(parent, client.Config, &opts, callOptions)
+	callOptions, ctx, cancel := configureGrpcCall(parent, client.Config, opts, callOptions)
 
 	streamsClient := api.NewStreamsClient(handle.Connection())
-	subscriptionRequest, err := toStreamSubscriptionRequest(streamID, opts.From, opts.ResolveLinkTos, nil)
+	subscriptionRequest, err := toStreamSubscriptionRequest(streamID, from, opts.ResolveLinkTos, nil)
 	if err != nil {
+		defer cancel()
 		return nil, fmt.Errorf("failed to construct subscription. Reason: %w", err)
 	}
 	readClient, err := streamsClient.Read(ctx, subscriptionRequest, callOptions...)
@@ -331,17 +911,37 @@ func (client *Client) SubscribeToStream(
 		err = client.grpcClient.handleError(handle, headers, trailers, err)
 		return nil, fmt.Errorf("failed to construct subscription. Reason: %w", err)
 	}
+	if opts.IdleTimeout > 0 {
+		readClient = newIdleReadClient(readClient, opts.IdleTimeout, cancel)
+	}
+
+	return client.confirmSubscription(handle, readClient, cancel, headers, &trailers)
+}
+
+// confirmSubscription waits for the server to confirm a subscription call.
+func (client *Client) confirmSubscription(
+	handle *connectionHandle,
+	readClient api.Streams_ReadClient,
+	cancel context.CancelFunc,
+	headers metadata.MD,
+	trailers *metadata.MD,
+) (*openedSubscription, error) {
 	readResult, err := readClient.Recv()
 	if err != nil {
 		defer cancel()
-		err = client.grpcClient.handleError(handle, headers, trailers, err)
+		err = client.grpcClient.handleError(handle, headers, *trailers, err)
 		return nil, fmt.Errorf("failed to perform read. Reason: %w", err)
 	}
 	switch readResult.Content.(type) {
 	case *api.ReadResp_Confirmation:
 		{
-			confirmation := readResult.GetConfirmation()
-			return NewSubscription(client, cancel, readClient, confirmation.SubscriptionId), nil
+			return &openedSubscription{
+				handle:   handle,
+				inner:    readClient,
+				cancel:   cancel,
+				trailers: trailers,
+				id:       readResult.GetConfirmation().SubscriptionId,
+			}, nil
 		}
 	}
 	defer cancel()
@@ -354,6 +954,103 @@ func (client *Client) SubscribeToAll(
 	opts SubscribeToAllOptions,
 ) (*Subscription, error) {
 	opts.setDefaults()
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	if opts.InitialWindowSize > 0 || opts.ReadBufferSize > 0 {
+		return client.subscribeToAllTuned(parent, opts)
+	}
+	live, err := client.allLive(parent, &opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the head of $all. Reason: %w", err)
+	}
+
+	if opts.From, err = client.resolveAllStart(parent, &opts); err != nil {
+		return nil, fmt.Errorf("failed to read the end of $all. Reason: %w", err)
+	}
+
+	// A call reopened before the first event or checkpoint resumes from the end of $all when subscribing, so the
+	// events written in the meantime aren't missed.
+	reopenFrom := opts.From
+	if _, ok := opts.From.(End); ok {
+		if reopenFrom, err = client.allEnd(parent, &opts); err != nil {
+			return nil, fmt.Errorf("failed to read the end of $all. Reason: %w", err)
+		}
+	}
+
+	opened, err := client.openAllSubscription(parent, opts.From, &opts)
+	if err != nil {
+		return nil, err
+	}
+
+	sub := NewSubscription(client, opened.cancel, opened.inner, opened.id)
+	sub.confirmation = allConfirmation(opened.id, opts.From)
+	sub.toAll = true
+	sub.readHead = client.allHead(&opts)
+	if position, ok := reopenFrom.(Position); ok {
+		sub.start = &resumePoint{position: position}
+	}
+	sub.connection = opened.handle
+	sub.trailers = opened.trailers
+	sub.ctx = parent
+	sub.onCheckpoint = opts.CheckpointReached
+	sub.live = live
+	sub.reopen = func(from *resumePoint) (*openedSubscription, error) {
+		if from == nil {
+			return client.openAllSubscription(parent, reopenFrom, &opts)
+		}
+
+		return client.openAllSubscription(parent, from.position, &opts)
+	}
+	if opts.OnStalled != nil {
+		sub.monitorStalls(opts.StallThreshold, opts.OnStalled)
+	}
+	sub.releaseOnPause = opts.ReleaseOnPause
+	if opts.OnConfirmed != nil {
+		opts.OnConfirmed(sub.confirmation)
+	}
+
+	return sub, nil
+}
+
+// subscribeToAllTuned subscribes through a dedicated client whose connection uses the flow-control settings of the
+// subscription. The node is discovered, and discovered again when reconnecting, like for the shared connection, and
+// the dedicated client is closed along with the subscription.
+func (client *Client) subscribeToAllTuned(parent context.Context, opts SubscribeToAllOptions) (*Subscription, error) {
+	tuned := *client.Config
+	if opts.InitialWindowSize > 0 {
+		tuned.InitialWindowSize = opts.InitialWindowSize
+	}
+
+	if opts.ReadBufferSize > 0 {
+		tuned.ReadBufferSize = opts.ReadBufferSize
+	}
+
+	dedicated, err := NewClient(&tuned)
+	if err != nil {
+		return nil, err
+	}
+
+	opts.InitialWindowSize = 0
+	opts.ReadBufferSize = 0
+	sub, err := dedicated.SubscribeToAll(parent, opts)
+	if err != nil {
+		_ = dedicated.Close()
+		return nil, err
+	}
+
+	sub.release = func() {
+		_ = dedicated.Close()
+	}
+
+	return sub, nil
+}
+
+func (client *Client) openAllSubscription(
+	parent context.Context,
+	from AllPosition,
+	opts *SubscribeToAllOptions,
+) (*openedSubscription, error) {
 	handle, err := client.grpcClient.getConnectionHandle()
 	if err != nil {
 		return nil, err
@@ -361,7 +1058,7 @@ func (client *Client) SubscribeToAll(
 	streamsClient := api.NewStreamsClient(handle.Connection())
 	var headers, trailers metadata.MD
 	callOptions := []grpc.CallOption{grpc.Header(&headers), grpc.Trailer(&trailers)}
-	callOptions, ctx, cancel := configureGrpcCall(parent, client.Config, &opts, callOptions)
+	callOptions, ctx, cancel := configureGrpcCall(parent, client.Config, opts, callOptions)
 
 	var filterOptions *SubscriptionFilterOptions = nil
 	if opts.Filter != nil {
@@ -372,8 +1069,9 @@ func (client *Client) SubscribeToAll(
 		}
 	}
 
-	subscriptionRequest, err := toAllSubscriptionRequest(opts.From, opts.ResolveLinkTos, filterOptions)
+	subscriptionRequest, err := toAllSubscriptionRequest(from, opts.ResolveLinkTos, filterOptions)
 	if err != nil {
+		defer cancel()
 		return nil, fmt.Errorf("failed to construct subscription. Reason: %w", err)
 	}
 	readClient, err := streamsClient.Read(ctx, subscriptionRequest, callOptions...)
@@ -382,21 +1080,11 @@ func (client *Client) SubscribeToAll(
 		err = client.grpcClient.handleError(handle, headers, trailers, err)
 		return nil, fmt.Errorf("failed to construct subscription. Reason: %w", err)
 	}
-	readResult, err := readClient.Recv()
-	if err != nil {
-		defer cancel()
-		err = client.grpcClient.handleError(handle, headers, trailers, err)
-		return nil, fmt.Errorf("failed to perform read. Reason: %w", err)
+	if opts.IdleTimeout > 0 {
+		readClient = newIdleReadClient(readClient, opts.IdleTimeout, cancel)
 	}
-	switch readResult.Content.(type) {
-	case *api.ReadResp_Confirmation:
-		{
-			confirmation := readResult.GetConfirmation()
-			return NewSubscription(client, cancel, readClient, confirmation.SubscriptionId), nil
-		}
-	}
-	defer cancel()
-	return nil, fmt.Errorf("failed to initiate subscription")
+
+	return client.confirmSubscription(handle, readClient, cancel, headers, &trailers)
 }
 
 // SubscribeToPersistentSubscription ...
@@ -407,22 +1095,15 @@ func (client *Client) SubscribeToPersistentSubscription(
 	options SubscribeToPersistentSubscriptionOptions,
 ) (*PersistentSubscription, error) {
 	options.setDefaults()
+	if err := options.validate(); err != nil {
+		return nil, err
+	}
 	handle, err := client.grpcClient.getConnectionHandle()
 	if err != nil {
 		return nil, err
 	}
 
-	persistentSubscriptionClient := newPersistentClient(client.grpcClient, persistentProto.NewPersistentSubscriptionsClient(handle.Connection()))
-
-	return persistentSubscriptionClient.ConnectToPersistentSubscription(
-		ctx,
-		client.Config,
-		&options,
-		handle,
-		int32(options.BufferSize),
-		streamName,
-		groupName,
-	)
+	return client.connectToPersistentSubscription(ctx, handle, streamName, groupName, options)
 }
 
 func (client *Client) SubscribeToPersistentSubscriptionToAll(
@@ -431,6 +1112,9 @@ func (client *Client) SubscribeToPersistentSubscriptionToAll(
 	options SubscribeToPersistentSubscriptionOptions,
 ) (*PersistentSubscription, error) {
 	options.setDefaults()
+	if err := options.validate(); err != nil {
+		return nil, err
+	}
 	handle, err := client.grpcClient.getConnectionHandle()
 	if err != nil {
 		return nil, err
@@ -439,17 +1123,8 @@ func (client *Client) SubscribeToPersistentSubscriptionToAll(
 	if !handle.SupportsFeature(FEATURE_PERSISTENT_SUBSCRIPTION_TO_ALL) {
 		return nil, unsupportedFeatureError()
 	}
-	persistentSubscriptionClient := newPersistentClient(client.grpcClient, persistentProto.NewPersistentSubscriptionsClient(handle.Connection()))
 
-	return persistentSubscriptionClient.ConnectToPersistentSubscription(
-		ctx,
-		client.Config,
-		&options,
-		handle,
-		int32(options.BufferSize),
-		"",
-		groupName,
-	)
+	return client.connectToPersistentSubscription(ctx, handle, "", groupName, options)
 }
 
 func (client *Client) CreatePersistentSubscription(
@@ -459,6 +1134,9 @@ func (client *Client) CreatePersistentSubscription(
 	options PersistentStreamSubscriptionOptions,
 ) error {
 	options.setDefaults()
+	if err := options.validate(); err != nil {
+		return err
+	}
 	handle, err := client.grpcClient.getConnectionHandle()
 	if err != nil {
 		return err
@@ -470,6 +1148,10 @@ func (client *Client) CreatePersistentSubscription(
 		options.Settings = &setts
 	}
 
+	if err := options.Settings.ConsumerStrategyName.checkSupported(handle, false); err != nil {
+		return err
+	}
+
 	return persistentSubscriptionClient.CreateStreamSubscription(ctx, client.Config, &options, handle, streamName, groupName, options.StartFrom, *options.Settings)
 }
 
@@ -479,6 +1161,9 @@ func (client *Client) CreatePersistentSubscriptionToAll(
 	options PersistentAllSubscriptionOptions,
 ) error {
 	options.setDefaults()
+	if err := options.validate(); err != nil {
+		return err
+	}
 	handle, err := client.grpcClient.getConnectionHandle()
 	if err != nil {
 		return err
@@ -501,6 +1186,10 @@ func (client *Client) CreatePersistentSubscriptionToAll(
 		options.Settings = &setts
 	}
 
+	if err := options.Settings.ConsumerStrategyName.checkSupported(handle, false); err != nil {
+		return err
+	}
+
 	return persistentSubscriptionClient.CreateAllSubscription(
 		ctx,
 		client.Config,
@@ -520,6 +1209,9 @@ func (client *Client) UpdatePersistentSubscription(
 	options PersistentStreamSubscriptionOptions,
 ) error {
 	options.setDefaults()
+	if err := options.validate(); err != nil {
+		return err
+	}
 	handle, err := client.grpcClient.getConnectionHandle()
 	if err != nil {
 		return err
@@ -531,6 +1223,10 @@ func (client *Client) UpdatePersistentSubscription(
 		options.Settings = &setts
 	}
 
+	if err := options.Settings.ConsumerStrategyName.checkSupported(handle, true); err != nil {
+		return err
+	}
+
 	return persistentSubscriptionClient.UpdateStreamSubscription(ctx, client.Config, &options, handle, streamName, groupName, options.StartFrom, *options.Settings)
 }
 
@@ -540,6 +1236,9 @@ func (client *Client) UpdatePersistentSubscriptionToAll(
 	options PersistentAllSubscriptionOptions,
 ) error {
 	options.setDefaults()
+	if err := options.validate(); err != nil {
+		return err
+	}
 	handle, err := client.grpcClient.getConnectionHandle()
 	if err != nil {
 		return err
@@ -551,6 +1250,15 @@ func (client *Client) UpdatePersistentSubscriptionToAll(
 
 	persistentSubscriptionClient := newPersistentClient(client.grpcClient, persistentProto.NewPersistentSubscriptionsClient(handle.Connection()))
 
+	if options.Settings == nil {
+		setts := SubscriptionSettingsDefault()
+		options.Settings = &setts
+	}
+
+	if err := options.Settings.ConsumerStrategyName.checkSupported(handle, true); err != nil {
+		return err
+	}
+
 	return persistentSubscriptionClient.UpdateAllSubscription(ctx, client.Config, &options, handle, groupName, options.StartFrom, *options.Settings)
 }
 
@@ -707,9 +1415,10 @@ func readInternal(
 	handle *connectionHandle,
 	streamsClient api.StreamsClient,
 	readRequest *api.ReadReq,
+	compressor string,
 ) (*ReadStream, error) {
 	var headers, trailers metadata.MD
-	callOptions := []grpc.CallOption{grpc.Header(&headers), grpc.Trailer(&trailers)}
+	callOptions := withCompressor([]grpc.CallOption{grpc.Header(&headers), grpc.Trailer(&trailers)}, compressor)
 	callOptions, ctx, cancel := configureGrpcCall(parent, client.Config, options, callOptions)
 	result, err := streamsClient.Read(ctx, readRequest, callOptions...)
 	if err != nil {
@@ -723,12 +1432,13 @@ func readInternal(
 	}
 
 	params := readStreamParams{
-		client:   client.grpcClient,
-		handle:   handle,
-		cancel:   cancel,
-		inner:    result,
-		headers:  &headers,
-		trailers: &trailers,
+		client:     client.grpcClient,
+		handle:     handle,
+		cancel:     cancel,
+		inner:      result,
+		headers:    &headers,
+		trailers:   &trailers,
+		compressor: compressor,
 	}
 
 	return newReadStream(params), nil
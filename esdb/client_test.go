@@ -32,6 +32,7 @@ func TestSingleNode(t *testing.T) {
 	AppendTests(t, emptyContainer, emptyContainerClient)
 	ConnectionTests(t, emptyContainer)
 	DeleteTests(t, emptyContainerClient)
+	CopyStreamTests(t, emptyContainerClient)
 	PersistentSubReadTests(t, emptyContainerClient)
 	PersistentSubTests(t, emptyContainerClient, populatedContainerClient)
 	TLSTests(t, emptyContainer)
@@ -0,0 +1,232 @@
+package esdb
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// Codec serializes the values appended with Append.
+type Codec interface {
+	ContentType() ContentType
+	Marshal(value interface{}) ([]byte, error)
+	Unmarshal(data []byte, value interface{}) error
+}
+
+// JSONCodec serializes values with encoding/json.
+type JSONCodec struct{}
+
+func (JSONCodec) ContentType() ContentType {
+	return JsonContentType
+}
+
+func (JSONCodec) Marshal(value interface{}) ([]byte, error) {
+	return json.Marshal(value)
+}
+
+func (JSONCodec) Unmarshal(data []byte, value interface{}) error {
+	return json.Unmarshal(data, value)
+}
+
+var codecs = struct {
+	sync.RWMutex
+	byContentType map[string]Codec
+}{byContentType: map[string]Codec{"application/json": JSONCodec{}}}
+
+// RegisterCodec sets the codec decoding the events recorded with the given content type, such as
+// "application/octet-stream". JSONCodec decodes "application/json" events unless overridden.
+func RegisterCodec(contentType string, codec Codec) error {
+	if contentType == "" || codec == nil {
+		return invalidArgumentError("content type and codec are required")
+	}
+
+	codecs.Lock()
+	defer codecs.Unlock()
+	codecs.byContentType[contentType] = codec
+	return nil
+}
+
+func codecFor(contentType string) (Codec, bool) {
+	codecs.RLock()
+	defer codecs.RUnlock()
+	codec, ok := codecs.byContentType[contentType]
+	return codec, ok
+}
+
+var eventTypes = struct {
+	sync.RWMutex
+	names map[reflect.Type]string
+}{names: make(map[reflect.Type]string)}
+
+// RegisterEventType overrides the event type used for values of type T, which is the name of the Go type otherwise.
+func RegisterEventType[T any](eventType string) error {
+	if eventType == "" {
+		return invalidArgumentError("event type can't be empty")
+	}
+
+	eventTypes.Lock()
+	defer eventTypes.Unlock()
+	eventTypes.names[valueType[T]()] = eventType
+	return nil
+}
+
+// EventTypeOf returns the event type used for values of type T.
+func EventTypeOf[T any]() string {
+	return eventTypeOf(valueType[T]())
+}
+
+func eventTypeOf(t reflect.Type) string {
+	eventTypes.RLock()
+	name, ok := eventTypes.names[t]
+	eventTypes.RUnlock()
+
+	if ok {
+		return name
+	}
+
+	if t.Name() == "" {
+		return t.String()
+	}
+
+	return t.Name()
+}
+
+// valueType returns the type of T, ignoring pointers so that T and *T share the same event type.
+func valueType[T any]() reflect.Type {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t
+}
+
+// Encode serializes the value with the codec, JSONCodec when nil, into an event whose type is given by EventTypeOf.
+// When T is an interface, the event type is the one of the value it holds.
+func Encode[T any](codec Codec, value T) (EventData, error) {
+	if codec == nil {
+		codec = JSONCodec{}
+	}
+
+	event, err := encodeValue(codec, value)
+	var esdbErr *Error
+	if err != nil && !errors.As(err, &esdbErr) {
+		return EventData{}, &Error{code: ErrorParsing, err: err}
+	}
+
+	return event, err
+}
+
+func encodeValue[T any](codec Codec, value T) (EventData, error) {
+	t := valueType[T]()
+	// An interface type says nothing about the event, which is named after the type of the value it holds.
+	if t.Kind() == reflect.Interface {
+		t = reflect.TypeOf(value)
+		if t == nil {
+			return EventData{}, invalidArgumentError("can't serialize a nil %s", valueType[T]())
+		}
+
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+	}
+
+	data, err := codec.Marshal(value)
+	if err != nil {
+		return EventData{}, fmt.Errorf("could not serialize %s: %w", eventTypeOf(t), err)
+	}
+
+	return EventData{
+		EventType:   eventTypeOf(t),
+		ContentType: codec.ContentType(),
+		Data:        data,
+	}, nil
+}
+
+// Decode deserializes the event into a value of type T, using the codec registered for its content type. The event
+// type must be the one EventTypeOf returns for T. Resolved links decode the event they point to. When T is an
+// interface, the event is decoded into the type registered with RegisterEventType for its event type, which must
+// implement T.
+func Decode[T any](event *ResolvedEvent) (T, error) {
+	var value T
+	t := valueType[T]()
+	if t.Kind() != reflect.Interface || event.Event == nil {
+		err := decodeInto(event, &value, t)
+		return value, err
+	}
+
+	concrete, ok := registeredTypeOf(event.Event.EventType, t)
+	if !ok {
+		return value, &Error{code: ErrorParsing, err: fmt.Errorf("no type implementing %s is registered for event type '%s' of event %s", t, event.Event.EventType, event.Event.EventID)}
+	}
+
+	target := reflect.New(concrete)
+	if err := decodeInto(event, target.Interface(), concrete); err != nil {
+		return value, err
+	}
+
+	if concrete.Implements(t) {
+		value = target.Elem().Interface().(T)
+	} else {
+		value = target.Interface().(T)
+	}
+
+	return value, nil
+}
+
+// registeredTypeOf returns the type registered for the event type whose values, or pointers to them, implement iface.
+func registeredTypeOf(eventType string, iface reflect.Type) (reflect.Type, bool) {
+	eventTypes.RLock()
+	defer eventTypes.RUnlock()
+
+	for t, name := range eventTypes.names {
+		if name == eventType && (t.Implements(iface) || reflect.PtrTo(t).Implements(iface)) {
+			return t, true
+		}
+	}
+
+	return nil, false
+}
+
+// DecodeAs deserializes the event into the value v points to, following the rules of Decode.
+func (resolved ResolvedEvent) DecodeAs(v interface{}) error {
+	target := reflect.TypeOf(v)
+	if target == nil || target.Kind() != reflect.Ptr || reflect.ValueOf(v).IsNil() {
+		return invalidArgumentError("DecodeAs requires a non-nil pointer, got %T", v)
+	}
+
+	t := target.Elem()
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return decodeInto(&resolved, v, t)
+}
+
+func decodeInto(resolved *ResolvedEvent, v interface{}, t reflect.Type) error {
+	event := resolved.Event
+	if event == nil && resolved.Link == nil {
+		return invalidArgumentError("can't decode an empty event")
+	}
+
+	if event == nil {
+		return &Error{code: ErrorParsing, err: fmt.Errorf("can't decode the broken link at revision %d of stream '%s'", resolved.Link.EventNumber, resolved.Link.StreamID)}
+	}
+
+	if expected := eventTypeOf(t); event.EventType != expected {
+		return &Error{code: ErrorParsing, err: fmt.Errorf("event %s has type '%s', which doesn't match %s (expected '%s')", event.EventID, event.EventType, t, expected)}
+	}
+
+	codec, ok := codecFor(event.ContentType)
+	if !ok {
+		return &Error{code: ErrorParsing, err: fmt.Errorf("no codec is registered for content type '%s' of event %s", event.ContentType, event.EventID)}
+	}
+
+	if err := codec.Unmarshal(event.Data, v); err != nil {
+		return &Error{code: ErrorParsing, err: fmt.Errorf("could not deserialize event %s as %s: %w", event.EventID, t, err)}
+	}
+
+	return nil
+}
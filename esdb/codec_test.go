@@ -0,0 +1,132 @@
+package esdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type orderPlaced struct {
+	OrderID string `json:"orderId"`
+}
+
+type orderShipped struct{}
+
+type orderEvent interface {
+	order() string
+}
+
+type orderCancelled struct {
+	OrderID string `json:"orderId"`
+}
+
+func (e orderCancelled) order() string {
+	return e.OrderID
+}
+
+func TestEventTypeOf(t *testing.T) {
+	assert.Equal(t, "orderPlaced", EventTypeOf[orderPlaced]())
+	assert.Equal(t, "orderPlaced", EventTypeOf[*orderPlaced]())
+	assert.Equal(t, "map[string]int", EventTypeOf[map[string]int]())
+
+	require.NoError(t, RegisterEventType[orderShipped]("OrderShipped"))
+	assert.Equal(t, "OrderShipped", EventTypeOf[orderShipped]())
+	assertInvalidArgument(t, RegisterEventType[orderShipped](""))
+}
+
+func TestEncodeValue(t *testing.T) {
+	event, err := encodeValue(JSONCodec{}, orderPlaced{OrderID: "42"})
+	require.NoError(t, err)
+	assert.Equal(t, "orderPlaced", event.EventType)
+	assert.Equal(t, JsonContentType, event.ContentType)
+	assert.JSONEq(t, `{"orderId":"42"}`, string(event.Data))
+
+	_, err = encodeValue(JSONCodec{}, make(chan int))
+	assert.Error(t, err)
+}
+
+func TestEncodeDefaultsToJSON(t *testing.T) {
+	event, err := Encode(nil, orderPlaced{OrderID: "42"})
+	require.NoError(t, err)
+	assert.Equal(t, JsonContentType, event.ContentType)
+	assert.JSONEq(t, `{"orderId":"42"}`, string(event.Data))
+
+	_, err = Encode(nil, make(chan int))
+	var esdbErr *Error
+	require.ErrorAs(t, err, &esdbErr)
+	assert.Equal(t, ErrorParsing, esdbErr.Code())
+}
+
+func TestDecode(t *testing.T) {
+	event := &ResolvedEvent{Event: &RecordedEvent{
+		EventType:   "orderPlaced",
+		ContentType: "application/json",
+		Data:        []byte(`{"orderId":"42"}`),
+	}}
+
+	value, err := Decode[orderPlaced](event)
+	require.NoError(t, err)
+	assert.Equal(t, "42", value.OrderID)
+
+	pointer, err := Decode[*orderPlaced](event)
+	require.NoError(t, err)
+	assert.Equal(t, "42", pointer.OrderID)
+
+	var target orderPlaced
+	require.NoError(t, event.DecodeAs(&target))
+	assert.Equal(t, "42", target.OrderID)
+	assertInvalidArgument(t, event.DecodeAs(target))
+
+	_, err = Decode[orderShipped](event)
+	esdbErr, ok := FromError(err)
+	require.False(t, ok)
+	assert.Equal(t, ErrorParsing, esdbErr.Code())
+	assert.Contains(t, err.Error(), "doesn't match")
+
+	event.Event.ContentType = "application/octet-stream"
+	_, err = Decode[orderPlaced](event)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no codec")
+
+	require.NoError(t, RegisterCodec("application/octet-stream", JSONCodec{}))
+	defer func() {
+		codecs.Lock()
+		delete(codecs.byContentType, "application/octet-stream")
+		codecs.Unlock()
+	}()
+	_, err = Decode[orderPlaced](event)
+	require.NoError(t, err)
+
+	_, err = Decode[orderPlaced](&ResolvedEvent{Link: &RecordedEvent{StreamID: "$ce-order"}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "broken link")
+}
+
+func TestInterfaceValuesUseTheirDynamicType(t *testing.T) {
+	require.NoError(t, RegisterEventType[orderCancelled]("OrderCancelled"))
+
+	var value orderEvent = orderCancelled{OrderID: "42"}
+	event, err := encodeValue(JSONCodec{}, value)
+	require.NoError(t, err)
+	assert.Equal(t, "OrderCancelled", event.EventType)
+
+	_, err = encodeValue[orderEvent](JSONCodec{}, nil)
+	assertInvalidArgument(t, err)
+
+	resolved := &ResolvedEvent{Event: &RecordedEvent{
+		EventType:   event.EventType,
+		ContentType: "application/json",
+		Data:        event.Data,
+	}}
+
+	decoded, err := Decode[orderEvent](resolved)
+	require.NoError(t, err)
+	assert.Equal(t, orderCancelled{OrderID: "42"}, decoded)
+
+	resolved.Event.EventType = "orderPlaced"
+	_, err = Decode[orderEvent](resolved)
+	esdbErr, ok := FromError(err)
+	require.False(t, ok)
+	assert.Equal(t, ErrorParsing, esdbErr.Code())
+}
@@ -0,0 +1,77 @@
+package esdb
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// GzipCompression compresses requests with gzip.
+	GzipCompression = gzip.Name
+	// NoCompression disables compression, overriding Configuration.Compression when set on an operation.
+	NoCompression = "identity"
+)
+
+func validateCompression(compression string) error {
+	switch compression {
+	case "", GzipCompression, NoCompression:
+		return nil
+	}
+
+	return invalidArgumentError("unsupported compression '%s', use '%s' or '%s'", compression, GzipCompression, NoCompression)
+}
+
+func parseCompression(k, v string, config *Configuration) error {
+	compression := strings.ToLower(v)
+	if err := validateCompression(compression); err != nil {
+		return fmt.Errorf("Setting '%s' must be either %s or %s", k, GzipCompression, NoCompression)
+	}
+
+	config.Compression = compression
+	return nil
+}
+
+// compressor resolves the compression of an operation. It returns an empty string when the operation shouldn't be
+// compressed, either because it is disabled or because the server rejected compressed calls before.
+func (client *Client) compressor(override string) string {
+	compression := override
+	if compression == "" {
+		compression = client.Config.Compression
+	}
+
+	if compression == "" || compression == NoCompression || client.grpcClient.compressionRejected() {
+		return ""
+	}
+
+	return compression
+}
+
+func withCompressor(callOptions []grpc.CallOption, compressor string) []grpc.CallOption {
+	if compressor == "" {
+		return callOptions
+	}
+
+	return append(callOptions, grpc.UseCompressor(compressor))
+}
+
+// isCompressionRejected reports whether a call failed because the server can't decompress it.
+func isCompressionRejected(err error) bool {
+	return status.Code(err) == codes.Unimplemented && strings.Contains(status.Convert(err).Message(), "compress")
+}
+
+func (client *grpcClient) compressionRejected() bool {
+	return client.noCompression != nil && atomic.LoadInt32(client.noCompression) != 0
+}
+
+// rejectCompression disables compression for the lifetime of the client.
+func (client *grpcClient) rejectCompression(compressor string) {
+	if atomic.CompareAndSwapInt32(client.noCompression, 0, 1) {
+		client.logger.warn("server rejected %s compressed calls, disabling compression", compressor)
+	}
+}
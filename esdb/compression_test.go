@@ -0,0 +1,42 @@
+package esdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCompressorResolution(t *testing.T) {
+	client := &Client{
+		Config:     &Configuration{Compression: GzipCompression},
+		grpcClient: &grpcClient{logger: &logger{}, noCompression: new(int32)},
+	}
+
+	assert.Equal(t, GzipCompression, client.compressor(""))
+	assert.Equal(t, "", client.compressor(NoCompression))
+
+	client.Config.Compression = ""
+	assert.Equal(t, "", client.compressor(""))
+	assert.Equal(t, GzipCompression, client.compressor(GzipCompression))
+
+	client.grpcClient.rejectCompression(GzipCompression)
+	assert.Equal(t, "", client.compressor(GzipCompression))
+}
+
+func TestIsCompressionRejected(t *testing.T) {
+	assert.True(t, isCompressionRejected(status.Error(codes.Unimplemented, `grpc: Decompressor is not installed for grpc-encoding "gzip"`)))
+	assert.False(t, isCompressionRejected(status.Error(codes.Unimplemented, "unknown service")))
+	assert.False(t, isCompressionRejected(status.Error(codes.Unavailable, "compress")))
+}
+
+func TestCompressionValidation(t *testing.T) {
+	opts := ReadStreamOptions{Compression: "lz4"}
+	opts.setDefaults()
+	assertInvalidArgument(t, opts.validate())
+
+	appendOpts := AppendToStreamOptions{Compression: GzipCompression}
+	appendOpts.setDefaults()
+	assert.NoError(t, appendOpts.validate())
+}
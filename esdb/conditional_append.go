@@ -0,0 +1,71 @@
+package esdb
+
+import (
+	"context"
+	"errors"
+)
+
+// ConditionalWriteStatus is the outcome of ConditionalAppendToStream.
+type ConditionalWriteStatus int
+
+const (
+	ConditionalWriteSucceeded ConditionalWriteStatus = iota
+	ConditionalWriteVersionMismatch
+	ConditionalWriteStreamDeleted
+)
+
+func (s ConditionalWriteStatus) String() string {
+	switch s {
+	case ConditionalWriteSucceeded:
+		return "Succeeded"
+	case ConditionalWriteVersionMismatch:
+		return "VersionMismatch"
+	case ConditionalWriteStreamDeleted:
+		return "StreamDeleted"
+	}
+
+	return "Unknown"
+}
+
+// ConditionalWriteResult is returned by ConditionalAppendToStream.
+type ConditionalWriteResult struct {
+	Status ConditionalWriteStatus
+	// Set when Status is ConditionalWriteSucceeded.
+	WriteResult *WriteResult
+	// Revision of the stream after the append when it succeeded, or the revision it was at when the expected revision
+	// didn't match. Nil when the stream is deleted.
+	CurrentRevision StreamState
+}
+
+// ConditionalAppendToStream appends events like AppendToStream, but reports expected revision mismatches and deleted
+// streams as a status instead of an error. Any other failure is still returned as an error.
+func (client *Client) ConditionalAppendToStream(
+	context context.Context,
+	streamID string,
+	opts AppendToStreamOptions,
+	events ...EventData,
+) (*ConditionalWriteResult, error) {
+	result, err := client.AppendToStream(context, streamID, opts, events...)
+	if err == nil {
+		return &ConditionalWriteResult{
+			Status:          ConditionalWriteSucceeded,
+			WriteResult:     result,
+			CurrentRevision: result.NextExpectedRevision,
+		}, nil
+	}
+
+	var wrongErr *WrongExpectedVersionError
+	if errors.As(err, &wrongErr) {
+		return &ConditionalWriteResult{
+			Status:          ConditionalWriteVersionMismatch,
+			CurrentRevision: wrongErr.Actual(),
+		}, nil
+	}
+
+	var esdbErr *Error
+	if errors.As(err, &esdbErr) && esdbErr.Code() == ErrorStreamDeleted {
+		return &ConditionalWriteResult{Status: ConditionalWriteStreamDeleted}, nil
+	}
+
+	return nil, err
+}
@@ -48,6 +48,14 @@ type Configuration struct {
 	// If RootCAs is nil, TLS uses the host's root CA set.
 	RootCAs *x509.CertPool // Defaults to nil.
 
+	// Minimum and maximum TLS versions, tls.VersionTLS12 or tls.VersionTLS13. Defaults to the crypto/tls defaults.
+	TLSMinVersion uint16
+	TLSMaxVersion uint16
+
+	// Cipher suites allowed for TLS 1.2, see FIPSCipherSuites for regulated deployments. TLS 1.3 cipher suites are
+	// not configurable. Defaults to the crypto/tls defaults.
+	TLSCipherSuites []uint16
+
 	// Allows to skip certificate validation.
 	SkipCertificateVerification bool // Defaults to false.
 
@@ -74,10 +82,45 @@ type Configuration struct {
 	// DeadlineExceeded. Defaults to 10 seconds.
 	DefaultDeadline *time.Duration
 
+	// Enables gRPC transparent retries of idempotent calls (stream reads, server features and persistent
+	// subscription listing) failing with UNAVAILABLE. Gossip calls are excluded since the discovery process already
+	// retries them across candidates. Ignored when RetryPolicy is set, so reads are never retried by both layers.
+	// Defaults to false.
+	EnableGrpcRetries bool
+
+	// Retries appends and reads failing with a transient error, unless overridden per call. It takes over from
+	// EnableGrpcRetries when both are set. Defaults to no retries.
+	RetryPolicy *RetryPolicy
+
+	// Run in order for every event appended with EventData, before it is sent. Raw appends are not intercepted.
+	AppendInterceptors []AppendInterceptor
+
+	// Compresses appends and reads with the given algorithm, GzipCompression being the only one supported. If the
+	// server rejects compressed calls, the client falls back to uncompressed ones. Defaults to no compression.
+	Compression string
+
+	// Initial HTTP/2 flow-control window of every call, in bytes, which bounds how much a subscription or read
+	// receives before waiting for the client to acknowledge it. Values below 64KB are ignored by gRPC. Setting it
+	// disables the dynamic window sizing of gRPC. Defaults to 64KB.
+	InitialWindowSize int32
+
+	// Initial HTTP/2 flow-control window shared by all the calls of a connection, in bytes. Values below 64KB are
+	// ignored by gRPC. Defaults to 64KB.
+	InitialConnWindowSize int32
+
+	// Size of the buffer used to read from the connection, in bytes. Defaults to 32KB.
+	ReadBufferSize int
+
 	// Logging abstraction used by the client.
 	Logger LoggingFunc
 }
 
+// grpcRetriesEnabled tells whether the connection retries idempotent calls at the gRPC level, which only happens
+// when no client-side RetryPolicy is configured.
+func (conf *Configuration) grpcRetriesEnabled() bool {
+	return conf.EnableGrpcRetries && conf.RetryPolicy == nil
+}
+
 func (conf *Configuration) applyLogger(level LogLevel, format string, args ...interface{}) {
 	if conf.Logger != nil {
 		conf.Logger(level, format, args)
@@ -269,11 +312,51 @@ func parseSetting(k, v string, config *Configuration) error {
 		if err != nil {
 			return err
 		}
+	case "tlsminversion":
+		err := parseTLSVersion(k, v, &config.TLSMinVersion)
+		if err != nil {
+			return err
+		}
+	case "tlsmaxversion":
+		err := parseTLSVersion(k, v, &config.TLSMaxVersion)
+		if err != nil {
+			return err
+		}
+	case "tlsciphersuites":
+		err := parseCipherSuites(k, v, config)
+		if err != nil {
+			return err
+		}
 	case "tlsverifycert":
 		err := parseBoolSetting(k, v, &config.SkipCertificateVerification, true)
 		if err != nil {
 			return err
 		}
+	case "compression":
+		err := parseCompression(k, v, config)
+		if err != nil {
+			return err
+		}
+	case "grpcretries":
+		err := parseBoolSetting(k, v, &config.EnableGrpcRetries, false)
+		if err != nil {
+			return err
+		}
+	case "initialwindowsize":
+		err := parseWindowSizeSetting(k, v, &config.InitialWindowSize)
+		if err != nil {
+			return err
+		}
+	case "initialconnwindowsize":
+		err := parseWindowSizeSetting(k, v, &config.InitialConnWindowSize)
+		if err != nil {
+			return err
+		}
+	case "readbuffersize":
+		err := parseIntSetting(k, v, &config.ReadBufferSize)
+		if err != nil {
+			return err
+		}
 	case "defaultdeadline":
 		config.DefaultDeadline = new(time.Duration)
 		err := parseDurationAsMs(k, v, config.DefaultDeadline)
@@ -325,6 +408,16 @@ func parseIntSetting(k, v string, i *int) error {
 	return nil
 }
 
+func parseWindowSizeSetting(k, v string, size *int32) error {
+	parsed, err := strconv.ParseInt(v, 10, 32)
+	if err != nil || parsed <= 0 {
+		return fmt.Errorf("Setting '%s' must be a positive 32 bits integer value", k)
+	}
+
+	*size = int32(parsed)
+	return nil
+}
+
 func parseNodePreference(v string, config *Configuration) error {
 	switch strings.ToLower(v) {
 	case "follower":
@@ -1,6 +1,7 @@
 package esdb_test
 
 import (
+	"crypto/tls"
 	"testing"
 	"time"
 
@@ -537,3 +538,80 @@ func TestConnectionStringWithDefaultDeadline(t *testing.T) {
 	assert.NotNil(t, config.DefaultDeadline)
 	assert.Equal(t, *config.DefaultDeadline, 60*time.Second)
 }
+
+func TestConnectionStringWithGrpcRetries(t *testing.T) {
+	config, err := esdb.ParseConnectionString("esdb://localhost")
+	require.NoError(t, err)
+	assert.False(t, config.EnableGrpcRetries)
+
+	config, err = esdb.ParseConnectionString("esdb://localhost?grpcRetries=true")
+	require.NoError(t, err)
+	assert.True(t, config.EnableGrpcRetries)
+
+	_, err = esdb.ParseConnectionString("esdb://localhost?grpcRetries=maybe")
+	require.Error(t, err)
+}
+
+func TestConnectionStringWithTLSRestrictions(t *testing.T) {
+	config, err := esdb.ParseConnectionString("esdb://localhost?tlsMinVersion=1.2&tlsMaxVersion=1.2&tlsCipherSuites=fips")
+	require.NoError(t, err)
+	assert.Equal(t, uint16(tls.VersionTLS12), config.TLSMinVersion)
+	assert.Equal(t, uint16(tls.VersionTLS12), config.TLSMaxVersion)
+	assert.Equal(t, esdb.FIPSCipherSuites, config.TLSCipherSuites)
+
+	config, err = esdb.ParseConnectionString("esdb://localhost?tlsCipherSuites=TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384")
+	require.NoError(t, err)
+	assert.Equal(t, []uint16{tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256, tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384}, config.TLSCipherSuites)
+
+	_, err = esdb.ParseConnectionString("esdb://localhost?tlsMinVersion=1.0")
+	require.Error(t, err)
+
+	_, err = esdb.ParseConnectionString("esdb://localhost?tlsCipherSuites=TLS_RSA_WITH_RC4_128_SHA")
+	require.Error(t, err)
+}
+
+func TestNewClientValidatesTLSRestrictions(t *testing.T) {
+	config, err := esdb.ParseConnectionString("esdb://localhost?tlsMinVersion=1.3&tlsMaxVersion=1.2")
+	require.NoError(t, err)
+
+	_, err = esdb.NewClient(config)
+	esdbErr, _ := esdb.FromError(err)
+	require.NotNil(t, esdbErr)
+	assert.Equal(t, esdb.ErrorInvalidArgument, esdbErr.Code())
+
+	config, err = esdb.ParseConnectionString("esdb://localhost?tlsMinVersion=1.3&tlsCipherSuites=fips")
+	require.NoError(t, err)
+
+	_, err = esdb.NewClient(config)
+	require.Error(t, err)
+
+	config, err = esdb.ParseConnectionString("esdb://localhost?tls=false&tlsMinVersion=1.3&tlsCipherSuites=fips")
+	require.NoError(t, err)
+
+	client, err := esdb.NewClient(config)
+	require.NoError(t, err)
+	require.NoError(t, client.Close())
+}
+
+func TestConnectionStringWithCompression(t *testing.T) {
+	config, err := esdb.ParseConnectionString("esdb://localhost?compression=GZIP")
+	require.NoError(t, err)
+	assert.Equal(t, esdb.GzipCompression, config.Compression)
+
+	_, err = esdb.ParseConnectionString("esdb://localhost?compression=brotli")
+	require.Error(t, err)
+}
+
+func TestConnectionStringWithFlowControl(t *testing.T) {
+	config, err := esdb.ParseConnectionString("esdb://localhost?initialWindowSize=1048576&initialConnWindowSize=4194304&readBufferSize=65536")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1048576), config.InitialWindowSize)
+	assert.Equal(t, int32(4194304), config.InitialConnWindowSize)
+	assert.Equal(t, 65536, config.ReadBufferSize)
+
+	_, err = esdb.ParseConnectionString("esdb://localhost?initialWindowSize=4294967296")
+	require.Error(t, err)
+
+	_, err = esdb.ParseConnectionString("esdb://localhost?initialConnWindowSize=0")
+	require.Error(t, err)
+}
@@ -0,0 +1,114 @@
+package esdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// CopyStreamProgress reports how far a CopyStream call went.
+type CopyStreamProgress struct {
+	// Number of events read from the source stream.
+	Read uint64
+	// Number of events appended to the destination stream.
+	Copied uint64
+	// Number of events the transform function chose to skip.
+	Skipped uint64
+	// The source stream revision to read from to resume the copy.
+	NextRevision StreamRevision
+	// Result of the last append to the destination stream, nil if nothing got appended yet.
+	LastWrite *WriteResult
+}
+
+// CopyStream reads the events of the source stream in batches and appends them to the destination stream, optionally
+// transforming or filtering them on the way. On failure, the returned progress tells where to resume from.
+func (client *Client) CopyStream(
+	ctx context.Context,
+	sourceStreamID string,
+	destinationStreamID string,
+	opts CopyStreamOptions,
+) (*CopyStreamProgress, error) {
+	opts.setDefaults()
+
+	progress := CopyStreamProgress{}
+	from := opts.From
+	expected := opts.ExpectedRevision
+
+	if revision, ok := from.(StreamRevision); ok {
+		progress.NextRevision = revision
+	}
+
+	for {
+		stream, err := client.ReadStream(ctx, sourceStreamID, ReadStreamOptions{
+			From:           from,
+			ResolveLinkTos: opts.ResolveLinkTos,
+			Authenticated:  opts.Authenticated,
+			Deadline:       opts.Deadline,
+		}, opts.BatchSize)
+
+		if err != nil {
+			return &progress, err
+		}
+
+		var batch []EventData
+		var read, skipped uint64
+		next := progress.NextRevision
+
+		for {
+			event, err := stream.Recv()
+
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			if err != nil {
+				stream.Close()
+				return &progress, fmt.Errorf("failed to read source stream '%s': %w", sourceStreamID, err)
+			}
+
+			read++
+			next = Revision(event.OriginalEvent().EventNumber + 1)
+
+			if data, ok := opts.Transform(*event); ok {
+				batch = append(batch, data)
+			} else {
+				skipped++
+			}
+		}
+
+		stream.Close()
+
+		if len(batch) > 0 {
+			result, err := client.AppendToStream(ctx, destinationStreamID, AppendToStreamOptions{
+				ExpectedRevision: expected,
+				Authenticated:    opts.Authenticated,
+				Deadline:         opts.Deadline,
+			}, batch...)
+
+			if err != nil {
+				return &progress, fmt.Errorf("failed to append to destination stream '%s': %w", destinationStreamID, err)
+			}
+
+			progress.LastWrite = result
+			if expected != nil {
+				expected = result.NextExpectedRevision
+			}
+		}
+
+		progress.Read += read
+		progress.Copied += uint64(len(batch))
+		progress.Skipped += skipped
+		progress.NextRevision = next
+
+		if read > 0 && opts.Progress != nil {
+			opts.Progress(progress)
+		}
+
+		if read < opts.BatchSize {
+			return &progress, nil
+		}
+
+		from = next
+	}
+}
@@ -0,0 +1,42 @@
+package esdb
+
+import "time"
+
+type CopyStreamOptions struct {
+	// Maps a source event to the event appended to the destination stream. Returning false skips the event. By
+	// default, events are copied as-is, keeping their id.
+	Transform func(ResolvedEvent) (EventData, bool)
+	// Where to start reading the source stream from. Use the NextRevision of a previous CopyStreamProgress to resume
+	// an interrupted copy.
+	From StreamPosition
+	// Expected revision of the destination stream for the first append, every later append expecting the revision
+	// the previous one wrote. Defaults to nil, which appends regardless of what the destination stream holds.
+	ExpectedRevision ExpectedRevision
+	// How many source events are read and appended at a time.
+	BatchSize      uint64 // Defaults to 500.
+	ResolveLinkTos bool
+	// Called after each batch got appended to the destination stream.
+	Progress      func(CopyStreamProgress)
+	Authenticated *Credentials
+	Deadline      *time.Duration
+}
+
+func (o *CopyStreamOptions) setDefaults() {
+	if o.From == nil {
+		o.From = Start{}
+	}
+
+	if o.BatchSize == 0 {
+		o.BatchSize = 500
+	}
+
+	if o.Transform == nil {
+		o.Transform = func(event ResolvedEvent) (EventData, bool) {
+			if event.Event == nil {
+				return EventData{}, false
+			}
+
+			return EventDataFromRecordedEvent(event.Event), true
+		}
+	}
+}
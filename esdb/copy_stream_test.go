@@ -0,0 +1,164 @@
+package esdb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func CopyStreamTests(t *testing.T, db *esdb.Client) {
+	t.Run("CopyStreamTests", func(t *testing.T) {
+		t.Run("copyStreamInBatches", copyStreamInBatches(db))
+		t.Run("copyStreamWithTransform", copyStreamWithTransform(db))
+		t.Run("copyStreamToNewStream", copyStreamToNewStream(db))
+		t.Run("renameStream", renameStream(db))
+		t.Run("renameStreamDryRun", renameStreamDryRun(db))
+	})
+}
+
+func copyStreamInBatches(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		source := NAME_GENERATOR.Generate()
+		destination := NAME_GENERATOR.Generate()
+
+		_, err := db.AppendToStream(context.Background(), source, esdb.AppendToStreamOptions{}, testCreateEvents(5)...)
+		require.NoError(t, err)
+
+		batches := 0
+		progress, err := db.CopyStream(context.Background(), source, destination, esdb.CopyStreamOptions{
+			BatchSize: 2,
+			Progress: func(esdb.CopyStreamProgress) {
+				batches++
+			},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, batches)
+		assert.Equal(t, uint64(5), progress.Read)
+		assert.Equal(t, uint64(5), progress.Copied)
+		assert.Equal(t, esdb.Revision(5), progress.NextRevision)
+
+		stream, err := db.ReadStream(context.Background(), destination, esdb.ReadStreamOptions{}, 10)
+		require.NoError(t, err)
+		defer stream.Close()
+
+		events, err := collectStreamEvents(stream)
+		require.NoError(t, err)
+		assert.Len(t, events, 5)
+	}
+}
+
+func copyStreamWithTransform(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		source := NAME_GENERATOR.Generate()
+		destination := NAME_GENERATOR.Generate()
+
+		_, err := db.AppendToStream(context.Background(), source, esdb.AppendToStreamOptions{}, testCreateEvents(4)...)
+		require.NoError(t, err)
+
+		progress, err := db.CopyStream(context.Background(), source, destination, esdb.CopyStreamOptions{
+			Transform: func(event esdb.ResolvedEvent) (esdb.EventData, bool) {
+				if event.Event.EventNumber%2 == 1 {
+					return esdb.EventData{}, false
+				}
+
+				data := esdb.EventDataFromRecordedEvent(event.Event)
+				data.EventType = "Copied"
+				return data, true
+			},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, uint64(2), progress.Copied)
+		assert.Equal(t, uint64(2), progress.Skipped)
+
+		stream, err := db.ReadStream(context.Background(), destination, esdb.ReadStreamOptions{}, 10)
+		require.NoError(t, err)
+		defer stream.Close()
+
+		events, err := collectStreamEvents(stream)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		assert.Equal(t, "Copied", events[0].Event.EventType)
+	}
+}
+
+func copyStreamToNewStream(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		source := NAME_GENERATOR.Generate()
+		destination := NAME_GENERATOR.Generate()
+
+		_, err := db.AppendToStream(context.Background(), source, esdb.AppendToStreamOptions{}, testCreateEvents(5)...)
+		require.NoError(t, err)
+
+		progress, err := db.CopyStream(context.Background(), source, destination, esdb.CopyStreamOptions{
+			ExpectedRevision: esdb.NoStream{},
+			BatchSize:        2,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, uint64(5), progress.Copied)
+
+		_, err = db.CopyStream(context.Background(), source, destination, esdb.CopyStreamOptions{
+			ExpectedRevision: esdb.NoStream{},
+		})
+
+		var wrongVersion *esdb.WrongExpectedVersionError
+		assert.ErrorAs(t, err, &wrongVersion)
+	}
+}
+
+func renameStream(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		source := NAME_GENERATOR.Generate()
+		destination := NAME_GENERATOR.Generate()
+
+		_, err := db.AppendToStream(context.Background(), source, esdb.AppendToStreamOptions{}, testCreateEvents(3)...)
+		require.NoError(t, err)
+
+		result, err := db.RenameStream(context.Background(), source, destination, esdb.RenameStreamOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(3), result.Events)
+		assert.NotNil(t, result.DeleteResult)
+
+		stream, err := db.ReadStream(context.Background(), source, esdb.ReadStreamOptions{}, 10)
+		require.NoError(t, err)
+		defer stream.Close()
+
+		_, err = stream.Recv()
+		esdbErr, ok := esdb.FromError(err)
+		require.False(t, ok)
+		assert.Equal(t, esdb.ErrorResourceNotFound, esdbErr.Code())
+
+		_, err = db.RenameStream(context.Background(), destination, destination, esdb.RenameStreamOptions{})
+		esdbErr, ok = esdb.FromError(err)
+		require.False(t, ok)
+		assert.Equal(t, esdb.ErrorResourceAlreadyExists, esdbErr.Code())
+	}
+}
+
+func renameStreamDryRun(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		source := NAME_GENERATOR.Generate()
+		destination := NAME_GENERATOR.Generate()
+
+		_, err := db.AppendToStream(context.Background(), source, esdb.AppendToStreamOptions{}, testCreateEvents(3)...)
+		require.NoError(t, err)
+
+		result, err := db.RenameStream(context.Background(), source, destination, esdb.RenameStreamOptions{DryRun: true})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(3), result.Events)
+		assert.Nil(t, result.DeleteResult)
+
+		stream, err := db.ReadStream(context.Background(), source, esdb.ReadStreamOptions{}, 10)
+		require.NoError(t, err)
+		defer stream.Close()
+
+		events, err := collectStreamEvents(stream)
+		require.NoError(t, err)
+		assert.Len(t, events, 3)
+	}
+}
@@ -8,6 +8,13 @@ type DeleteStreamOptions struct {
 	ExpectedRevision ExpectedRevision
 	Authenticated    *Credentials
 	Deadline         *time.Duration
+	// Attaches the gRPC headers and trailers of the response to the result.
+	ReturnResponseMetadata bool
+	// Sets DeleteResult.LastRevision to the revision of the last event of the stream at deletion time. Unless
+	// ExpectedRevision is a StreamRevision, the revision is read before deleting and used as the expected revision,
+	// so a concurrent write makes the delete fail with ErrorWrongExpectedVersion instead of returning a stale
+	// revision.
+	ReturnLastRevision bool
 }
 
 func (o *DeleteStreamOptions) kind() operationKind {
@@ -27,3 +34,39 @@ func (o *DeleteStreamOptions) setDefaults() {
 		o.ExpectedRevision = Any{}
 	}
 }
+
+// DeleteStreamAtHeadOptions configures DeleteStreamAtHead.
+type DeleteStreamAtHeadOptions struct {
+	// Force skips the revision lookup and deletes the stream with an Any expected revision.
+	Force         bool
+	Authenticated *Credentials
+	Deadline      *time.Duration
+}
+
+func (o *DeleteStreamAtHeadOptions) kind() operationKind {
+	return RegularOperation
+}
+
+func (o *DeleteStreamAtHeadOptions) credentials() *Credentials {
+	return o.Authenticated
+}
+
+func (o *DeleteStreamAtHeadOptions) deadline() *time.Duration {
+	return o.Deadline
+}
+
+type GetStreamDeletionStatusOptions struct {
+	Authenticated *Credentials
+	Deadline      *time.Duration
+}
+
+type GetStreamLastEventOptions struct {
+	ResolveLinkTos bool
+	Authenticated  *Credentials
+	Deadline       *time.Duration
+}
+
+type StreamExistsOptions struct {
+	Authenticated *Credentials
+	Deadline      *time.Duration
+}
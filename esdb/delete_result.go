@@ -2,4 +2,9 @@ package esdb
 
 type DeleteResult struct {
 	Position Position
+	// Only set when the ReturnResponseMetadata option is.
+	ResponseMetadata *ResponseMetadata
+	// Revision of the last event of the stream when it got deleted. Only set when the ReturnLastRevision option is
+	// and the stream had events.
+	LastRevision *uint64
 }
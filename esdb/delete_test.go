@@ -14,6 +14,12 @@ func DeleteTests(t *testing.T, db *esdb.Client) {
 		t.Run("canDeleteStream", canDeleteStream(db))
 		t.Run("canTombstoneStream", canTombstoneStream(db))
 		t.Run("detectStreamDeleted", detectStreamDeleted(db))
+		t.Run("canDeleteStreamAtHead", canDeleteStreamAtHead(db))
+		t.Run("canRestoreSoftDeletedStream", canRestoreSoftDeletedStream(db))
+		t.Run("getStreamDeletionStatus", getStreamDeletionStatus(db))
+		t.Run("getStreamLastEvent", getStreamLastEvent(db))
+		t.Run("deleteStreamReturnsLastRevision", deleteStreamReturnsLastRevision(db))
+		t.Run("getStreamLength", getStreamLength(db))
 	})
 }
 func canDeleteStream(db *esdb.Client) TestCall {
@@ -80,3 +86,172 @@ func detectStreamDeleted(db *esdb.Client) TestCall {
 		require.Equal(t, esdbErr.Code(), esdb.ErrorStreamDeleted)
 	}
 }
+
+func canDeleteStreamAtHead(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		streamID := NAME_GENERATOR.Generate()
+
+		_, err := db.AppendToStream(context.Background(), streamID, esdb.AppendToStreamOptions{}, createTestEvent(), createTestEvent())
+		require.NoError(t, err)
+
+		deleteResult, err := db.DeleteStreamAtHead(context.Background(), streamID, esdb.DeleteStreamAtHeadOptions{})
+		require.NoError(t, err)
+		assert.True(t, deleteResult.Position.Commit > 0)
+
+		_, err = db.DeleteStreamAtHead(context.Background(), NAME_GENERATOR.Generate(), esdb.DeleteStreamAtHeadOptions{})
+		esdbErr, ok := esdb.FromError(err)
+		require.False(t, ok)
+		require.Equal(t, esdb.ErrorResourceNotFound, esdbErr.Code())
+	}
+}
+
+func canRestoreSoftDeletedStream(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		streamID := NAME_GENERATOR.Generate()
+
+		_, err := db.AppendToStream(context.Background(), streamID, esdb.AppendToStreamOptions{}, createTestEvent(), createTestEvent())
+		require.NoError(t, err)
+
+		_, err = db.RestoreStream(context.Background(), streamID, esdb.RestoreStreamOptions{})
+		esdbErr, ok := esdb.FromError(err)
+		require.False(t, ok)
+		require.Equal(t, esdb.ErrorInvalidArgument, esdbErr.Code())
+
+		_, err = db.DeleteStream(context.Background(), streamID, esdb.DeleteStreamOptions{})
+		require.NoError(t, err)
+
+		_, err = db.RestoreStream(context.Background(), streamID, esdb.RestoreStreamOptions{})
+		require.NoError(t, err)
+
+		stream, err := db.ReadStream(context.Background(), streamID, esdb.ReadStreamOptions{}, 10)
+		require.NoError(t, err)
+		defer stream.Close()
+
+		events, err := collectStreamEvents(stream)
+		require.NoError(t, err)
+		assert.Len(t, events, 2)
+	}
+}
+
+func getStreamDeletionStatus(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		softDeleted := NAME_GENERATOR.Generate()
+		tombstoned := NAME_GENERATOR.Generate()
+
+		for _, streamID := range []string{softDeleted, tombstoned} {
+			_, err := db.AppendToStream(context.Background(), streamID, esdb.AppendToStreamOptions{}, createTestEvent())
+			require.NoError(t, err)
+		}
+
+		status, err := db.GetStreamDeletionStatus(context.Background(), softDeleted, esdb.GetStreamDeletionStatusOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, esdb.StreamNotDeleted, status.State)
+
+		_, err = db.DeleteStream(context.Background(), softDeleted, esdb.DeleteStreamOptions{})
+		require.NoError(t, err)
+
+		status, err = db.GetStreamDeletionStatus(context.Background(), softDeleted, esdb.GetStreamDeletionStatusOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, esdb.StreamSoftDeleted, status.State)
+		require.NotNil(t, status.TruncateBefore)
+
+		_, err = db.TombstoneStream(context.Background(), tombstoned, esdb.TombstoneStreamOptions{})
+		require.NoError(t, err)
+
+		status, err = db.GetStreamDeletionStatus(context.Background(), tombstoned, esdb.GetStreamDeletionStatusOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, esdb.StreamTombstoned, status.State)
+
+		status, err = db.GetStreamDeletionStatus(context.Background(), NAME_GENERATOR.Generate(), esdb.GetStreamDeletionStatusOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, esdb.StreamNotDeleted, status.State)
+		assert.Nil(t, status.TruncateBefore)
+	}
+}
+
+func getStreamLastEvent(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		found := NAME_GENERATOR.Generate()
+		softDeleted := NAME_GENERATOR.Generate()
+		tombstoned := NAME_GENERATOR.Generate()
+
+		for _, streamID := range []string{found, softDeleted, tombstoned} {
+			_, err := db.AppendToStream(context.Background(), streamID, esdb.AppendToStreamOptions{}, createTestEvent(), createTestEvent())
+			require.NoError(t, err)
+		}
+
+		_, err := db.DeleteStream(context.Background(), softDeleted, esdb.DeleteStreamOptions{})
+		require.NoError(t, err)
+
+		_, err = db.TombstoneStream(context.Background(), tombstoned, esdb.TombstoneStreamOptions{})
+		require.NoError(t, err)
+
+		last, err := db.GetStreamLastEvent(context.Background(), found, esdb.GetStreamLastEventOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, esdb.StreamExistenceFound, last.Existence)
+		require.NotNil(t, last.Event)
+		assert.Equal(t, uint64(1), last.Event.OriginalEvent().EventNumber)
+
+		expected := map[string]esdb.StreamExistence{
+			found:                     esdb.StreamExistenceFound,
+			softDeleted:               esdb.StreamExistenceSoftDeleted,
+			tombstoned:                esdb.StreamExistenceTombstoned,
+			NAME_GENERATOR.Generate(): esdb.StreamExistenceNotFound,
+		}
+
+		for streamID, existence := range expected {
+			actual, err := db.StreamExists(context.Background(), streamID, esdb.StreamExistsOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, existence, actual, streamID)
+		}
+	}
+}
+
+func deleteStreamReturnsLastRevision(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		streamID := NAME_GENERATOR.Generate()
+
+		_, err := db.AppendToStream(context.Background(), streamID, esdb.AppendToStreamOptions{}, createTestEvent(), createTestEvent(), createTestEvent())
+		require.NoError(t, err)
+
+		result, err := db.DeleteStream(context.Background(), streamID, esdb.DeleteStreamOptions{ReturnLastRevision: true})
+		require.NoError(t, err)
+		require.NotNil(t, result.LastRevision)
+		assert.Equal(t, uint64(2), *result.LastRevision)
+
+		result, err = db.DeleteStream(context.Background(), NAME_GENERATOR.Generate(), esdb.DeleteStreamOptions{ReturnLastRevision: true})
+		require.NoError(t, err)
+		assert.Nil(t, result.LastRevision)
+	}
+}
+
+func getStreamLength(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		streamID := NAME_GENERATOR.Generate()
+
+		_, err := db.AppendToStream(context.Background(), streamID, esdb.AppendToStreamOptions{}, createTestEvent(), createTestEvent(), createTestEvent())
+		require.NoError(t, err)
+
+		length, err := db.GetStreamLength(context.Background(), streamID, esdb.GetStreamLengthOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(3), length)
+
+		_, err = db.TruncateStream(context.Background(), streamID, 1, esdb.TruncateStreamOptions{})
+		require.NoError(t, err)
+
+		length, err = db.GetStreamLength(context.Background(), streamID, esdb.GetStreamLengthOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(2), length)
+
+		_, err = db.DeleteStream(context.Background(), streamID, esdb.DeleteStreamOptions{})
+		require.NoError(t, err)
+
+		length, err = db.GetStreamLength(context.Background(), streamID, esdb.GetStreamLengthOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(0), length)
+
+		length, err = db.GetStreamLength(context.Background(), NAME_GENERATOR.Generate(), esdb.GetStreamLengthOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(0), length)
+	}
+}
@@ -0,0 +1,95 @@
+package esdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+type StreamDeletionState int
+
+const (
+	// StreamNotDeleted means the stream wasn't deleted, or got written to again after a soft delete. It includes
+	// streams which never existed.
+	StreamNotDeleted StreamDeletionState = iota
+	// StreamSoftDeleted means the stream got deleted with DeleteStream. It can be restored or written to again.
+	StreamSoftDeleted
+	// StreamTombstoned means the stream got deleted with TombstoneStream. It can't be written to anymore.
+	StreamTombstoned
+)
+
+func (s StreamDeletionState) String() string {
+	switch s {
+	case StreamSoftDeleted:
+		return "soft-deleted"
+	case StreamTombstoned:
+		return "tombstoned"
+	default:
+		return "not deleted"
+	}
+}
+
+type StreamDeletionStatus struct {
+	State StreamDeletionState
+	// The truncate before ($tb) value of the stream metadata, if any. Soft-deleted streams have it set to the largest
+	// revision.
+	TruncateBefore *uint64
+}
+
+// GetStreamDeletionStatus tells whether the stream is soft-deleted, tombstoned, or neither.
+func (client *Client) GetStreamDeletionStatus(
+	ctx context.Context,
+	streamID string,
+	opts GetStreamDeletionStatusOptions,
+) (*StreamDeletionStatus, error) {
+	_, err := client.readStreamHeadRevision(ctx, streamID, opts.Authenticated, opts.Deadline)
+
+	if isStreamTombstoned(err) {
+		return &StreamDeletionStatus{State: StreamTombstoned}, nil
+	}
+
+	var esdbErr *Error
+	if err != nil && !(errors.As(err, &esdbErr) && esdbErr.Code() == ErrorResourceNotFound) {
+		return nil, err
+	}
+
+	return client.readSoftDeletionStatus(ctx, streamID, opts.Authenticated, opts.Deadline)
+}
+
+func isStreamTombstoned(err error) bool {
+	var esdbErr *Error
+	return errors.As(err, &esdbErr) && esdbErr.Code() == ErrorStreamDeleted
+}
+
+// readSoftDeletionStatus tells a soft-deleted stream from one which isn't, using the truncate before ($tb) value a soft
+// delete leaves in the stream metadata.
+func (client *Client) readSoftDeletionStatus(
+	ctx context.Context,
+	streamID string,
+	credentials *Credentials,
+	deadline *time.Duration,
+) (*StreamDeletionStatus, error) {
+	meta, _, err := client.getStreamMetadataOrEmpty(ctx, streamID, ReadStreamOptions{
+		Direction:     Backwards,
+		From:          End{},
+		Authenticated: credentials,
+		Deadline:      deadline,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read metadata of stream '%s': %w", streamID, err)
+	}
+
+	status := &StreamDeletionStatus{
+		State:          StreamNotDeleted,
+		TruncateBefore: meta.TruncateBefore(),
+	}
+
+	if status.TruncateBefore != nil && *status.TruncateBefore >= math.MaxInt64 {
+		status.State = StreamSoftDeleted
+	}
+
+	return status, nil
+}
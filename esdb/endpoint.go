@@ -76,9 +76,10 @@ func NewGrpcClient(config Configuration) *grpcClient {
 	go connectionStateMachine(config, closeFlag, channel, &logger)
 
 	return &grpcClient{
-		channel:   channel,
-		closeFlag: closeFlag,
-		once:      new(sync.Once),
-		logger:    &logger,
+		channel:       channel,
+		closeFlag:     closeFlag,
+		once:          new(sync.Once),
+		logger:        &logger,
+		noCompression: new(int32),
 	}
 }
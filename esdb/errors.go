@@ -21,6 +21,11 @@ const (
 	ErrorInternalClient
 	ErrorInternalServer
 	ErrorNotLeader
+	ErrorInvalidArgument
+	ErrorTLSHandshake
+	ErrorReadLimitExceeded
+	ErrorEventNotFound
+	ErrorAppendQueueDrained
 )
 
 type Error struct {
@@ -42,9 +47,15 @@ func (e *Error) Error() string {
 	switch e.code {
 	case ErrorUnsupportedFeature:
 		msg = "unsupported feature"
+	case ErrorInvalidArgument:
+		msg = "invalid argument"
+	case ErrorTLSHandshake:
+		msg = "TLS handshake failed"
 	}
 
-	if e.err != nil {
+	if e.err != nil && msg == "" {
+		msg = e.err.Error()
+	} else if e.err != nil {
 		msg = fmt.Sprintf("%s: %v", msg, e.Err())
 	}
 
@@ -71,6 +82,16 @@ func unsupportedFeatureError() error {
 	return &Error{code: ErrorUnsupportedFeature}
 }
 
+func invalidArgumentError(format string, args ...interface{}) error {
+	return &Error{code: ErrorInvalidArgument, err: fmt.Errorf(format, args...)}
+}
+
+// appendTooLargeError replaces the RESOURCE_EXHAUSTED status the server returns when an append message doesn't fit in
+// its maximum gRPC message size.
+func appendTooLargeError(err error) error {
+	return &Error{code: ErrorInvalidArgument, err: fmt.Errorf("append payload exceeds the server's maximum message size, set MaxAppendPayloadBytes to fail before sending, or split the events: %w", err)}
+}
+
 func unknownError() error {
 	return &Error{code: ErrorUnknown}
 }
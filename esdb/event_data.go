@@ -19,3 +19,19 @@ type EventData struct {
 	Data        []byte
 	Metadata    []byte
 }
+
+// EventDataFromRecordedEvent creates the EventData needed to append a copy of a recorded event, keeping its id.
+func EventDataFromRecordedEvent(event *RecordedEvent) EventData {
+	contentType := BinaryContentType
+	if event.ContentType == "application/json" {
+		contentType = JsonContentType
+	}
+
+	return EventData{
+		EventID:     event.EventID,
+		EventType:   event.EventType,
+		ContentType: contentType,
+		Data:        event.Data,
+		Metadata:    event.UserMetadata,
+	}
+}
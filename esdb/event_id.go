@@ -0,0 +1,39 @@
+package esdb
+
+import (
+	"fmt"
+
+	"github.com/gofrs/uuid"
+)
+
+// NewEventID returns a new random event id.
+func NewEventID() uuid.UUID {
+	return uuid.Must(uuid.NewV4())
+}
+
+// ParseEventID parses an event id from its canonical string representation.
+func ParseEventID(value string) (uuid.UUID, error) {
+	id, err := uuid.FromString(value)
+
+	if err != nil {
+		return uuid.Nil, &Error{code: ErrorParsing, err: fmt.Errorf("invalid event id '%s': %w", value, err)}
+	}
+
+	return id, nil
+}
+
+// EventIDFromBytes converts a raw 16 bytes UUID into an event id. Because any UUID type backed by a [16]byte array is
+// assignable to the parameter, values of other UUID libraries (like github.com/google/uuid) can be passed as-is.
+func EventIDFromBytes(value [16]byte) uuid.UUID {
+	return uuid.UUID(value)
+}
+
+// EventIDBytes returns the raw 16 bytes of the event id, assignable to any UUID type backed by a [16]byte array.
+func (e *RecordedEvent) EventIDBytes() [16]byte {
+	return e.EventID
+}
+
+// EventIDString returns the canonical string representation of the event id.
+func (e *RecordedEvent) EventIDString() string {
+	return e.EventID.String()
+}
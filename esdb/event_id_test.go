@@ -0,0 +1,44 @@
+package esdb_test
+
+import (
+	"testing"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+	"github.com/EventStore/EventStore-Client-Go/v2/protos/shared"
+	api "github.com/EventStore/EventStore-Client-Go/v2/protos/streams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventIDFromStructuredProto(t *testing.T) {
+	expected, err := esdb.ParseEventID("38fffbc2-339e-11ea-8c7b-784f43837872")
+	require.NoError(t, err)
+
+	recordedEvent := &api.ReadResp_ReadEvent_RecordedEvent{
+		Id: &shared.UUID{
+			Value: &shared.UUID_Structured_{
+				Structured: &shared.UUID_Structured{
+					MostSignificantBits:  0x38fffbc2339e11ea,
+					LeastSignificantBits: -0x7384_87b0_bc7c_878e,
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, expected, esdb.EventIDFromProto(recordedEvent))
+}
+
+func TestEventIDConversions(t *testing.T) {
+	_, err := esdb.ParseEventID("not-an-id")
+	esdbErr, ok := esdb.FromError(err)
+	require.False(t, ok)
+	assert.Equal(t, esdb.ErrorParsing, esdbErr.Code())
+
+	type otherUUID [16]byte
+
+	raw := otherUUID{0x38, 0xff, 0xfb, 0xc2, 0x33, 0x9e, 0x11, 0xea, 0x8c, 0x7b, 0x78, 0x4f, 0x43, 0x83, 0x78, 0x72}
+	event := esdb.RecordedEvent{EventID: esdb.EventIDFromBytes(raw)}
+
+	assert.Equal(t, "38fffbc2-339e-11ea-8c7b-784f43837872", event.EventIDString())
+	assert.Equal(t, [16]byte(raw), event.EventIDBytes())
+}
@@ -0,0 +1,23 @@
+package esdb
+
+// retryServiceConfig is the gRPC service config enabling transparent retries of idempotent calls. Server streaming
+// calls, like reads, are only retried until the first response is received, so a retry never replays events. It is
+// only installed when no client-side RetryPolicy is configured, which otherwise retries reads itself, and reads then
+// reject a per-call RetryPolicy, so a call is never retried by both layers.
+const retryServiceConfig = `{
+	"methodConfig": [{
+		"name": [
+			{"service": "event_store.client.streams.Streams", "method": "Read"},
+			{"service": "event_store.client.server_features.ServerFeatures", "method": "GetSupportedMethods"},
+			{"service": "event_store.client.persistent_subscriptions.PersistentSubscriptions", "method": "GetInfo"},
+			{"service": "event_store.client.persistent_subscriptions.PersistentSubscriptions", "method": "List"}
+		],
+		"retryPolicy": {
+			"maxAttempts": 3,
+			"initialBackoff": "0.1s",
+			"maxBackoff": "1s",
+			"backoffMultiplier": 2,
+			"retryableStatusCodes": ["UNAVAILABLE"]
+		}
+	}]
+}`
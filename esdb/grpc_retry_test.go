@@ -0,0 +1,38 @@
+package esdb
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestRetryServiceConfigOnlyCoversIdempotentCalls(t *testing.T) {
+	var config struct {
+		MethodConfig []struct {
+			Name []struct {
+				Service string `json:"service"`
+				Method  string `json:"method"`
+			} `json:"name"`
+		} `json:"methodConfig"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(retryServiceConfig), &config))
+	require.Len(t, config.MethodConfig, 1)
+
+	var methods []string
+	for _, name := range config.MethodConfig[0].Name {
+		require.NotEmpty(t, name.Method, "whole services must not be retried")
+		methods = append(methods, name.Service+"/"+name.Method)
+	}
+
+	assert.Contains(t, methods, "event_store.client.streams.Streams/Read")
+	assert.NotContains(t, methods, "event_store.client.streams.Streams/Append")
+
+	// The config must be accepted by gRPC, which validates it when dialing.
+	conn, err := grpc.Dial("localhost:2113", grpc.WithInsecure(), grpc.WithDefaultServiceConfig(retryServiceConfig))
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+}
@@ -0,0 +1,56 @@
+package esdb
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	api "github.com/EventStore/EventStore-Client-Go/v2/protos/streams"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// idleReadClient cancels a read call when a Recv waits for a message longer than timeout. Only the time spent waiting
+// on the server counts, the time the caller spends between two calls to Recv doesn't.
+type idleReadClient struct {
+	api.Streams_ReadClient
+	timeout  time.Duration
+	timer    *time.Timer
+	timedOut *int32
+}
+
+func newIdleReadClient(inner api.Streams_ReadClient, timeout time.Duration, cancel context.CancelFunc) *idleReadClient {
+	timedOut := new(int32)
+	timer := time.AfterFunc(timeout, func() {
+		atomic.StoreInt32(timedOut, 1)
+		cancel()
+	})
+	timer.Stop()
+
+	return &idleReadClient{
+		Streams_ReadClient: inner,
+		timeout:            timeout,
+		timer:              timer,
+		timedOut:           timedOut,
+	}
+}
+
+func (client *idleReadClient) Recv() (*api.ReadResp, error) {
+	client.timer.Reset(client.timeout)
+	msg, err := client.Streams_ReadClient.Recv()
+	client.timer.Stop()
+
+	if err != nil && atomic.LoadInt32(client.timedOut) != 0 {
+		return nil, status.Errorf(codes.DeadlineExceeded, "no message received for %v", client.timeout)
+	}
+
+	return msg, err
+}
+
+func validateIdleTimeout(timeout time.Duration) error {
+	if timeout < 0 {
+		return invalidArgumentError("IdleTimeout can't be negative, got %v", timeout)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package esdb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	api "github.com/EventStore/EventStore-Client-Go/v2/protos/streams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// silentReadClient sends the given number of messages, then none until its call is cancelled.
+type silentReadClient struct {
+	fakeReadClient
+}
+
+func (c *silentReadClient) Recv() (*api.ReadResp, error) {
+	if c.remaining == 0 {
+		<-c.ctx.Done()
+		return nil, status.FromContextError(c.ctx.Err()).Err()
+	}
+
+	return c.fakeReadClient.Recv()
+}
+
+func TestIdleReadClientTimesOut(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := newIdleReadClient(&silentReadClient{fakeReadClient{ctx: ctx, remaining: 1}}, 20*time.Millisecond, cancel)
+
+	_, err := client.Recv()
+	require.NoError(t, err)
+
+	_, err = client.Recv()
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+}
+
+func TestIdleReadClientIgnoresTimeBetweenReceives(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := newIdleReadClient(&fakeReadClient{ctx: ctx, remaining: 2}, 20*time.Millisecond, cancel)
+
+	_, err := client.Recv()
+	require.NoError(t, err)
+
+	time.Sleep(50 * time.Millisecond)
+	_, err = client.Recv()
+	assert.NoError(t, err)
+	assert.NoError(t, ctx.Err())
+}
@@ -2,7 +2,6 @@ package esdb
 
 import (
 	"context"
-	"crypto/tls"
 	"encoding/base64"
 	"fmt"
 	"math/rand"
@@ -29,6 +28,8 @@ type grpcClient struct {
 	closeFlag *int32
 	once      *sync.Once
 	logger    *logger
+	// Set once the server rejected a compressed call.
+	noCompression *int32
 }
 
 func (client *grpcClient) handleError(handle *connectionHandle, headers metadata.MD, trailers metadata.MD, err error) error {
@@ -67,6 +68,12 @@ func (client *grpcClient) handleError(handle *connectionHandle, headers metadata
 
 	code := errToCode(err)
 
+	if code == ErrorTLSHandshake {
+		client.logger.error("TLS handshake failed: %v", err)
+		client.channel <- reconnect{correlation: handle.Id()}
+		return tlsHandshakeError(err)
+	}
+
 	if code != ErrorUnknown {
 		return &Error{code: code}
 	}
@@ -282,12 +289,12 @@ func createGrpcConnection(conf *Configuration, address string) (*grpc.ClientConn
 	if conf.DisableTLS {
 		opts = append(opts, grpc.WithInsecure())
 	} else {
-		opts = append(opts,
-			grpc.WithTransportCredentials(credentials.NewTLS(
-				&tls.Config{
-					InsecureSkipVerify: conf.SkipCertificateVerification,
-					RootCAs:            conf.RootCAs,
-				})))
+		tlsConfig, err := conf.tlsConfig()
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
 	}
 
 	opts = append(opts, grpc.WithPerRPCCredentials(basicAuth{
@@ -303,6 +310,22 @@ func createGrpcConnection(conf *Configuration, address string) (*grpc.ClientConn
 		}))
 	}
 
+	if conf.grpcRetriesEnabled() {
+		opts = append(opts, grpc.WithDefaultServiceConfig(retryServiceConfig))
+	}
+
+	if conf.InitialWindowSize > 0 {
+		opts = append(opts, grpc.WithInitialWindowSize(conf.InitialWindowSize))
+	}
+
+	if conf.InitialConnWindowSize > 0 {
+		opts = append(opts, grpc.WithInitialConnWindowSize(conf.InitialConnWindowSize))
+	}
+
+	if conf.ReadBufferSize > 0 {
+		opts = append(opts, grpc.WithReadBufferSize(conf.ReadBufferSize))
+	}
+
 	conn, err := grpc.Dial(address, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize connection to %s. Reason: %w", address, err)
@@ -325,6 +348,7 @@ const (
 	FEATURE_PERSISTENT_SUBSCRIPTION_RESTART_SUBSYSTEM = 8
 	FEATURE_PERSISTENT_SUBSCRIPTION_GET_INFO          = 16
 	FEATURE_PERSISTENT_SUBSCRIPTION_TO_ALL            = 32
+	FEATURE_SUBSCRIPTION_CAUGHT_UP                    = 64
 	FEATURE_PERSISTENT_SUBSCRIPTION_MANAGEMENT        = FEATURE_PERSISTENT_SUBSCRIPTION_LIST | FEATURE_PERSISTENT_SUBSCRIPTION_GET_INFO | FEATURE_PERSISTENT_SUBSCRIPTION_RESTART_SUBSYSTEM | FEATURE_PERSISTENT_SUBSCRIPTION_REPLAY
 )
 
@@ -375,6 +399,11 @@ func getSupportedMethods(ctx context.Context, conf *Configuration, conn *grpc.Cl
 		}
 	}
 
+	// Subscriptions tell when they catch up since 23.10, which isn't advertised as a method feature.
+	if info.Version.Major > 23 || (info.Version.Major == 23 && info.Version.Minor >= 10) {
+		info.FeatureFlags |= FEATURE_SUBSCRIPTION_CAUGHT_UP
+	}
+
 	for _, method := range methods.Methods {
 		switch method.ServiceName {
 		case "event_store.client.streams.streams":
@@ -541,6 +570,10 @@ func discoverNode(conf Configuration, logger *logger) (*grpc.ClientConn, *Server
 
 // In that case, `err` is always != nil
 func errToCode(err error) ErrorCode {
+	if isTLSHandshakeError(err) {
+		return ErrorTLSHandshake
+	}
+
 	var code ErrorCode
 	switch status.Code(err) {
 	case codes.Unauthenticated:
@@ -0,0 +1,169 @@
+package esdb
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// MultiStreamSubscription merges the subscriptions to several streams into a single feed. Events of a stream are
+// delivered in order, while events of different streams are interleaved as they arrive.
+type MultiStreamSubscription struct {
+	subs   map[string]*Subscription
+	events chan *SubscriptionEvent
+	done   chan struct{}
+	once   sync.Once
+
+	lock        sync.Mutex
+	checkpoints map[string]uint64
+	dropped     *SubscriptionDropped
+}
+
+// SubscribeToStreams subscribes to every stream and merges their events, instead of running a subscription per
+// stream. The merged subscription drops as soon as one of the stream subscriptions drops. Checkpoints returns the last
+// revision delivered by stream, which can be passed back in SubscribeToStreamsOptions.Checkpoints to resume.
+func (client *Client) SubscribeToStreams(
+	ctx context.Context,
+	streamIDs []string,
+	opts SubscribeToStreamsOptions,
+) (*MultiStreamSubscription, error) {
+	opts.setDefaults()
+	if err := opts.validate(streamIDs); err != nil {
+		return nil, err
+	}
+
+	multi := newMultiStreamSubscription(opts.Checkpoints)
+	for _, streamID := range streamIDs {
+		from := opts.From
+		if revision, ok := opts.Checkpoints[streamID]; ok {
+			from = Revision(revision)
+		}
+
+		sub, err := client.SubscribeToStream(ctx, streamID, SubscribeToStreamOptions{
+			From:           from,
+			ResolveLinkTos: opts.ResolveLinkTos,
+			Authenticated:  opts.Authenticated,
+			Deadline:       opts.Deadline,
+		})
+
+		if err != nil {
+			_ = multi.Close()
+			return nil, fmt.Errorf("failed to subscribe to stream '%s': %w", streamID, err)
+		}
+
+		multi.subs[streamID] = sub
+	}
+
+	multi.start()
+	return multi, nil
+}
+
+func newMultiStreamSubscription(checkpoints map[string]uint64) *MultiStreamSubscription {
+	multi := &MultiStreamSubscription{
+		subs:        make(map[string]*Subscription),
+		events:      make(chan *SubscriptionEvent),
+		done:        make(chan struct{}),
+		checkpoints: make(map[string]uint64, len(checkpoints)),
+	}
+
+	for streamID, revision := range checkpoints {
+		multi.checkpoints[streamID] = revision
+	}
+
+	return multi
+}
+
+func (multi *MultiStreamSubscription) start() {
+	for streamID, sub := range multi.subs {
+		go multi.forward(streamID, sub)
+	}
+}
+
+// forward sends the events of a stream subscription to the merged feed, until it drops or the merged subscription
+// gets closed.
+func (multi *MultiStreamSubscription) forward(streamID string, sub *Subscription) {
+	for {
+		event := sub.Recv()
+
+		if event.SubscriptionDropped != nil {
+			event = &SubscriptionEvent{SubscriptionDropped: &SubscriptionDropped{
+				Error:  fmt.Errorf("subscription to stream '%s' dropped: %w", streamID, event.SubscriptionDropped.Error),
+				Reason: event.SubscriptionDropped.Reason,
+			}}
+		} else if event.EventAppeared == nil {
+			continue
+		}
+
+		select {
+		case multi.events <- event:
+		case <-multi.done:
+			return
+		}
+
+		if event.SubscriptionDropped != nil {
+			return
+		}
+	}
+}
+
+// Recv returns the next event of any of the streams. Only EventAppeared and SubscriptionDropped are set, and once a
+// stream subscription dropped every call returns the same SubscriptionDropped.
+func (multi *MultiStreamSubscription) Recv() *SubscriptionEvent {
+	multi.lock.Lock()
+	dropped := multi.dropped
+	multi.lock.Unlock()
+
+	if dropped != nil {
+		return &SubscriptionEvent{SubscriptionDropped: dropped}
+	}
+
+	var event *SubscriptionEvent
+	select {
+	case event = <-multi.events:
+	case <-multi.done:
+		event = &SubscriptionEvent{SubscriptionDropped: &SubscriptionDropped{
+			Error:  fmt.Errorf("subscription has been dropped"),
+			Reason: SubscriptionDropUnsubscribed,
+		}}
+	}
+
+	multi.lock.Lock()
+	defer multi.lock.Unlock()
+
+	if event.SubscriptionDropped != nil {
+		multi.dropped = event.SubscriptionDropped
+		go multi.Close()
+		return event
+	}
+
+	original := event.EventAppeared.OriginalEvent()
+	multi.checkpoints[original.StreamID] = original.EventNumber
+	return event
+}
+
+// Checkpoints returns the revision of the last event returned by Recv, by stream. Streams without events returned yet
+// keep the checkpoint they were subscribed from, if any.
+func (multi *MultiStreamSubscription) Checkpoints() map[string]uint64 {
+	multi.lock.Lock()
+	defer multi.lock.Unlock()
+
+	checkpoints := make(map[string]uint64, len(multi.checkpoints))
+	for streamID, revision := range multi.checkpoints {
+		checkpoints[streamID] = revision
+	}
+
+	return checkpoints
+}
+
+// Close closes every stream subscription.
+func (multi *MultiStreamSubscription) Close() error {
+	multi.once.Do(func() {
+		close(multi.done)
+
+		for _, sub := range multi.subs {
+			_ = sub.Close()
+		}
+	})
+
+	return nil
+}
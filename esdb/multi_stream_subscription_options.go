@@ -0,0 +1,38 @@
+package esdb
+
+import "time"
+
+// SubscribeToStreamsOptions configures SubscribeToStreams.
+type SubscribeToStreamsOptions struct {
+	// Where the streams without a checkpoint start from. Defaults to End{}.
+	From StreamPosition
+	// Last revision handled by stream, as returned by MultiStreamSubscription.Checkpoints. The subscription to a
+	// stream with a checkpoint starts right after it.
+	Checkpoints    map[string]uint64
+	ResolveLinkTos bool
+	Authenticated  *Credentials
+	Deadline       *time.Duration
+}
+
+func (o *SubscribeToStreamsOptions) setDefaults() {
+	if o.From == nil {
+		o.From = End{}
+	}
+}
+
+func (o *SubscribeToStreamsOptions) validate(streamIDs []string) error {
+	if len(streamIDs) == 0 {
+		return invalidArgumentError("at least one stream is required")
+	}
+
+	seen := make(map[string]struct{}, len(streamIDs))
+	for _, streamID := range streamIDs {
+		if _, ok := seen[streamID]; ok {
+			return invalidArgumentError("stream '%s' is subscribed to more than once", streamID)
+		}
+
+		seen[streamID] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package esdb
+
+import (
+	"testing"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/protos/shared"
+	api "github.com/EventStore/EventStore-Client-Go/v2/protos/streams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// blockingReadClient replays the given responses, then waits until its call is cancelled.
+type blockingReadClient struct {
+	scriptedReadClient
+	cancelled chan struct{}
+}
+
+func (c *blockingReadClient) Recv() (*api.ReadResp, error) {
+	if len(c.responses) == 0 {
+		<-c.cancelled
+		return nil, status.Error(codes.Canceled, "cancelled")
+	}
+
+	return c.scriptedReadClient.Recv()
+}
+
+func streamEventResponses(streamID string, revisions ...uint64) []*api.ReadResp {
+	responses := readEventResponses(revisions...)
+	for _, response := range responses {
+		response.GetEvent().Event.StreamIdentifier = &shared.StreamIdentifier{StreamName: []byte(streamID)}
+	}
+
+	return responses
+}
+
+func TestMultiStreamSubscriptionMergesStreams(t *testing.T) {
+	client := &Client{grpcClient: &grpcClient{logger: &logger{}}}
+	multi := newMultiStreamSubscription(map[string]uint64{"payments": 7})
+	multi.subs["orders"] = NewSubscription(client, func() {}, &scriptedReadClient{responses: streamEventResponses("orders", 0, 1, 2)}, "orders")
+	invoices := &blockingReadClient{scriptedReadClient{responses: streamEventResponses("invoices", 4, 5)}, make(chan struct{})}
+	multi.subs["invoices"] = NewSubscription(client, func() { close(invoices.cancelled) }, invoices, "invoices")
+	multi.start()
+
+	revisions := map[string][]uint64{}
+	for {
+		event := multi.Recv()
+		if event.SubscriptionDropped != nil {
+			// The scripted orders stream ends first, which drops the merged subscription.
+			assert.Contains(t, event.SubscriptionDropped.Error.Error(), "orders")
+			break
+		}
+
+		original := event.EventAppeared.OriginalEvent()
+		revisions[original.StreamID] = append(revisions[original.StreamID], original.EventNumber)
+	}
+
+	assert.Equal(t, []uint64{0, 1, 2}, revisions["orders"])
+
+	checkpoints := multi.Checkpoints()
+	assert.Equal(t, uint64(2), checkpoints["orders"])
+	assert.Equal(t, uint64(7), checkpoints["payments"])
+
+	require.NotNil(t, multi.Recv().SubscriptionDropped)
+}
+
+func TestSubscribeToStreamsOptionsValidation(t *testing.T) {
+	opts := SubscribeToStreamsOptions{}
+	assertInvalidArgument(t, opts.validate(nil))
+	assertInvalidArgument(t, opts.validate([]string{"orders", "orders"}))
+	assert.NoError(t, opts.validate([]string{"orders", "invoices"}))
+}
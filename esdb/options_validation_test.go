@@ -0,0 +1,124 @@
+package esdb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func assertInvalidArgument(t *testing.T, err error) {
+	esdbErr, ok := FromError(err)
+	assert.False(t, ok)
+	assert.Equal(t, ErrorInvalidArgument, esdbErr.Code())
+}
+
+func TestReadOptionsValidation(t *testing.T) {
+	opts := ReadStreamOptions{PrefetchCount: -1}
+	assertInvalidArgument(t, opts.validate())
+
+	allOpts := ReadAllOptions{Filter: ExcludeSystemEventsFilter()}
+	allOpts.setDefaults()
+	assert.NoError(t, allOpts.validate())
+	assert.Equal(t, 32, allOpts.MaxSearchWindow)
+
+	allOpts = ReadAllOptions{Filter: &SubscriptionFilter{Type: StreamFilterType}}
+	allOpts.setDefaults()
+	assertInvalidArgument(t, allOpts.validate())
+}
+
+func TestSubscriptionOptionsValidation(t *testing.T) {
+	opts := SubscribeToAllOptions{Filter: ExcludeSystemEventsFilter()}
+	opts.setDefaults()
+	assert.NoError(t, opts.validate())
+
+	opts.MaxSearchWindow = -2
+	assertInvalidArgument(t, opts.validate())
+
+	opts = SubscribeToAllOptions{Filter: &SubscriptionFilter{Type: StreamFilterType}}
+	opts.setDefaults()
+	assertInvalidArgument(t, opts.validate())
+
+	settings := SubscriptionSettingsDefault()
+	settings.ReadBatchSize = -1
+	persistentOpts := PersistentStreamSubscriptionOptions{Settings: &settings}
+	assertInvalidArgument(t, persistentOpts.validate())
+
+	settings = SubscriptionSettingsDefault()
+	settings.CheckpointLowerBound = settings.CheckpointUpperBound + 1
+	assertInvalidArgument(t, persistentOpts.validate())
+
+	subOpts := SubscribeToPersistentSubscriptionOptions{BufferSize: 1 << 31}
+	assertInvalidArgument(t, subOpts.validate())
+
+	opts = SubscribeToAllOptions{InitialWindowSize: -1}
+	opts.setDefaults()
+	assertInvalidArgument(t, opts.validate())
+
+	streamOpts := SubscribeToStreamOptions{IdleTimeout: -time.Second}
+	assertInvalidArgument(t, streamOpts.validate())
+}
+
+func TestConsumerStrategyValidation(t *testing.T) {
+	strategy, err := ParseConsumerStrategy("pinnedbycorrelation")
+	assert.NoError(t, err)
+	assert.Equal(t, ConsumerStrategy_PinnedByCorrelation, strategy)
+
+	_, err = ParseConsumerStrategy("Random")
+	assertInvalidArgument(t, err)
+
+	settings := SubscriptionSettingsDefault()
+	settings.ConsumerStrategyName = "Random"
+	persistentOpts := PersistentStreamSubscriptionOptions{Settings: &settings}
+	assertInvalidArgument(t, persistentOpts.validate())
+
+	err = ConsumerStrategy_PinnedByCorrelation.checkSupported(nil, true)
+	esdbErr, _ := FromError(err)
+	assert.Equal(t, ErrorUnsupportedFeature, esdbErr.Code())
+	assert.NoError(t, ConsumerStrategy_Pinned.checkSupported(nil, true))
+}
+
+func TestEmptyConsumerStrategyDefaultsToRoundRobin(t *testing.T) {
+	settings := SubscriptionSettings{}
+	assert.NoError(t, settings.validate())
+
+	streamOpts := PersistentStreamSubscriptionOptions{Settings: &settings}
+	streamOpts.setDefaults()
+	assert.NoError(t, streamOpts.validate())
+	assert.Equal(t, ConsumerStrategy_RoundRobin, streamOpts.Settings.ConsumerStrategyName)
+
+	allOpts := PersistentAllSubscriptionOptions{Settings: &settings}
+	allOpts.setDefaults()
+	assert.Equal(t, ConsumerStrategy_RoundRobin, allOpts.Settings.ConsumerStrategyName)
+
+	assert.Equal(t, ConsumerStrategy(""), settings.ConsumerStrategyName)
+}
+
+func TestAppendToStreamOptionsValidation(t *testing.T) {
+	opts := AppendToStreamOptions{}
+	opts.setDefaults()
+	assert.Equal(t, 0, opts.MaxAppendPayloadBytes)
+	assert.NoError(t, opts.validate())
+
+	opts.MaxAppendPayloadBytes = -1
+	assertInvalidArgument(t, opts.validate())
+}
+
+func TestUpdateStreamMetadataOptionsValidation(t *testing.T) {
+	opts := UpdateStreamMetadataOptions{}
+	opts.setDefaults()
+	assert.Equal(t, 10, opts.MaxAttempts)
+	assert.NoError(t, opts.validate())
+
+	opts = UpdateStreamMetadataOptions{MaxAttempts: -1}
+	opts.setDefaults()
+	assertInvalidArgument(t, opts.validate())
+
+	client := &Client{grpcClient: &grpcClient{logger: &logger{}}, Config: &Configuration{}}
+	result, err := client.UpdateStreamMetadata(context.Background(), "orders", UpdateStreamMetadataOptions{MaxAttempts: -1}, func(meta StreamMetadata) (StreamMetadata, error) {
+		return meta, nil
+	})
+	assert.Nil(t, result)
+	assertInvalidArgument(t, err)
+}
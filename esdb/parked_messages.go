@@ -0,0 +1,217 @@
+package esdb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	uuid "github.com/gofrs/uuid"
+)
+
+// ParkedMessage is a message parked by a persistent subscription group, either explicitly by a nack or once its
+// retries got exhausted.
+type ParkedMessage struct {
+	// Link is the event of the parked messages stream and Event the parked event it points to, which is nil when the
+	// parked event got deleted since.
+	ResolvedEvent
+	// Reason given when the message got parked, such as the reason of a nack.
+	Reason string
+	// Time the message got parked at, zero when the server didn't record it.
+	ParkedAt time.Time
+}
+
+type parkedMessageMetadata struct {
+	Added  string `json:"added"`
+	Reason string `json:"reason"`
+}
+
+// ReadParkedMessages reads up to MaxCount of the messages currently parked by a persistent subscription group on a
+// stream, oldest first. When MaxCount messages are returned, more may follow: read them by setting From to the
+// revision after the last one, its OriginalEvent().EventNumber + 1. Messages replayed by ReplayParkedMessages aren't
+// returned anymore. A group which never parked a message has none.
+func (client *Client) ReadParkedMessages(ctx context.Context, streamName string, groupName string, opts ReadParkedMessagesOptions) ([]ParkedMessage, error) {
+	opts.setDefaults()
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	stream, err := client.ReadStream(ctx, ParkedMessagesStreamName(streamName, groupName), ReadStreamOptions{
+		Direction:      Forwards,
+		From:           Revision(opts.From),
+		ResolveLinkTos: true,
+		Authenticated:  opts.Authenticated,
+		Deadline:       opts.Deadline,
+	}, uint64(opts.MaxCount))
+
+	var events []ResolvedEvent
+	if err == nil {
+		events, err = stream.Collect(opts.MaxCount)
+	}
+
+	if err != nil {
+		var esdbErr *Error
+		if errors.As(err, &esdbErr) && esdbErr.Code() == ErrorResourceNotFound {
+			return []ParkedMessage{}, nil
+		}
+
+		return nil, err
+	}
+
+	return toParkedMessages(events), nil
+}
+
+// readEveryParkedMessage reads all the messages parked by a group, failing with ErrorReadLimitExceeded past maxCount.
+func (client *Client) readEveryParkedMessage(ctx context.Context, parkedStream string, maxCount int, auth *Credentials, deadline *time.Duration) ([]ParkedMessage, error) {
+	events, err := client.ReadStreamAll(ctx, parkedStream, ReadStreamAllOptions{
+		ReadStreamOptions: ReadStreamOptions{
+			Direction:      Forwards,
+			From:           Start{},
+			ResolveLinkTos: true,
+			Authenticated:  auth,
+			Deadline:       deadline,
+		},
+		MaxCount: maxCount,
+	})
+
+	if err != nil {
+		var esdbErr *Error
+		if errors.As(err, &esdbErr) && esdbErr.Code() == ErrorResourceNotFound {
+			return []ParkedMessage{}, nil
+		}
+
+		return nil, err
+	}
+
+	return toParkedMessages(events), nil
+}
+
+func toParkedMessages(events []ResolvedEvent) []ParkedMessage {
+	messages := make([]ParkedMessage, 0, len(events))
+	for _, event := range events {
+		messages = append(messages, toParkedMessage(event))
+	}
+
+	return messages
+}
+
+// ReadParkedMessagesToAll reads the messages currently parked by a persistent subscription group on $all.
+func (client *Client) ReadParkedMessagesToAll(ctx context.Context, groupName string, opts ReadParkedMessagesOptions) ([]ParkedMessage, error) {
+	return client.ReadParkedMessages(ctx, "$all", groupName, opts)
+}
+
+// toParkedMessage decodes the parking metadata the server stores on the link of the parked messages stream.
+func toParkedMessage(event ResolvedEvent) ParkedMessage {
+	message := ParkedMessage{ResolvedEvent: event}
+
+	link := event.OriginalEvent()
+	if link == nil || len(link.UserMetadata) == 0 {
+		return message
+	}
+
+	var metadata parkedMessageMetadata
+	if json.Unmarshal(link.UserMetadata, &metadata) != nil {
+		return message
+	}
+
+	message.Reason = metadata.Reason
+	if parkedAt, err := time.Parse(time.RFC3339Nano, metadata.Added); err == nil {
+		message.ParkedAt = parkedAt
+	}
+
+	return message
+}
+
+// ReplaySelectedParkedMessages replays the parked messages of a persistent subscription group on a stream selected by
+// the options, leaving the others parked, and returns the number of replayed messages. Unlike ReplayParkedMessages,
+// the selection happens client-side: the parked messages stream is rewritten with the selected messages first, then
+// the others, and the server is asked to replay only the selected ones. The events themselves are never written
+// again, so only the group sees them again. It fails with ErrorWrongExpectedVersion, before replaying anything, when
+// a message gets parked concurrently. Parked messages whose event got deleted can't be replayed and stay parked.
+func (client *Client) ReplaySelectedParkedMessages(ctx context.Context, streamName string, groupName string, opts ReplaySelectedParkedMessagesOptions) (int, error) {
+	opts.setDefaults()
+	if err := opts.validate(); err != nil {
+		return 0, err
+	}
+
+	parkedStream := ParkedMessagesStreamName(streamName, groupName)
+	messages, err := client.readEveryParkedMessage(ctx, parkedStream, opts.MaxCount, opts.Authenticated, opts.Deadline)
+
+	if err != nil {
+		return 0, err
+	}
+
+	replayed, kept := selectParkedMessages(messages, opts)
+	if len(replayed) == 0 {
+		return 0, nil
+	}
+
+	links := make([]EventData, 0, len(messages))
+	for _, message := range append(replayed, kept...) {
+		link := message.OriginalEvent()
+		links = append(links, EventData{
+			EventID:     NewEventID(),
+			EventType:   LinkEventType,
+			ContentType: BinaryContentType,
+			Data:        link.Data,
+			Metadata:    link.UserMetadata,
+		})
+	}
+
+	last := messages[len(messages)-1].OriginalEvent().EventNumber
+	if _, err := client.AppendToStream(ctx, parkedStream, AppendToStreamOptions{
+		ExpectedRevision: Revision(last),
+		Authenticated:    opts.Authenticated,
+		Deadline:         opts.Deadline,
+	}, links...); err != nil {
+		return 0, fmt.Errorf("failed to reorder the messages of '%s': %w", parkedStream, err)
+	}
+
+	if _, err := client.TruncateStream(ctx, parkedStream, last+1, TruncateStreamOptions{
+		Authenticated: opts.Authenticated,
+		Deadline:      opts.Deadline,
+	}); err != nil {
+		return 0, fmt.Errorf("failed to remove the previous messages of '%s': %w", parkedStream, err)
+	}
+
+	if err := client.ReplayParkedMessages(ctx, streamName, groupName, ReplayParkedMessagesOptions{
+		StopAt:        len(replayed),
+		Authenticated: opts.Authenticated,
+		Deadline:      opts.Deadline,
+	}); err != nil {
+		return 0, fmt.Errorf("failed to replay the selected messages of '%s': %w", parkedStream, err)
+	}
+
+	return len(replayed), nil
+}
+
+// ReplaySelectedParkedMessagesToAll replays the selected parked messages of a persistent subscription group on $all.
+func (client *Client) ReplaySelectedParkedMessagesToAll(ctx context.Context, groupName string, opts ReplaySelectedParkedMessagesOptions) (int, error) {
+	return client.ReplaySelectedParkedMessages(ctx, "$all", groupName, opts)
+}
+
+// selectParkedMessages splits the parked messages between the ones to replay and the ones staying parked.
+func selectParkedMessages(messages []ParkedMessage, opts ReplaySelectedParkedMessagesOptions) ([]ParkedMessage, []ParkedMessage) {
+	ids := make(map[uuid.UUID]struct{}, len(opts.EventIDs))
+	for _, id := range opts.EventIDs {
+		ids[id] = struct{}{}
+	}
+
+	var replayed, kept []ParkedMessage
+	for _, message := range messages {
+		if message.Event == nil {
+			kept = append(kept, message)
+			continue
+		}
+
+		_, selected := ids[message.Event.EventID]
+		if selected || (opts.Predicate != nil && opts.Predicate(message)) {
+			replayed = append(replayed, message)
+		} else {
+			kept = append(kept, message)
+		}
+	}
+
+	return replayed, kept
+}
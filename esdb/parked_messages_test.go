@@ -0,0 +1,64 @@
+package esdb
+
+import (
+	"testing"
+	"time"
+
+	uuid "github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToParkedMessage(t *testing.T) {
+	event := ResolvedEvent{
+		Link: &RecordedEvent{
+			EventType:    LinkEventType,
+			UserMetadata: []byte(`{"added":"2022-03-04T10:11:12.5+01:00","reason":"Client explicitly NAK'ed message.\nMessage: invalid amount","subscriptionEventNumber":4}`),
+		},
+		Event: &RecordedEvent{EventType: "AccountOpened"},
+	}
+
+	message := toParkedMessage(event)
+	assert.Equal(t, "Client explicitly NAK'ed message.\nMessage: invalid amount", message.Reason)
+	assert.True(t, message.ParkedAt.Equal(time.Date(2022, 3, 4, 9, 11, 12, 500000000, time.UTC)))
+	assert.Equal(t, "AccountOpened", message.Event.EventType)
+
+	message = toParkedMessage(ResolvedEvent{Link: &RecordedEvent{UserMetadata: []byte("not json")}})
+	assert.Empty(t, message.Reason)
+	assert.True(t, message.ParkedAt.IsZero())
+}
+
+func TestSelectParkedMessages(t *testing.T) {
+	parked := func(eventType string) ParkedMessage {
+		return ParkedMessage{ResolvedEvent: ResolvedEvent{
+			Link:  &RecordedEvent{EventType: LinkEventType},
+			Event: &RecordedEvent{EventID: NewEventID(), EventType: eventType},
+		}}
+	}
+
+	messages := []ParkedMessage{parked("AccountOpened"), parked("Poison"), parked("AccountClosed"), parked("Poison")}
+	messages = append(messages, ParkedMessage{ResolvedEvent: ResolvedEvent{Link: &RecordedEvent{EventType: LinkEventType}}})
+
+	replayed, kept := selectParkedMessages(messages, ReplaySelectedParkedMessagesOptions{
+		EventIDs: []uuid.UUID{messages[0].Event.EventID},
+		Predicate: func(message ParkedMessage) bool {
+			return message.Event.EventType == "AccountClosed"
+		},
+	})
+
+	assert.Equal(t, []ParkedMessage{messages[0], messages[2]}, replayed)
+	assert.Equal(t, []ParkedMessage{messages[1], messages[3], messages[4]}, kept)
+
+	opts := ReplaySelectedParkedMessagesOptions{}
+	opts.setDefaults()
+	assertInvalidArgument(t, opts.validate())
+}
+
+func TestReadParkedMessagesOptionsValidation(t *testing.T) {
+	opts := ReadParkedMessagesOptions{From: 1000}
+	opts.setDefaults()
+	assert.Equal(t, 1000, opts.MaxCount)
+	assert.NoError(t, opts.validate())
+
+	opts.MaxCount = -1
+	assertInvalidArgument(t, opts.validate())
+}
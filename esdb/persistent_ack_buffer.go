@@ -0,0 +1,102 @@
+package esdb
+
+import (
+	"sync"
+	"time"
+)
+
+// AckBuffer buffers the acknowledgements of a persistent subscription, sending them in a single message once Size
+// are buffered or Interval elapsed since the first one was. Acknowledgements the server doesn't receive before the
+// message timeout of the subscription are retried by the server, so Interval must stay well below that timeout.
+type AckBuffer struct {
+	sub  *PersistentSubscription
+	opts AckBufferOptions
+
+	lock    sync.Mutex
+	pending []*ResolvedEvent
+	timer   *time.Timer
+	// Failure of a flush triggered by the timer, returned by the next call.
+	err error
+}
+
+// NewAckBuffer creates an acknowledgement buffer for the subscription.
+func (connection *PersistentSubscription) NewAckBuffer(opts AckBufferOptions) (*AckBuffer, error) {
+	opts.setDefaults()
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	return &AckBuffer{sub: connection, opts: opts}, nil
+}
+
+// Ack buffers the acknowledgement of the events, sending the buffer when it is full. It returns the error of the
+// send, or of a previous send triggered by Interval.
+func (b *AckBuffer) Ack(events ...*ResolvedEvent) error {
+	b.lock.Lock()
+	b.pending = append(b.pending, events...)
+
+	if len(b.pending) < b.opts.Size {
+		if b.timer == nil && len(b.pending) > 0 {
+			b.timer = time.AfterFunc(b.opts.Interval, b.flushOnTimer)
+		}
+
+		err := b.err
+		b.err = nil
+		b.lock.Unlock()
+		return err
+	}
+
+	b.lock.Unlock()
+	return b.Flush()
+}
+
+// Flush sends the buffered acknowledgements.
+func (b *AckBuffer) Flush() error {
+	b.lock.Lock()
+	pending := b.pending
+	b.pending = nil
+
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+
+	err := b.err
+	b.err = nil
+	b.lock.Unlock()
+
+	if sendErr := b.sub.Ack(pending...); sendErr != nil {
+		return sendErr
+	}
+
+	return err
+}
+
+// Close sends the buffered acknowledgements. It must be called before closing the subscription.
+func (b *AckBuffer) Close() error {
+	return b.Flush()
+}
+
+func (b *AckBuffer) flushOnTimer() {
+	b.lock.Lock()
+	pending := b.pending
+	b.pending = nil
+	b.timer = nil
+	b.lock.Unlock()
+
+	if err := b.sub.Ack(pending...); err != nil {
+		b.sub.logger.error("failed to send %d buffered acknowledgements: %v", len(pending), err)
+
+		b.lock.Lock()
+		b.err = err
+		b.lock.Unlock()
+	}
+}
+
+// Len returns the number of buffered acknowledgements.
+func (b *AckBuffer) Len() int {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	return len(b.pending)
+}
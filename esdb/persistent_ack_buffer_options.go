@@ -0,0 +1,33 @@
+package esdb
+
+import "time"
+
+// AckBufferOptions configures PersistentSubscription.NewAckBuffer.
+type AckBufferOptions struct {
+	// Number of buffered acknowledgements which are sent as soon as reached. Defaults to 100.
+	Size int
+	// Delay after which buffered acknowledgements are sent, however many they are. Defaults to 1 second.
+	Interval time.Duration
+}
+
+func (o *AckBufferOptions) setDefaults() {
+	if o.Size == 0 {
+		o.Size = 100
+	}
+
+	if o.Interval == 0 {
+		o.Interval = time.Second
+	}
+}
+
+func (o *AckBufferOptions) validate() error {
+	if o.Size < 1 {
+		return invalidArgumentError("Size must be strictly positive, got %d", o.Size)
+	}
+
+	if o.Interval < 0 {
+		return invalidArgumentError("Interval can't be negative, got %v", o.Interval)
+	}
+
+	return nil
+}
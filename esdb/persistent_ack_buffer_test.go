@@ -0,0 +1,104 @@
+package esdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/protos/persistent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func persistentTestEvents(count int) []*ResolvedEvent {
+	var events []*ResolvedEvent
+	for i := 0; i < count; i++ {
+		event, _ := fromPersistentProtoResponse(persistentEventResponse(uint64(i), 0))
+		events = append(events, event)
+	}
+
+	return events
+}
+
+func sentAcks(inner *scriptedPersistentReadClient) []int {
+	inner.lock.Lock()
+	defer inner.lock.Unlock()
+
+	var acks []int
+	for _, req := range inner.sent {
+		acks = append(acks, len(req.GetAck().GetIds()))
+	}
+
+	return acks
+}
+
+func TestPersistentSubscriptionBatches(t *testing.T) {
+	inner := newScriptedPersistentReadClient()
+	sub := NewPersistentSubscription(inner, "sub", func() {}, &logger{})
+
+	require.NoError(t, sub.Ack(persistentTestEvents(3)...))
+	require.NoError(t, sub.Nack("poison", Nack_Park, persistentTestEvents(2)...))
+
+	require.Len(t, inner.sent, 2)
+	assert.Len(t, inner.sent[0].GetAck().GetIds(), 3)
+	assert.Len(t, inner.sent[1].GetNack().GetIds(), 2)
+	assert.Equal(t, persistent.ReadReq_Nack_Park, inner.sent[1].GetNack().Action)
+	assert.Equal(t, "poison", inner.sent[1].GetNack().Reason)
+}
+
+func TestAckBufferFlushesWhenFull(t *testing.T) {
+	inner := newScriptedPersistentReadClient()
+	sub := NewPersistentSubscription(inner, "sub", func() {}, &logger{})
+	buffer, err := sub.NewAckBuffer(AckBufferOptions{Size: 3, Interval: time.Hour})
+	require.NoError(t, err)
+
+	events := persistentTestEvents(4)
+	require.NoError(t, buffer.Ack(events[0], events[1]))
+	assert.Empty(t, sentAcks(inner))
+	assert.Equal(t, 2, buffer.Len())
+
+	require.NoError(t, buffer.Ack(events[2]))
+	assert.Equal(t, []int{3}, sentAcks(inner))
+
+	require.NoError(t, buffer.Ack(events[3]))
+	require.NoError(t, buffer.Close())
+	assert.Equal(t, []int{3, 1}, sentAcks(inner))
+	assert.Equal(t, 0, buffer.Len())
+}
+
+func TestAckBufferFlushesOnInterval(t *testing.T) {
+	inner := newScriptedPersistentReadClient()
+	sub := NewPersistentSubscription(inner, "sub", func() {}, &logger{})
+	buffer, err := sub.NewAckBuffer(AckBufferOptions{Interval: 10 * time.Millisecond})
+	require.NoError(t, err)
+
+	require.NoError(t, buffer.Ack(persistentTestEvents(2)...))
+	assert.Eventually(t, func() bool {
+		return len(sentAcks(inner)) == 1
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []int{2}, sentAcks(inner))
+}
+
+func TestAckBufferReportsTimerFailures(t *testing.T) {
+	inner := newScriptedPersistentReadClient()
+	sub := NewPersistentSubscription(inner, "sub", func() {}, &logger{})
+	buffer, err := sub.NewAckBuffer(AckBufferOptions{Interval: time.Millisecond})
+	require.NoError(t, err)
+
+	require.NoError(t, sub.Close())
+	require.NoError(t, buffer.Ack(persistentTestEvents(1)...))
+
+	// The failure of the send triggered by the timer is returned by the next call.
+	assert.Eventually(t, func() bool {
+		buffer.lock.Lock()
+		defer buffer.lock.Unlock()
+		return buffer.err != nil
+	}, time.Second, 5*time.Millisecond)
+	assert.Error(t, buffer.Flush())
+	assert.NoError(t, buffer.Flush())
+}
+
+func TestAckBufferOptionsValidation(t *testing.T) {
+	sub := NewPersistentSubscription(newScriptedPersistentReadClient(), "sub", func() {}, &logger{})
+	_, err := sub.NewAckBuffer(AckBufferOptions{Size: -1})
+	assertInvalidArgument(t, err)
+}
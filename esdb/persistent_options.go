@@ -1,6 +1,11 @@
 package esdb
 
-import "time"
+import (
+	"math"
+	"time"
+
+	uuid "github.com/gofrs/uuid"
+)
 
 type PersistentStreamSubscriptionOptions struct {
 	Settings      *SubscriptionSettings
@@ -25,6 +30,16 @@ func (o *PersistentStreamSubscriptionOptions) setDefaults() {
 	if o.StartFrom == nil {
 		o.StartFrom = End{}
 	}
+
+	o.Settings = o.Settings.withDefaultStrategy()
+}
+
+func (o *PersistentStreamSubscriptionOptions) validate() error {
+	if o.Settings != nil {
+		return o.Settings.validate()
+	}
+
+	return nil
 }
 
 type PersistentAllSubscriptionOptions struct {
@@ -53,6 +68,8 @@ func (o *PersistentAllSubscriptionOptions) setDefaults() {
 		o.StartFrom = End{}
 	}
 
+	o.Settings = o.Settings.withDefaultStrategy()
+
 	if o.Filter != nil {
 		if o.MaxSearchWindow == 0 {
 			o.MaxSearchWindow = 32
@@ -60,10 +77,29 @@ func (o *PersistentAllSubscriptionOptions) setDefaults() {
 	}
 }
 
+func (o *PersistentAllSubscriptionOptions) validate() error {
+	if o.Settings != nil {
+		if err := o.Settings.validate(); err != nil {
+			return err
+		}
+	}
+
+	if o.Filter == nil {
+		return nil
+	}
+
+	return validateSubscriptionFilter(o.Filter, o.MaxSearchWindow)
+}
+
 type SubscribeToPersistentSubscriptionOptions struct {
 	BufferSize    uint32
 	Authenticated *Credentials
 	Deadline      *time.Duration
+	// Makes the subscription connect again to its group, possibly on another node, when its node shuts down or stops
+	// being the leader, instead of dropping. The policy bounds the attempts and the delay between them, and
+	// RetryableCodes is ignored. Recv returns a Reconnected event once connected again. Defaults to nil, which disables
+	// it.
+	Reconnect *RetryPolicy
 }
 
 func (o *SubscribeToPersistentSubscriptionOptions) kind() operationKind {
@@ -82,6 +118,24 @@ func (o *SubscribeToPersistentSubscriptionOptions) setDefaults() {
 	if o.BufferSize == 0 {
 		o.BufferSize = 10
 	}
+
+	if o.Reconnect != nil {
+		policy := *o.Reconnect
+		policy.setDefaults()
+		o.Reconnect = &policy
+	}
+}
+
+func (o *SubscribeToPersistentSubscriptionOptions) validate() error {
+	if o.BufferSize > math.MaxInt32 {
+		return invalidArgumentError("buffer size must not exceed %d, got %d", math.MaxInt32, o.BufferSize)
+	}
+
+	if o.Reconnect != nil {
+		return o.Reconnect.validate()
+	}
+
+	return nil
 }
 
 type DeletePersistentSubscriptionOptions struct {
@@ -119,6 +173,104 @@ func (r ReplayParkedMessagesOptions) deadline() *time.Duration {
 	return r.Deadline
 }
 
+// ReadParkedMessagesOptions configures ReadParkedMessages.
+type ReadParkedMessagesOptions struct {
+	// Revision of the parked messages stream to read from, 0 reading from the oldest parked message. Set it to the
+	// revision after the one of the last message returned to read the next page, see ParkedMessage.
+	From uint64
+	// Maximum number of parked messages returned at once. Defaults to 1000.
+	MaxCount      int
+	Authenticated *Credentials
+	Deadline      *time.Duration
+}
+
+func (o *ReadParkedMessagesOptions) setDefaults() {
+	if o.MaxCount == 0 {
+		o.MaxCount = 1000
+	}
+}
+
+func (o *ReadParkedMessagesOptions) validate() error {
+	if o.MaxCount < 0 {
+		return invalidArgumentError("MaxCount must be positive, got %d", o.MaxCount)
+	}
+
+	return nil
+}
+
+// ReplaySelectedParkedMessagesOptions configures ReplaySelectedParkedMessages. A parked message is replayed when its
+// event ID is one of EventIDs or when Predicate returns true for it.
+type ReplaySelectedParkedMessagesOptions struct {
+	// IDs of the parked events to replay, as found in the Event of the ParkedMessage.
+	EventIDs []uuid.UUID
+	// Tells whether a parked message should be replayed.
+	Predicate func(ParkedMessage) bool
+	// Maximum number of parked messages read before failing with ErrorReadLimitExceeded. Defaults to 1000.
+	MaxCount      int
+	Authenticated *Credentials
+	Deadline      *time.Duration
+}
+
+func (o *ReplaySelectedParkedMessagesOptions) setDefaults() {
+	if o.MaxCount == 0 {
+		o.MaxCount = 1000
+	}
+}
+
+func (o *ReplaySelectedParkedMessagesOptions) validate() error {
+	if len(o.EventIDs) == 0 && o.Predicate == nil {
+		return invalidArgumentError("EventIDs or Predicate must be set to select the parked messages to replay")
+	}
+
+	if o.MaxCount < 0 {
+		return invalidArgumentError("MaxCount must be positive, got %d", o.MaxCount)
+	}
+
+	return nil
+}
+
+// SubscriptionSettingsPatch lists the persistent subscription settings to change, nil fields keeping their current
+// value.
+type SubscriptionSettingsPatch struct {
+	ResolveLinkTos       *bool
+	ExtraStatistics      *bool
+	MaxRetryCount        *int32
+	CheckpointLowerBound *int32
+	CheckpointUpperBound *int32
+	MaxSubscriberCount   *int32
+	LiveBufferSize       *int32
+	ReadBatchSize        *int32
+	HistoryBufferSize    *int32
+	ConsumerStrategyName *ConsumerStrategy
+	MessageTimeout       *int32
+	CheckpointAfter      *int32
+}
+
+// UpdatePersistentSubscriptionSettingsOptions configures UpdatePersistentSubscriptionSettings.
+type UpdatePersistentSubscriptionSettingsOptions struct {
+	// Settings to change.
+	Patch SubscriptionSettingsPatch
+	// New start position of the group, a StreamPosition for a stream group and an AllPosition for a $all group. Only
+	// applied when ChangeStartFrom is set, since moving it can rewind a group.
+	StartFrom interface{}
+	// Confirms StartFrom should replace the current start position.
+	ChangeStartFrom bool
+	Authenticated   *Credentials
+	Deadline        *time.Duration
+}
+
+func (o *UpdatePersistentSubscriptionSettingsOptions) validate() error {
+	if o.StartFrom != nil && !o.ChangeStartFrom {
+		return invalidArgumentError("StartFrom is only applied when ChangeStartFrom is set, to avoid moving the start position of the group by accident")
+	}
+
+	if o.ChangeStartFrom && o.StartFrom == nil {
+		return invalidArgumentError("ChangeStartFrom is set without a StartFrom")
+	}
+
+	return nil
+}
+
 type ListPersistentSubscriptionsOptions struct {
 	Authenticated *Credentials
 	Deadline      *time.Duration
@@ -10,6 +10,7 @@ import (
 	"github.com/EventStore/EventStore-Client-Go/v2/protos/persistent"
 	"github.com/EventStore/EventStore-Client-Go/v2/protos/shared"
 	"github.com/gofrs/uuid"
+	"google.golang.org/grpc/metadata"
 )
 
 type Nack_Action int32
@@ -29,25 +30,62 @@ type PersistentSubscription struct {
 	closed         *int32
 	cancel         context.CancelFunc
 	logger         *logger
+	sendLock       *sync.Mutex
+	// Sends are handed to a single goroutine, started by the first one and stopped by Close, so they go out in
+	// order without a goroutine per (n)ack.
+	sends      chan persistentSend
+	senderOnce sync.Once
+	done       chan struct{}
+	// Trailers of the gRPC call, nil when the subscription wasn't created by the client.
+	trailers *metadata.MD
+	// Connection of the gRPC call, nil when the subscription wasn't created by the client.
+	handle *connectionHandle
+	// Set when the subscription reconnects automatically, see SubscribeToPersistentSubscriptionOptions.Reconnect.
+	reconnect *RetryPolicy
+	reopen    func(previous *connectionHandle) (*PersistentSubscription, error)
+	// Done once the subscription gets closed, interrupting the reconnection backoff.
+	reconnectCtx  context.Context
+	stopReconnect context.CancelFunc
+	// Events deferred by NackRetryAfter, and the receive of the call left pending while waiting for them.
+	deferLock sync.Mutex
+	deferred  deferredRetries
+	deferWake chan struct{}
+	pending   chan persistentRecvResult
+	// Guards client, cancel, subscriptionId, trailers and handle, which are replaced when reconnecting. Sends hold
+	// sendLock, which reconnecting takes too.
+	lock sync.Mutex
 }
 
+// Recv returns the next event of the subscription. It must not be called from several goroutines at once, while
+// Close, Ack and Nack can be called concurrently with it.
 func (connection *PersistentSubscription) Recv() *PersistentSubscriptionEvent {
 	if atomic.LoadInt32(connection.closed) != 0 {
 		return &PersistentSubscriptionEvent{
 			SubscriptionDropped: &SubscriptionDropped{
-				Error: fmt.Errorf("subscription has been dropped"),
+				Error:  fmt.Errorf("subscription has been dropped"),
+				Reason: SubscriptionDropUnsubscribed,
 			},
 		}
 	}
 
-	result, err := connection.client.Recv()
+	result, retry, err := connection.receive()
+	if retry != nil {
+		return &PersistentSubscriptionEvent{EventAppeared: retry}
+	}
+
 	if err != nil {
+		reconnected, err := connection.reconnectAfter(err)
+		if reconnected != nil {
+			return &PersistentSubscriptionEvent{Reconnected: reconnected}
+		}
+
 		atomic.StoreInt32(connection.closed, 1)
 
 		connection.logger.error("subscription has dropped. Reason: %v", err)
 
 		dropped := SubscriptionDropped{
-			Error: err,
+			Error:  err,
+			Reason: dropReason(err, connection.call().trailers),
 		}
 
 		return &PersistentSubscriptionEvent{
@@ -59,10 +97,13 @@ func (connection *PersistentSubscription) Recv() *PersistentSubscriptionEvent {
 	case *persistent.ReadResp_Event:
 		{
 			resolvedEvent, retryCount := fromPersistentProtoResponse(result)
+			_, reported := result.GetEvent().GetCount().(*persistent.ReadResp_ReadEvent_RetryCount)
 			return &PersistentSubscriptionEvent{
 				EventAppeared: &EventAppeared{
-					Event:      resolvedEvent,
-					RetryCount: retryCount,
+					Event:              resolvedEvent,
+					RetryCount:         retryCount,
+					RetryCountReported: reported,
+					SubscriptionID:     connection.id(),
 				},
 			}
 		}
@@ -74,6 +115,13 @@ func (connection *PersistentSubscription) Recv() *PersistentSubscriptionEvent {
 func (connection *PersistentSubscription) Close() error {
 	connection.once.Do(func() {
 		atomic.StoreInt32(connection.closed, 1)
+		close(connection.done)
+		if connection.stopReconnect != nil {
+			connection.stopReconnect()
+		}
+
+		connection.lock.Lock()
+		defer connection.lock.Unlock()
 		connection.cancel()
 		connection.client.CloseSend()
 	})
@@ -81,6 +129,13 @@ func (connection *PersistentSubscription) Close() error {
 }
 
 func (connection *PersistentSubscription) Ack(messages ...*ResolvedEvent) error {
+	return connection.AckContext(context.Background(), messages...)
+}
+
+// AckContext acknowledges the messages. If the context is done before the acknowledgement could be handed to the
+// transport, the context error is returned. When that happens after the send started, the acknowledgement may still
+// reach the server.
+func (connection *PersistentSubscription) AckContext(ctx context.Context, messages ...*ResolvedEvent) error {
 	if len(messages) == 0 {
 		return nil
 	}
@@ -90,22 +145,22 @@ func (connection *PersistentSubscription) Ack(messages ...*ResolvedEvent) error
 		ids = append(ids, event.OriginalEvent().EventID)
 	}
 
-	err := connection.client.Send(&persistent.ReadReq{
+	return connection.send(ctx, &persistent.ReadReq{
 		Content: &persistent.ReadReq_Ack_{
 			Ack: &persistent.ReadReq_Ack{
-				Id:  []byte(connection.subscriptionId),
+				Id:  []byte(connection.id()),
 				Ids: messageIdSliceToProto(ids...),
 			},
 		},
 	})
-	if err != nil {
-		return err
-	}
-
-	return nil
 }
 
 func (connection *PersistentSubscription) Nack(reason string, action Nack_Action, messages ...*ResolvedEvent) error {
+	return connection.NackContext(context.Background(), reason, action, messages...)
+}
+
+// NackContext negatively acknowledges the messages, see AckContext for how the context is handled.
+func (connection *PersistentSubscription) NackContext(ctx context.Context, reason string, action Nack_Action, messages ...*ResolvedEvent) error {
 	if len(messages) == 0 {
 		return nil
 	}
@@ -115,21 +170,107 @@ func (connection *PersistentSubscription) Nack(reason string, action Nack_Action
 		ids = append(ids, event.OriginalEvent().EventID)
 	}
 
-	err := connection.client.Send(&persistent.ReadReq{
+	return connection.send(ctx, &persistent.ReadReq{
 		Content: &persistent.ReadReq_Nack_{
 			Nack: &persistent.ReadReq_Nack{
-				Id:     []byte(connection.subscriptionId),
+				Id:     []byte(connection.id()),
 				Ids:    messageIdSliceToProto(ids...),
 				Action: persistent.ReadReq_Nack_Action(action),
 				Reason: reason,
 			},
 		},
 	})
-	if err != nil {
+}
+
+// persistentSend is a request waiting for the sender goroutine, which reports the outcome on result.
+type persistentSend struct {
+	ctx    context.Context
+	req    *persistent.ReadReq
+	result chan error
+}
+
+// send serializes the writes to the underlying stream, as gRPC streams don't support concurrent sends. A request whose
+// context is done before the sender gets to it isn't sent.
+func (connection *PersistentSubscription) send(ctx context.Context, req *persistent.ReadReq) error {
+	if err := ctx.Err(); err != nil {
 		return err
 	}
 
-	return nil
+	if atomic.LoadInt32(connection.closed) != 0 {
+		return persistentSubscriptionClosedError()
+	}
+
+	connection.senderOnce.Do(func() {
+		go connection.runSender()
+	})
+
+	request := persistentSend{ctx: ctx, req: req, result: make(chan error, 1)}
+	select {
+	case connection.sends <- request:
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-connection.done:
+		return persistentSubscriptionClosedError()
+	}
+
+	select {
+	case err := <-request.result:
+		return err
+	case <-ctx.Done():
+		// The outcome wins over the context when both are available.
+		select {
+		case err := <-request.result:
+			return err
+		default:
+			return ctx.Err()
+		}
+	}
+}
+
+// runSender sends the requests handed to it one at a time, until the subscription is closed.
+func (connection *PersistentSubscription) runSender() {
+	for {
+		select {
+		case <-connection.done:
+			return
+		case request := <-connection.sends:
+			if err := request.ctx.Err(); err != nil {
+				request.result <- err
+				continue
+			}
+
+			connection.sendLock.Lock()
+			err := connection.client.Send(request.req)
+			connection.sendLock.Unlock()
+
+			request.result <- err
+		}
+	}
+}
+
+func persistentSubscriptionClosedError() error {
+	return &Error{code: ErrorConnectionClosed, err: fmt.Errorf("persistent subscription is closed")}
+}
+
+// id returns the subscription ID of the current connection to the group.
+func (connection *PersistentSubscription) id() string {
+	connection.lock.Lock()
+	defer connection.lock.Unlock()
+	return connection.subscriptionId
+}
+
+// persistentCall is the state of the current call to the group, replaced when reconnecting.
+type persistentCall struct {
+	client   persistent.PersistentSubscriptions_ReadClient
+	trailers *metadata.MD
+	handle   *connectionHandle
+}
+
+// call returns the state of the current call to the group.
+func (connection *PersistentSubscription) call() persistentCall {
+	connection.lock.Lock()
+	defer connection.lock.Unlock()
+	return persistentCall{client: connection.client, trailers: connection.trailers, handle: connection.handle}
 }
 
 func messageIdSliceToProto(messageIds ...uuid.UUID) []*shared.UUID {
@@ -159,5 +300,9 @@ func NewPersistentSubscription(
 		closed:         closed,
 		cancel:         cancel,
 		logger:         logger,
+		sendLock:       new(sync.Mutex),
+		sends:          make(chan persistentSend),
+		done:           make(chan struct{}),
+		deferWake:      make(chan struct{}, 1),
 	}
 }
@@ -51,6 +51,8 @@ func (client *persistentClient) ConnectToPersistentSubscription(
 				readClient,
 				readResult.GetSubscriptionConfirmation().SubscriptionId,
 				cancel, client.inner.logger)
+			asyncConnection.trailers = &trailers
+			asyncConnection.handle = handle
 
 			return asyncConnection, nil
 		}
@@ -418,7 +420,7 @@ func subscriptionInfoFromWire(wire *persistent.SubscriptionInfo) (*PersistentSub
 			}
 
 			stats.LastCheckpointedEventRevision = new(uint64)
-			*stats.LastKnownEventRevision = lastRev
+			*stats.LastCheckpointedEventRevision = lastRev
 		}
 	}
 
@@ -0,0 +1,333 @@
+package esdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// PersistentSubscriptionConsumer consumes a persistent subscription with a handler. Events are acknowledged once
+// handled, and negatively acknowledged when the handler fails: they are retried by the server up to MaxRetries times,
+// then parked or skipped. The consumer reconnects when the subscription drops.
+type PersistentSubscriptionConsumer struct {
+	client     *Client
+	streamName string
+	groupName  string
+	handler    SubscriptionHandler
+	opts       PersistentSubscriptionConsumerOptions
+	// Opens the subscription, replaced in tests.
+	subscribe func(ctx context.Context) (*PersistentSubscription, error)
+	// Closed by Shutdown, which bounds the draining of the handlers with drainCtx.
+	shutdown     chan struct{}
+	shutdownOnce sync.Once
+	drainCtx     context.Context
+	// Set while Run runs, and closed when it returns.
+	running int32
+	stopped chan struct{}
+}
+
+// NewPersistentSubscriptionConsumer prepares a consumer of the persistent subscription group of a stream, or of $all
+// when streamName is "$all". The group must exist. Run starts consuming.
+func (client *Client) NewPersistentSubscriptionConsumer(
+	streamName string,
+	groupName string,
+	handler SubscriptionHandler,
+	opts PersistentSubscriptionConsumerOptions,
+) (*PersistentSubscriptionConsumer, error) {
+	opts.setDefaults()
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	consumer := &PersistentSubscriptionConsumer{
+		client:     client,
+		streamName: streamName,
+		groupName:  groupName,
+		handler:    handler,
+		opts:       opts,
+		shutdown:   make(chan struct{}),
+		stopped:    make(chan struct{}),
+	}
+
+	consumer.subscribe = func(ctx context.Context) (*PersistentSubscription, error) {
+		if streamName == "$all" {
+			return client.SubscribeToPersistentSubscriptionToAll(ctx, groupName, opts.Subscription)
+		}
+
+		return client.SubscribeToPersistentSubscription(ctx, streamName, groupName, opts.Subscription)
+	}
+
+	return consumer, nil
+}
+
+// Run consumes events until the context gets cancelled, in which case it returns nil once the handlers in flight
+// returned. It returns an error when subscribing fails permanently, because the group doesn't exist or access is
+// denied for instance, or after MaxAttempts consecutive failures. Shutdown stops it gracefully. A consumer only runs
+// once.
+func (c *PersistentSubscriptionConsumer) Run(ctx context.Context) error {
+	if !atomic.CompareAndSwapInt32(&c.running, 0, 1) {
+		return fmt.Errorf("persistent subscription consumer can only run once")
+	}
+	defer close(c.stopped)
+
+	var failures int
+	for {
+		if c.shuttingDown() {
+			return nil
+		}
+
+		sub, err := c.subscribe(ctx)
+
+		if ctx.Err() != nil || c.shuttingDown() {
+			if sub != nil {
+				_ = sub.Close()
+			}
+
+			return nil
+		}
+
+		if err == nil {
+			failures = 0
+			dropped := c.consume(ctx, sub)
+
+			if ctx.Err() != nil || c.shuttingDown() {
+				return nil
+			}
+
+			err = dropped.Error
+			if dropped.Reason == SubscriptionDropAccessDenied || dropped.Reason == SubscriptionDropStreamDeleted {
+				return fmt.Errorf("persistent subscription '%s' of stream '%s' dropped: %w", c.groupName, c.streamName, err)
+			}
+		} else if consumerPermanent(err) {
+			return fmt.Errorf("failed to subscribe to persistent subscription '%s' of stream '%s': %w", c.groupName, c.streamName, err)
+		}
+
+		failures++
+		if c.opts.MaxAttempts > 0 && failures >= c.opts.MaxAttempts {
+			return fmt.Errorf("persistent subscription '%s' of stream '%s' failed %d times in a row: %w", c.groupName, c.streamName, failures, err)
+		}
+
+		delay := c.backoff(failures)
+		c.client.grpcClient.logger.warn("persistent subscription '%s' of stream '%s' dropped, reconnecting in %v. Reason: %v", c.groupName, c.streamName, delay, err)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-c.shutdown:
+			timer.Stop()
+			return nil
+		case <-timer.C:
+		}
+	}
+}
+
+// consume handles the events of the subscription until it drops, and waits for the handlers in flight.
+func (c *PersistentSubscriptionConsumer) consume(ctx context.Context, sub *PersistentSubscription) SubscriptionDropped {
+	defer sub.Close()
+
+	stop := closeOnDone(ctx, func() {
+		_ = sub.Close()
+	})
+	defer stop()
+
+	slots := make(chan struct{}, c.opts.Concurrency)
+	var handlers sync.WaitGroup
+	defer handlers.Wait()
+
+	finished := make(chan struct{})
+	defer close(finished)
+	go c.drainOnShutdown(sub, slots, finished)
+
+	for {
+		event := sub.Recv()
+
+		if event.SubscriptionDropped != nil {
+			return *event.SubscriptionDropped
+		}
+
+		if event.EventAppeared == nil {
+			continue
+		}
+
+		select {
+		case slots <- struct{}{}:
+		case <-c.shutdown:
+			continue
+		}
+
+		// Events received once shutting down are left to the server, which redelivers them once the subscription
+		// gets closed.
+		if c.shuttingDown() {
+			<-slots
+			continue
+		}
+
+		handlers.Add(1)
+
+		go func(appeared *EventAppeared) {
+			defer handlers.Done()
+			defer func() { <-slots }()
+
+			c.handle(ctx, sub, appeared)
+		}(event.EventAppeared)
+	}
+}
+
+// Shutdown stops the consumer gracefully: it stops handling new events, waits for the handlers in flight to
+// acknowledge their events, then closes the subscription, so that only the events it didn't handle get redelivered.
+// When the context is done before the handlers return, the subscription gets closed right away and the context error
+// is returned. Run returns nil once stopped.
+func (c *PersistentSubscriptionConsumer) Shutdown(ctx context.Context) error {
+	c.shutdownOnce.Do(func() {
+		c.drainCtx = ctx
+		close(c.shutdown)
+	})
+
+	if atomic.LoadInt32(&c.running) == 0 {
+		return nil
+	}
+
+	select {
+	case <-c.stopped:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (c *PersistentSubscriptionConsumer) shuttingDown() bool {
+	select {
+	case <-c.shutdown:
+		return true
+	default:
+		return false
+	}
+}
+
+// drainOnShutdown closes the subscription once shutting down and every handler slot is free, which means no handler
+// is in flight, or when the shutdown context is done first.
+func (c *PersistentSubscriptionConsumer) drainOnShutdown(sub *PersistentSubscription, slots chan struct{}, finished chan struct{}) {
+	select {
+	case <-c.shutdown:
+	case <-finished:
+		return
+	}
+
+	drained := make(chan struct{})
+	go func() {
+		for i := 0; i < cap(slots); i++ {
+			select {
+			case slots <- struct{}{}:
+			case <-finished:
+				return
+			}
+		}
+
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+	case <-c.drainCtx.Done():
+		c.client.grpcClient.logger.warn("persistent subscription '%s' of stream '%s' closed before its handlers returned: %v", c.groupName, c.streamName, c.drainCtx.Err())
+	case <-finished:
+		return
+	}
+
+	_ = sub.Close()
+}
+
+// handle runs the handler on an event and acknowledges it accordingly.
+func (c *PersistentSubscriptionConsumer) handle(ctx context.Context, sub *PersistentSubscription, appeared *EventAppeared) {
+	timedOut, err := c.runHandler(ctx, appeared)
+
+	if timedOut {
+		action := c.opts.TimeoutAction
+		if action == Nack_Retry && appeared.RetryCount+appeared.Deferrals >= c.opts.MaxRetries {
+			action = c.opts.ExhaustedAction
+		}
+
+		event := appeared.Event.OriginalEvent()
+		c.client.grpcClient.logger.warn("handler of persistent subscription '%s' didn't handle event %d@%s within %v, negatively acknowledging it with action %d",
+			c.groupName, event.EventNumber, event.StreamID, c.opts.MessageTimeout, action)
+		err = sub.Nack(fmt.Sprintf("handler timed out after %v", c.opts.MessageTimeout), action, appeared.Event)
+	} else if err == nil {
+		err = sub.Ack(appeared.Event)
+	} else if appeared.RetryCount+appeared.Deferrals >= c.opts.MaxRetries {
+		err = sub.Nack(err.Error(), c.opts.ExhaustedAction, appeared.Event)
+	} else if c.opts.RetryDelay > 0 {
+		err = sub.NackRetryAfter(c.opts.retryDelay(appeared.Deferrals), appeared)
+	} else {
+		err = sub.Nack(err.Error(), Nack_Retry, appeared.Event)
+	}
+
+	// The event is retried by the server once the subscription drops, which failing to (n)ack usually means.
+	if err != nil {
+		c.client.grpcClient.logger.warn("failed to acknowledge event of persistent subscription '%s': %v", c.groupName, err)
+	}
+}
+
+// runHandler runs the handler on an event, giving up on it after MessageTimeout. When the consumer stops, it waits for
+// the handler instead, as Run does.
+func (c *PersistentSubscriptionConsumer) runHandler(ctx context.Context, appeared *EventAppeared) (bool, error) {
+	if c.opts.MessageTimeout == 0 {
+		return false, c.handler(ctx, appeared.Event)
+	}
+
+	handlerCtx, cancel := context.WithTimeout(ctx, c.opts.MessageTimeout)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- c.handler(handlerCtx, appeared.Event)
+	}()
+
+	select {
+	case err := <-done:
+		return false, err
+	case <-handlerCtx.Done():
+	}
+
+	select {
+	case err := <-done:
+		return false, err
+	default:
+	}
+
+	if ctx.Err() != nil {
+		return false, <-done
+	}
+
+	return true, nil
+}
+
+func (c *PersistentSubscriptionConsumer) backoff(failures int) time.Duration {
+	delay := c.opts.InitialBackoff
+	for i := 1; i < failures && delay < c.opts.MaxBackoff; i++ {
+		delay *= 2
+	}
+
+	if delay > c.opts.MaxBackoff {
+		delay = c.opts.MaxBackoff
+	}
+
+	return delay
+}
+
+// consumerPermanent tells whether subscribing again after err is pointless.
+func consumerPermanent(err error) bool {
+	var esdbErr *Error
+	if errors.As(err, &esdbErr) {
+		switch esdbErr.Code() {
+		case ErrorResourceNotFound, ErrorAccessDenied, ErrorUnauthenticated, ErrorStreamDeleted, ErrorInvalidArgument, ErrorUnsupportedFeature:
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,127 @@
+package esdb
+
+import "time"
+
+// PersistentSubscriptionConsumerOptions configures NewPersistentSubscriptionConsumer.
+type PersistentSubscriptionConsumerOptions struct {
+	// Options of the underlying subscription. BufferSize bounds the number of events in flight, so it should be at
+	// least Concurrency.
+	Subscription SubscribeToPersistentSubscriptionOptions
+	// Number of times an event whose handler failed is retried before ExhaustedAction applies.
+	MaxRetries int // Defaults to 3.
+	// Makes the retries of an event whose handler failed happen client-side after a delay, doubling with every
+	// retry up to MaxRetryDelay, using PersistentSubscription.NackRetryAfter. Defaults to 0, which retries it with Nack_Retry.
+	RetryDelay time.Duration
+	// Upper bound of the delay between retries when RetryDelay is set. It should stay below the MessageTimeout of the
+	// group, past which the server retries the event itself. Defaults to 10 seconds.
+	MaxRetryDelay time.Duration
+	// Applied to an event whose handler failed after MaxRetries retries, either Nack_Park or Nack_Skip.
+	ExhaustedAction Nack_Action // Defaults to Nack_Park.
+	// Number of events handled at once.
+	Concurrency int // Defaults to 1.
+	// Time given to the handler to handle an event, after which the event is negatively acknowledged with
+	// TimeoutAction and the context of the handler cancelled. A handler ignoring its context keeps running, but its
+	// outcome is ignored and it no longer counts towards Concurrency. Defaults to 0, which waits for the handler.
+	MessageTimeout time.Duration
+	// Applied to an event whose handler timed out, either Nack_Retry, Nack_Park or Nack_Skip. ExhaustedAction applies
+	// instead of Nack_Retry once the event was retried MaxRetries times.
+	TimeoutAction Nack_Action // Defaults to Nack_Retry.
+	// Delay before the first reconnection. It doubles after every failed attempt.
+	InitialBackoff time.Duration // Defaults to 100 milliseconds.
+	// Upper bound of the delay between reconnections.
+	MaxBackoff time.Duration // Defaults to 5 seconds.
+	// Number of consecutive failed subscriptions after which Run gives up. Defaults to 0, which reconnects until the
+	// context gets cancelled.
+	MaxAttempts int
+}
+
+func (o *PersistentSubscriptionConsumerOptions) setDefaults() {
+	o.Subscription.setDefaults()
+
+	if o.MaxRetries == 0 {
+		o.MaxRetries = 3
+	}
+
+	if o.MaxRetryDelay == 0 {
+		o.MaxRetryDelay = 10 * time.Second
+	}
+
+	if o.ExhaustedAction == Nack_Unknown {
+		o.ExhaustedAction = Nack_Park
+	}
+
+	if o.Concurrency == 0 {
+		o.Concurrency = 1
+	}
+
+	if o.TimeoutAction == Nack_Unknown {
+		o.TimeoutAction = Nack_Retry
+	}
+
+	if o.InitialBackoff == 0 {
+		o.InitialBackoff = 100 * time.Millisecond
+	}
+
+	if o.MaxBackoff == 0 {
+		o.MaxBackoff = 5 * time.Second
+	}
+}
+
+func (o *PersistentSubscriptionConsumerOptions) validate() error {
+	if err := o.Subscription.validate(); err != nil {
+		return err
+	}
+
+	if o.RetryDelay < 0 {
+		return invalidArgumentError("RetryDelay can't be negative, got %v", o.RetryDelay)
+	}
+
+	if o.MaxRetryDelay < o.RetryDelay {
+		return invalidArgumentError("MaxRetryDelay (%v) can't be lower than RetryDelay (%v)", o.MaxRetryDelay, o.RetryDelay)
+	}
+
+	if o.MaxRetries < 0 {
+		return invalidArgumentError("MaxRetries can't be negative, got %d", o.MaxRetries)
+	}
+
+	if o.ExhaustedAction != Nack_Park && o.ExhaustedAction != Nack_Skip {
+		return invalidArgumentError("ExhaustedAction must be Nack_Park or Nack_Skip, got %d", o.ExhaustedAction)
+	}
+
+	if o.Concurrency < 1 {
+		return invalidArgumentError("Concurrency must be strictly positive, got %d", o.Concurrency)
+	}
+
+	if o.MessageTimeout < 0 {
+		return invalidArgumentError("MessageTimeout can't be negative, got %v", o.MessageTimeout)
+	}
+
+	if o.TimeoutAction != Nack_Retry && o.TimeoutAction != Nack_Park && o.TimeoutAction != Nack_Skip {
+		return invalidArgumentError("TimeoutAction must be Nack_Retry, Nack_Park or Nack_Skip, got %d", o.TimeoutAction)
+	}
+
+	if o.InitialBackoff < 0 || o.MaxBackoff < 0 {
+		return invalidArgumentError("backoffs can't be negative, got %v and %v", o.InitialBackoff, o.MaxBackoff)
+	}
+
+	if o.MaxAttempts < 0 {
+		return invalidArgumentError("MaxAttempts can't be negative, got %d", o.MaxAttempts)
+	}
+
+	return nil
+}
+
+// retryDelay returns the delay before retrying an event deferred the given number of times, doubling RetryDelay with
+// every retry up to MaxRetryDelay.
+func (o *PersistentSubscriptionConsumerOptions) retryDelay(deferrals int) time.Duration {
+	delay := o.RetryDelay
+	for i := 0; i < deferrals && delay < o.MaxRetryDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > o.MaxRetryDelay {
+		return o.MaxRetryDelay
+	}
+
+	return delay
+}
@@ -0,0 +1,352 @@
+package esdb
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/protos/persistent"
+	"github.com/EventStore/EventStore-Client-Go/v2/protos/shared"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// scriptedPersistentReadClient replays the given responses, then returns io.EOF once every response got (n)acked. It
+// records the requests sent.
+type scriptedPersistentReadClient struct {
+	grpc.ClientStream
+	responses []*persistent.ReadResp
+	expected  int
+	acked     chan struct{}
+
+	lock sync.Mutex
+	sent []*persistent.ReadReq
+}
+
+func newScriptedPersistentReadClient(responses ...*persistent.ReadResp) *scriptedPersistentReadClient {
+	return &scriptedPersistentReadClient{responses: responses, expected: len(responses), acked: make(chan struct{})}
+}
+
+func (c *scriptedPersistentReadClient) Recv() (*persistent.ReadResp, error) {
+	if len(c.responses) == 0 {
+		<-c.acked
+		return nil, io.EOF
+	}
+
+	response := c.responses[0]
+	c.responses = c.responses[1:]
+	return response, nil
+}
+
+func (c *scriptedPersistentReadClient) Send(req *persistent.ReadReq) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.sent = append(c.sent, req)
+	if len(c.sent) == c.expected {
+		close(c.acked)
+	}
+
+	return nil
+}
+
+func (c *scriptedPersistentReadClient) CloseSend() error {
+	return nil
+}
+
+func persistentEventResponse(revision uint64, retryCount int32) *persistent.ReadResp {
+	return &persistent.ReadResp{Content: &persistent.ReadResp_Event{Event: &persistent.ReadResp_ReadEvent{
+		Event: &persistent.ReadResp_ReadEvent_RecordedEvent{
+			Id:               &shared.UUID{Value: &shared.UUID_String_{String_: uuid.Must(uuid.NewV4()).String()}},
+			StreamIdentifier: &shared.StreamIdentifier{StreamName: []byte("orders")},
+			StreamRevision:   revision,
+			Metadata: map[string]string{
+				systemMetadataKeysType:        "TestEvent",
+				systemMetadataKeysContentType: "application/json",
+				systemMetadataKeysCreated:     "16000000000000000",
+			},
+			Data: []byte(strconv.FormatUint(revision, 10)),
+		},
+		Count: &persistent.ReadResp_ReadEvent_RetryCount{RetryCount: retryCount},
+	}}}
+}
+
+func TestPersistentSubscriptionConsumerAcknowledges(t *testing.T) {
+	client := &Client{grpcClient: &grpcClient{logger: &logger{}}}
+	inner := newScriptedPersistentReadClient(
+		persistentEventResponse(0, 0),
+		persistentEventResponse(1, 0),
+		persistentEventResponse(2, 3),
+	)
+
+	consumer, err := client.NewPersistentSubscriptionConsumer("orders", "group", func(_ context.Context, event *ResolvedEvent) error {
+		if event.OriginalEvent().EventNumber > 0 {
+			return errors.New("boom")
+		}
+
+		return nil
+	}, PersistentSubscriptionConsumerOptions{ExhaustedAction: Nack_Skip, Concurrency: 2})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var subscriptions int
+	consumer.subscribe = func(context.Context) (*PersistentSubscription, error) {
+		subscriptions++
+		if subscriptions > 1 {
+			// The scripted subscription dropped, stop once the consumer reconnects.
+			cancel()
+			return nil, errors.New("cancelled")
+		}
+
+		return NewPersistentSubscription(inner, "sub", func() {}, client.grpcClient.logger), nil
+	}
+
+	require.NoError(t, consumer.Run(ctx))
+	assert.Equal(t, 2, subscriptions)
+
+	var acks int
+	actions := map[persistent.ReadReq_Nack_Action]int{}
+	for _, req := range inner.sent {
+		if req.GetAck() != nil {
+			acks++
+		}
+
+		if nack := req.GetNack(); nack != nil {
+			actions[nack.Action]++
+			assert.Equal(t, "boom", nack.Reason)
+		}
+	}
+
+	assert.Equal(t, 1, acks)
+	assert.Equal(t, map[persistent.ReadReq_Nack_Action]int{
+		persistent.ReadReq_Nack_Retry: 1,
+		persistent.ReadReq_Nack_Skip:  1,
+	}, actions)
+}
+
+func TestPersistentSubscriptionConsumerStopsOnPermanentErrors(t *testing.T) {
+	client := &Client{grpcClient: &grpcClient{logger: &logger{}}}
+	consumer, err := client.NewPersistentSubscriptionConsumer("orders", "group", nil, PersistentSubscriptionConsumerOptions{})
+	require.NoError(t, err)
+
+	notFound := &Error{code: ErrorResourceNotFound}
+	consumer.subscribe = func(context.Context) (*PersistentSubscription, error) {
+		return nil, notFound
+	}
+
+	assert.ErrorIs(t, consumer.Run(context.Background()), notFound)
+}
+
+func TestPersistentSubscriptionConsumerOptionsValidation(t *testing.T) {
+	client := &Client{grpcClient: &grpcClient{logger: &logger{}}}
+
+	_, err := client.NewPersistentSubscriptionConsumer("orders", "group", nil, PersistentSubscriptionConsumerOptions{ExhaustedAction: Nack_Retry})
+	assertInvalidArgument(t, err)
+
+	_, err = client.NewPersistentSubscriptionConsumer("orders", "group", nil, PersistentSubscriptionConsumerOptions{Concurrency: -1})
+	assertInvalidArgument(t, err)
+
+	_, err = client.NewPersistentSubscriptionConsumer("orders", "group", nil, PersistentSubscriptionConsumerOptions{RetryDelay: time.Minute, MaxRetryDelay: time.Second})
+	assertInvalidArgument(t, err)
+}
+
+func TestPersistentSubscriptionConsumerRetryDelayIsBounded(t *testing.T) {
+	opts := PersistentSubscriptionConsumerOptions{RetryDelay: 100 * time.Millisecond}
+	opts.setDefaults()
+
+	assert.Equal(t, 100*time.Millisecond, opts.retryDelay(0))
+	assert.Equal(t, 400*time.Millisecond, opts.retryDelay(2))
+	assert.Equal(t, 10*time.Second, opts.retryDelay(7))
+	assert.Equal(t, 10*time.Second, opts.retryDelay(100))
+}
+
+func TestPersistentSubscriptionEventAppeared(t *testing.T) {
+	inner := newScriptedPersistentReadClient(persistentEventResponse(0, 2))
+	sub := NewPersistentSubscription(inner, "sub-id", func() {}, &logger{})
+
+	event := sub.Recv()
+	require.NotNil(t, event.EventAppeared)
+	assert.Equal(t, 2, event.EventAppeared.RetryCount)
+	assert.True(t, event.EventAppeared.RetryCountReported)
+	assert.True(t, event.EventAppeared.Retried())
+	assert.Equal(t, "sub-id", event.EventAppeared.SubscriptionID)
+}
+
+func TestPersistentSubscriptionConsumerNacksTimedOutEvents(t *testing.T) {
+	client := &Client{grpcClient: &grpcClient{logger: &logger{}}}
+	inner := newScriptedPersistentReadClient(persistentEventResponse(0, 0), persistentEventResponse(1, 0))
+
+	release := make(chan struct{})
+	defer close(release)
+
+	consumer, err := client.NewPersistentSubscriptionConsumer("orders", "group", func(ctx context.Context, event *ResolvedEvent) error {
+		if event.OriginalEvent().EventNumber == 0 {
+			// Hangs, ignoring its context.
+			<-release
+		}
+
+		return nil
+	}, PersistentSubscriptionConsumerOptions{MessageTimeout: 20 * time.Millisecond, TimeoutAction: Nack_Park})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var subscriptions int
+	consumer.subscribe = func(context.Context) (*PersistentSubscription, error) {
+		subscriptions++
+		if subscriptions > 1 {
+			cancel()
+			return nil, errors.New("cancelled")
+		}
+
+		return NewPersistentSubscription(inner, "sub", func() {}, client.grpcClient.logger), nil
+	}
+
+	require.NoError(t, consumer.Run(ctx))
+	require.Len(t, inner.sent, 2)
+	assert.Equal(t, persistent.ReadReq_Nack_Park, inner.sent[0].GetNack().Action)
+	assert.NotNil(t, inner.sent[1].GetAck())
+}
+
+// cancellablePersistentReadClient is a scriptedPersistentReadClient whose call can be cancelled.
+type cancellablePersistentReadClient struct {
+	*scriptedPersistentReadClient
+	cancelled chan struct{}
+}
+
+func (c *cancellablePersistentReadClient) Recv() (*persistent.ReadResp, error) {
+	if len(c.responses) > 0 {
+		return c.scriptedPersistentReadClient.Recv()
+	}
+
+	select {
+	case <-c.acked:
+		return nil, io.EOF
+	case <-c.cancelled:
+		return nil, status.Error(codes.Canceled, "cancelled")
+	}
+}
+
+func (c *cancellablePersistentReadClient) sentCount() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return len(c.sent)
+}
+
+func runShutdownConsumer(t *testing.T, handler SubscriptionHandler) (*PersistentSubscriptionConsumer, *cancellablePersistentReadClient, chan int, chan error) {
+	client := &Client{grpcClient: &grpcClient{logger: &logger{}}}
+	inner := &cancellablePersistentReadClient{
+		scriptedPersistentReadClient: newScriptedPersistentReadClient(persistentEventResponse(0, 0), persistentEventResponse(1, 0)),
+		cancelled:                    make(chan struct{}),
+	}
+
+	consumer, err := client.NewPersistentSubscriptionConsumer("orders", "group", handler, PersistentSubscriptionConsumerOptions{Concurrency: 2})
+	require.NoError(t, err)
+
+	// Receives the number of requests sent when the subscription got closed.
+	closed := make(chan int, 1)
+	consumer.subscribe = func(context.Context) (*PersistentSubscription, error) {
+		return NewPersistentSubscription(inner, "sub", func() {
+			closed <- inner.sentCount()
+			close(inner.cancelled)
+		}, client.grpcClient.logger), nil
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- consumer.Run(context.Background())
+	}()
+
+	return consumer, inner, closed, done
+}
+
+func TestPersistentSubscriptionConsumerShutdownDrainsHandlers(t *testing.T) {
+	started := make(chan struct{}, 2)
+	release := make(chan struct{})
+
+	consumer, _, closed, done := runShutdownConsumer(t, func(context.Context, *ResolvedEvent) error {
+		started <- struct{}{}
+		<-release
+		return nil
+	})
+
+	<-started
+	<-started
+
+	shutdown := make(chan error, 1)
+	go func() {
+		shutdown <- consumer.Shutdown(context.Background())
+	}()
+
+	select {
+	case <-closed:
+		t.Fatal("the subscription got closed before its handlers returned")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(release)
+	require.NoError(t, <-shutdown)
+	require.NoError(t, <-done)
+
+	select {
+	case sent := <-closed:
+		assert.Equal(t, 2, sent)
+	default:
+		// The scripted subscription ended on its own once both events got acknowledged.
+	}
+}
+
+func TestPersistentSubscriptionConsumerShutdownIsBoundedByItsContext(t *testing.T) {
+	started := make(chan struct{}, 2)
+	release := make(chan struct{})
+
+	consumer, _, closed, done := runShutdownConsumer(t, func(context.Context, *ResolvedEvent) error {
+		started <- struct{}{}
+		<-release
+		return nil
+	})
+
+	<-started
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	assert.ErrorIs(t, consumer.Shutdown(ctx), context.DeadlineExceeded)
+	assert.Equal(t, 0, <-closed)
+
+	close(release)
+	require.NoError(t, <-done)
+}
+
+func TestPersistentSubscriptionSendsInOrder(t *testing.T) {
+	inner := newScriptedPersistentReadClient()
+	sub := NewPersistentSubscription(inner, "id", func() {}, &logger{})
+
+	var requests []*persistent.ReadReq
+	for i := 0; i < 20; i++ {
+		req := &persistent.ReadReq{Content: &persistent.ReadReq_Ack_{Ack: &persistent.ReadReq_Ack{Id: []byte(strconv.Itoa(i))}}}
+		requests = append(requests, req)
+		require.NoError(t, sub.send(context.Background(), req))
+	}
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, sub.send(cancelled, &persistent.ReadReq{}), context.Canceled)
+
+	inner.lock.Lock()
+	assert.Equal(t, requests, inner.sent)
+	inner.lock.Unlock()
+
+	require.NoError(t, sub.Close())
+	esdbErr, ok := FromError(sub.send(context.Background(), &persistent.ReadReq{}))
+	require.False(t, ok)
+	assert.Equal(t, ErrorConnectionClosed, esdbErr.Code())
+}
@@ -1,8 +1,10 @@
 package esdb
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -107,6 +109,70 @@ func (client *Client) httpReplayParkedMessages(streamName string, groupName stri
 	return err
 }
 
+// httpUpdatePersistentSubscription updates a stream group through the HTTP API, which unlike the gRPC one accepts
+// any consumer strategy name.
+func (client *Client) httpUpdatePersistentSubscription(
+	streamName string,
+	groupName string,
+	from StreamPosition,
+	settings SubscriptionSettings,
+	auth *Credentials,
+) error {
+	body, err := json.Marshal(persistentSubscriptionConfigOf(from, settings))
+	if err != nil {
+		return &Error{code: ErrorParsing, err: err}
+	}
+
+	urlStr := fmt.Sprintf("/subscriptions/%s/%s", url.PathEscape(streamName), url.PathEscape(groupName))
+	_, err = client.httpExecute("POST", urlStr, auth, &httpParams{body: body})
+
+	return err
+}
+
+// persistentSubscriptionConfigData is the body the HTTP API expects when updating a group, which names the strategy
+// differently than the config returned by the info endpoint.
+type persistentSubscriptionConfigData struct {
+	ResolveLinkTos        bool   `json:"resolveLinktos"`
+	StartFrom             int64  `json:"startFrom"`
+	MessageTimeout        int64  `json:"messageTimeoutMilliseconds"`
+	ExtraStatistics       bool   `json:"extraStatistics"`
+	MaxRetryCount         int64  `json:"maxRetryCount"`
+	LiveBufferSize        int64  `json:"liveBufferSize"`
+	BufferSize            int64  `json:"bufferSize"`
+	ReadBatchSize         int64  `json:"readBatchSize"`
+	CheckpointAfter       int64  `json:"checkPointAfterMilliseconds"`
+	CheckpointLowerBound  int64  `json:"minCheckPointCount"`
+	CheckpointUpperBound  int64  `json:"maxCheckPointCount"`
+	MaxSubscriberCount    int64  `json:"maxSubscriberCount"`
+	NamedConsumerStrategy string `json:"namedConsumerStrategy"`
+}
+
+func persistentSubscriptionConfigOf(from StreamPosition, settings SubscriptionSettings) persistentSubscriptionConfigData {
+	var startFrom int64
+	switch value := from.(type) {
+	case End:
+		startFrom = -1
+	case StreamRevision:
+		startFrom = int64(value.Value)
+	}
+
+	return persistentSubscriptionConfigData{
+		ResolveLinkTos:        settings.ResolveLinkTos,
+		StartFrom:             startFrom,
+		MessageTimeout:        int64(settings.MessageTimeout),
+		ExtraStatistics:       settings.ExtraStatistics,
+		MaxRetryCount:         int64(settings.MaxRetryCount),
+		LiveBufferSize:        int64(settings.LiveBufferSize),
+		BufferSize:            int64(settings.HistoryBufferSize),
+		ReadBatchSize:         int64(settings.ReadBatchSize),
+		CheckpointAfter:       int64(settings.CheckpointAfter),
+		CheckpointLowerBound:  int64(settings.CheckpointLowerBound),
+		CheckpointUpperBound:  int64(settings.CheckpointUpperBound),
+		MaxSubscriberCount:    int64(settings.MaxSubscriberCount),
+		NamedConsumerStrategy: string(settings.ConsumerStrategyName),
+	}
+}
+
 func (client *Client) httpRestartSubsystem(options RestartPersistentSubscriptionSubsystemOptions) error {
 	params := &httpParams{
 		headers: []keyvalue{newKV("content-length", "0")},
@@ -149,6 +215,7 @@ func newKV(key string, value string) keyvalue {
 type httpParams struct {
 	queries []keyvalue
 	headers []keyvalue
+	body    []byte
 }
 
 func (client *Client) httpExecute(method string, path string, auth *Credentials, params *httpParams) ([]byte, error) {
@@ -157,7 +224,12 @@ func (client *Client) httpExecute(method string, path string, auth *Credentials,
 		return nil, fmt.Errorf("can't get a connection handle: %w", err)
 	}
 
-	req, err := http.NewRequest(method, fmt.Sprintf("%s%s", baseUrl, path), nil)
+	var reqBody io.Reader
+	if params != nil && params.body != nil {
+		reqBody = bytes.NewReader(params.body)
+	}
+
+	req, err := http.NewRequest(method, fmt.Sprintf("%s%s", baseUrl, path), reqBody)
 	if err != nil {
 		return nil, err
 	}
@@ -240,7 +312,7 @@ func parsePosition(input string) (*Position, error) {
 		}
 	}
 
-	commit, err := strconv.Atoi(input[2:prepareIdx])
+	commit, err := parsePositionComponent(input[2:prepareIdx])
 	if err != nil {
 		return nil, &Error{
 			code: ErrorParsing,
@@ -248,7 +320,7 @@ func parsePosition(input string) (*Position, error) {
 		}
 	}
 
-	prepare, err := strconv.Atoi(input[prepareIdx+3:])
+	prepare, err := parsePositionComponent(input[prepareIdx+3:])
 	if err != nil {
 		return nil, &Error{
 			code: ErrorParsing,
@@ -256,7 +328,17 @@ func parsePosition(input string) (*Position, error) {
 		}
 	}
 
-	return &Position{Commit: uint64(commit), Prepare: uint64(prepare)}, nil
+	return &Position{Commit: commit, Prepare: prepare}, nil
+}
+
+// parsePositionComponent accepts the full uint64 range, as well as -1 which the server uses to represent the end of
+// the transaction log.
+func parsePositionComponent(input string) (uint64, error) {
+	if input == "-1" {
+		return ^uint64(0), nil
+	}
+
+	return strconv.ParseUint(input, 10, 64)
 }
 
 func parseRevisionOrPosition(input string) (interface{}, error) {
@@ -300,7 +382,6 @@ func parseStreamPosition(input string) (interface{}, error) {
 
 func fromHttpJsonInfo(src PersistentSubscriptionInfoHttpJson) (*PersistentSubscriptionInfo, error) {
 	var settings *SubscriptionSettings
-	var stats *PersistentSubscriptionStats
 	info := PersistentSubscriptionInfo{}
 
 	if src.Config != nil {
@@ -310,6 +391,7 @@ func fromHttpJsonInfo(src PersistentSubscriptionInfoHttpJson) (*PersistentSubscr
 		settings.MessageTimeout = int32(src.Config.MessageTimeout)
 		settings.MaxRetryCount = int32(src.Config.MaxRetryCount)
 		settings.LiveBufferSize = int32(src.Config.LiveBufferSize)
+		settings.HistoryBufferSize = int32(src.Config.BufferSize)
 		settings.ReadBatchSize = int32(src.Config.ReadBatchSize)
 		settings.CheckpointAfter = int32(src.Config.CheckpointAfter)
 		settings.CheckpointLowerBound = int32(src.Config.CheckpointLowerBound)
@@ -329,54 +411,88 @@ func fromHttpJsonInfo(src PersistentSubscriptionInfoHttpJson) (*PersistentSubscr
 		}
 
 		info.Settings = settings
+	}
 
-		if src.Config.ExtraStatistics {
-			stats = &PersistentSubscriptionStats{}
-			stats.AveragePerSecond = int64(src.AverageItemsPerSecond)
-			stats.TotalItems = src.TotalItemsProcessed
-			stats.CountSinceLastMeasurement = src.CountSinceLastMeasurement
-			stats.ReadBufferCount = src.ReadBufferCount
-			stats.LiveBufferCount = src.LiveBufferCount
-			stats.RetryBufferCount = src.RetryBufferCount
-			stats.TotalInFlightMessages = src.TotalInFlightMessages
-			stats.OutstandingMessagesCount = src.OutstandingMessagesCount
-			stats.ParkedMessagesCount = src.ParkedMessageCount
-
-			if src.EventStreamId == "$all" {
-				if src.LastCheckpointedEventPosition != "" {
-					pos, err := parsePosition(src.LastCheckpointedEventPosition)
-
-					if err != nil {
-						return nil, err
-					}
-
-					stats.LastCheckpointedPosition = pos
-				}
+	stats, err := statsFromHttpJsonInfo(src)
+	if err != nil {
+		return nil, err
+	}
 
-				if src.LastKnownEventPosition != "" {
-					pos, err := parsePosition(src.LastKnownEventPosition)
+	info.Stats = stats
 
-					if err != nil {
-						return nil, err
-					}
+	info.EventSource = src.EventStreamId
+	info.GroupName = src.GroupName
+	info.Status = src.Status
+	info.Connections = src.Connections
 
-					stats.LastKnownPosition = pos
-				}
-			} else {
-				stats.LastCheckpointedEventRevision = new(uint64)
-				stats.LastKnownEventRevision = new(uint64)
-				*stats.LastCheckpointedEventRevision = uint64(src.LastProcessedEventNumber)
-				*stats.LastKnownEventRevision = uint64(src.LastKnownEventNumber)
+	return &info, nil
+}
+
+// statsFromHttpJsonInfo reads the statistics the server always reports, whether the subscription collects extra
+// statistics or not.
+func statsFromHttpJsonInfo(src PersistentSubscriptionInfoHttpJson) (*PersistentSubscriptionStats, error) {
+	stats := PersistentSubscriptionStats{
+		AveragePerSecond:          int64(src.AverageItemsPerSecond),
+		TotalItems:                src.TotalItemsProcessed,
+		CountSinceLastMeasurement: src.CountSinceLastMeasurement,
+		ReadBufferCount:           src.ReadBufferCount,
+		LiveBufferCount:           src.LiveBufferCount,
+		RetryBufferCount:          src.RetryBufferCount,
+		TotalInFlightMessages:     src.TotalInFlightMessages,
+		OutstandingMessagesCount:  src.OutstandingMessagesCount,
+		ParkedMessagesCount:       src.ParkedMessageCount,
+	}
+
+	if src.EventStreamId == "$all" {
+		if src.LastCheckpointedEventPosition != "" {
+			pos, err := parsePosition(src.LastCheckpointedEventPosition)
+			if err != nil {
+				return nil, err
 			}
 
-			info.Stats = stats
+			stats.LastCheckpointedPosition = pos
 		}
+
+		if src.LastKnownEventPosition != "" {
+			pos, err := parsePosition(src.LastKnownEventPosition)
+			if err != nil {
+				return nil, err
+			}
+
+			stats.LastKnownPosition = pos
+		}
+
+		return &stats, nil
 	}
 
-	info.EventSource = src.EventStreamId
-	info.GroupName = src.GroupName
-	info.Status = src.Status
-	info.Connections = src.Connections
+	var err error
+	if stats.LastCheckpointedEventRevision, err = httpEventRevision(src.LastCheckpointedEventPosition, src.LastProcessedEventNumber); err != nil {
+		return nil, err
+	}
 
-	return &info, nil
+	if stats.LastKnownEventRevision, err = httpEventRevision(src.LastKnownEventPosition, src.LastKnownEventNumber); err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
+
+// httpEventRevision returns the revision reported as a position string by recent servers, or as a number by older
+// ones, which use -1 when there is none.
+func httpEventRevision(position string, number int64) (*uint64, error) {
+	if position != "" {
+		revision, err := parseEventRevision(position)
+		if err != nil {
+			return nil, err
+		}
+
+		return &revision, nil
+	}
+
+	if number < 0 {
+		return nil, nil
+	}
+
+	revision := uint64(number)
+	return &revision, nil
 }
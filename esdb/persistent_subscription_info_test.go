@@ -0,0 +1,78 @@
+package esdb
+
+import (
+	"testing"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/protos/persistent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscriptionInfoFromWire(t *testing.T) {
+	info, err := subscriptionInfoFromWire(&persistent.SubscriptionInfo{
+		EventSource:                   "orders",
+		GroupName:                     "billing",
+		Status:                        PersistentSubscriptionStatus_Live,
+		StartFrom:                     "0",
+		LastCheckpointedEventPosition: "41",
+		LastKnownEventPosition:        "42",
+		ParkedMessageCount:            3,
+		ReadBufferCount:               5,
+		OutstandingMessagesCount:      2,
+		NamedConsumerStrategy:         string(ConsumerStrategy_PinnedByCorrelation),
+		Connections: []*persistent.SubscriptionInfo_ConnectionInfo{{
+			Username:              "admin",
+			AverageItemsPerSecond: 12,
+			InFlightMessages:      4,
+			ObservedMeasurements:  []*persistent.SubscriptionInfo_Measurement{{Key: "Average", Value: 7}},
+		}},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, uint64(41), *info.Stats.LastCheckpointedEventRevision)
+	assert.Equal(t, uint64(42), *info.Stats.LastKnownEventRevision)
+	assert.Equal(t, int64(3), info.Stats.ParkedMessagesCount)
+	assert.Equal(t, int64(5), info.Stats.ReadBufferCount)
+	assert.Equal(t, int64(2), info.Stats.OutstandingMessagesCount)
+	assert.Equal(t, ConsumerStrategy_PinnedByCorrelation, info.Settings.ConsumerStrategyName)
+
+	require.Len(t, info.Connections, 1)
+	assert.Equal(t, "admin", info.Connections[0].Username)
+	assert.Equal(t, float64(12), info.Connections[0].AverageItemsPerSecond)
+	assert.Equal(t, int64(4), info.Connections[0].InFlightMessages)
+
+	value, ok := info.Connections[0].ExtraStatistic("Average")
+	assert.True(t, ok)
+	assert.Equal(t, int64(7), value)
+
+	_, ok = info.Connections[0].ExtraStatistic("Mean")
+	assert.False(t, ok)
+}
+
+func TestFromHttpJsonInfo(t *testing.T) {
+	info, err := fromHttpJsonInfo(PersistentSubscriptionInfoHttpJson{
+		EventStreamId:            "orders",
+		GroupName:                "billing",
+		LastProcessedEventNumber: 9,
+		LastKnownEventNumber:     -1,
+		ParkedMessageCount:       1,
+		Config:                   &PersistentSubscriptionConfig{BufferSize: 500},
+		Connections:              []PersistentSubscriptionConnectionInfo{{Username: "admin", InFlightMessages: 2}},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(500), info.Settings.HistoryBufferSize)
+	assert.Equal(t, uint64(9), *info.Stats.LastCheckpointedEventRevision)
+	assert.Nil(t, info.Stats.LastKnownEventRevision)
+	assert.Equal(t, int64(1), info.Stats.ParkedMessagesCount)
+	assert.Equal(t, int64(2), info.Connections[0].InFlightMessages)
+
+	info, err = fromHttpJsonInfo(PersistentSubscriptionInfoHttpJson{
+		EventStreamId:                 "$all",
+		LastCheckpointedEventPosition: "C:10/P:8",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, Position{Commit: 10, Prepare: 8}, *info.Stats.LastCheckpointedPosition)
+	assert.Nil(t, info.Stats.LastKnownPosition)
+}
@@ -22,6 +22,7 @@ func PersistentSubReadTests(t *testing.T, emptyDBClient *esdb.Client) {
 		t.Run("ToExistingStream_StartFromHigherRevisionThenEventsInStream_EventsInItAppendEventsAfterwards", persistentSubscription_ToExistingStream_StartFromHigherRevisionThenEventsInStream_EventsInItAppendEventsAfterwards(emptyDBClient))
 		t.Run("ReadExistingStream_NackToReceiveNewEvents", persistentSubscription_ReadExistingStream_NackToReceiveNewEvents(emptyDBClient))
 		t.Run("persistentSubscriptionToAll_Read", persistentSubscriptionToAll_Read(emptyDBClient))
+		t.Run("ReadExistingStream_AckContext", persistentSubscription_ReadExistingStream_AckContext(emptyDBClient))
 	})
 }
 
@@ -71,6 +72,49 @@ func persistentSubscription_ReadExistingStream_AckToReceiveNewEvents(clientInsta
 	}
 }
 
+func persistentSubscription_ReadExistingStream_AckContext(clientInstance *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		streamID := NAME_GENERATOR.Generate()
+		pushEventsToStream(t, clientInstance, streamID, testCreateEvents(2))
+
+		groupName := "Group 1"
+		err := clientInstance.CreatePersistentSubscription(
+			context.Background(),
+			streamID,
+			groupName,
+			esdb.PersistentStreamSubscriptionOptions{
+				StartFrom: esdb.Start{},
+			},
+		)
+		require.NoError(t, err)
+
+		readConnectionClient, err := clientInstance.SubscribeToPersistentSubscription(
+			context.Background(), streamID, groupName, esdb.SubscribeToPersistentSubscriptionOptions{
+				BufferSize: 1,
+			})
+		require.NoError(t, err)
+		defer readConnectionClient.Close()
+
+		firstReadEvent := readConnectionClient.Recv().EventAppeared.Event
+		require.NotNil(t, firstReadEvent)
+
+		cancelled, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err = readConnectionClient.AckContext(cancelled, firstReadEvent)
+		require.ErrorIs(t, err, context.Canceled)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		err = readConnectionClient.AckContext(ctx, firstReadEvent)
+		require.NoError(t, err)
+
+		secondReadEvent := readConnectionClient.Recv().EventAppeared
+		require.NotNil(t, secondReadEvent)
+	}
+}
+
 func persistentSubscription_ToExistingStream_StartFromBeginning_AndEventsInIt(clientInstance *esdb.Client) TestCall {
 	return func(t *testing.T) {
 		// create 10 events
@@ -0,0 +1,113 @@
+package esdb
+
+import (
+	"context"
+	"sync/atomic"
+
+	persistentProto "github.com/EventStore/EventStore-Client-Go/v2/protos/persistent"
+)
+
+// PersistentSubscriptionReconnected tells a persistent subscription connected again to its group. The server
+// redelivers the events which were in flight on the lost connection, acknowledging them is only honored once they're
+// received again. Events deferred by NackRetryAfter are dropped for the same reason.
+type PersistentSubscriptionReconnected struct {
+	// Error which made the subscription lose its connection.
+	Cause error
+	// Number of attempts it took to connect again.
+	Attempts int
+	// Subscription ID of the new connection.
+	SubscriptionID string
+}
+
+// connectToPersistentSubscription subscribes to the group, setting the subscription up to reconnect when asked by the
+// options.
+func (client *Client) connectToPersistentSubscription(
+	ctx context.Context,
+	handle *connectionHandle,
+	streamName string,
+	groupName string,
+	options SubscribeToPersistentSubscriptionOptions,
+) (*PersistentSubscription, error) {
+	connect := func(handle *connectionHandle) (*PersistentSubscription, error) {
+		persistentSubscriptionClient := newPersistentClient(client.grpcClient, persistentProto.NewPersistentSubscriptionsClient(handle.Connection()))
+
+		return persistentSubscriptionClient.ConnectToPersistentSubscription(
+			ctx,
+			client.Config,
+			&options,
+			handle,
+			int32(options.BufferSize),
+			streamName,
+			groupName,
+		)
+	}
+
+	sub, err := connect(handle)
+	if err != nil || options.Reconnect == nil {
+		return sub, err
+	}
+
+	sub.reconnect = options.Reconnect
+	sub.reconnectCtx, sub.stopReconnect = context.WithCancel(ctx)
+	sub.reopen = func(previous *connectionHandle) (*PersistentSubscription, error) {
+		// Discovers a node again, which goes to the new leader when the previous one stepped down.
+		if previous != nil && atomic.LoadInt32(client.grpcClient.closeFlag) == 0 {
+			client.grpcClient.channel <- reconnect{correlation: previous.Id()}
+		}
+
+		handle, err := client.grpcClient.getConnectionHandle()
+		if err != nil {
+			return nil, err
+		}
+
+		return connect(handle)
+	}
+
+	return sub, nil
+}
+
+// reconnectAfter connects the subscription to its group again when err tells its node went away, replacing the
+// dropped call. It returns the error to drop the subscription with when it doesn't or can't reconnect.
+func (connection *PersistentSubscription) reconnectAfter(err error) (*PersistentSubscriptionReconnected, error) {
+	call := connection.call()
+	if connection.reopen == nil || atomic.LoadInt32(connection.closed) != 0 || !serverMaintenance(err, call.trailers) {
+		return nil, err
+	}
+
+	cause := err
+	previous := call.handle
+	for attempt := 1; attempt <= connection.reconnect.MaxAttempts; attempt++ {
+		if waitErr := connection.reconnect.wait(connection.reconnectCtx, attempt); waitErr != nil {
+			return nil, err
+		}
+
+		var opened *PersistentSubscription
+		if opened, err = connection.reopen(previous); err != nil {
+			connection.logger.error("failed to reconnect persistent subscription (attempt %d): %v", attempt, err)
+			continue
+		}
+
+		connection.sendLock.Lock()
+		connection.lock.Lock()
+		if atomic.LoadInt32(connection.closed) != 0 {
+			connection.lock.Unlock()
+			connection.sendLock.Unlock()
+			opened.Close()
+			return nil, cause
+		}
+
+		connection.cancel()
+		connection.client = opened.client
+		connection.cancel = opened.cancel
+		connection.subscriptionId = opened.subscriptionId
+		connection.trailers = opened.trailers
+		connection.handle = opened.handle
+		connection.lock.Unlock()
+		connection.sendLock.Unlock()
+		connection.dropDeferred()
+
+		return &PersistentSubscriptionReconnected{Cause: cause, Attempts: attempt, SubscriptionID: opened.subscriptionId}, nil
+	}
+
+	return nil, err
+}
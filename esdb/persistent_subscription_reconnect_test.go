@@ -0,0 +1,136 @@
+package esdb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/protos/persistent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type failingPersistentReadClient struct {
+	grpc.ClientStream
+	err error
+}
+
+func (c *failingPersistentReadClient) Recv() (*persistent.ReadResp, error) {
+	return nil, c.err
+}
+
+func (c *failingPersistentReadClient) Send(*persistent.ReadReq) error {
+	return c.err
+}
+
+func (c *failingPersistentReadClient) CloseSend() error {
+	return nil
+}
+
+func newReconnectingPersistentSubscription(err error, reopen func(*connectionHandle) (*PersistentSubscription, error)) *PersistentSubscription {
+	sub := NewPersistentSubscription(&failingPersistentReadClient{err: err}, "first", func() {}, &logger{})
+	sub.reconnect = &RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond}
+	sub.reconnect.setDefaults()
+	sub.reconnectCtx, sub.stopReconnect = context.WithCancel(context.Background())
+	sub.reopen = reopen
+	return sub
+}
+
+func TestPersistentSubscriptionReconnects(t *testing.T) {
+	inner := newScriptedPersistentReadClient(persistentEventResponse(0, 1))
+	attempts := 0
+
+	sub := newReconnectingPersistentSubscription(status.Error(codes.Unavailable, "node shutting down"), func(*connectionHandle) (*PersistentSubscription, error) {
+		attempts++
+		if attempts == 1 {
+			return nil, status.Error(codes.Unavailable, "no leader yet")
+		}
+
+		return NewPersistentSubscription(inner, "second", func() {}, &logger{}), nil
+	})
+	defer sub.Close()
+
+	event := sub.Recv()
+	require.NotNil(t, event.Reconnected)
+	assert.Equal(t, 2, event.Reconnected.Attempts)
+	assert.Equal(t, "second", event.Reconnected.SubscriptionID)
+	assert.Equal(t, codes.Unavailable, status.Code(event.Reconnected.Cause))
+
+	event = sub.Recv()
+	require.NotNil(t, event.EventAppeared)
+	assert.Equal(t, "second", event.EventAppeared.SubscriptionID)
+
+	require.NoError(t, sub.Ack(event.EventAppeared.Event))
+	assert.Equal(t, []byte("second"), inner.sent[0].GetAck().Id)
+}
+
+func TestPersistentSubscriptionDropsWhenReconnectionIsExhausted(t *testing.T) {
+	sub := newReconnectingPersistentSubscription(status.Error(codes.Unavailable, "node shutting down"), func(*connectionHandle) (*PersistentSubscription, error) {
+		return nil, status.Error(codes.Unavailable, "no leader yet")
+	})
+
+	event := sub.Recv()
+	require.NotNil(t, event.SubscriptionDropped)
+	assert.Equal(t, SubscriptionDropServerUnavailable, event.SubscriptionDropped.Reason)
+}
+
+func TestPersistentSubscriptionDoesNotReconnectOnOtherErrors(t *testing.T) {
+	sub := newReconnectingPersistentSubscription(status.Error(codes.PermissionDenied, "access denied"), func(*connectionHandle) (*PersistentSubscription, error) {
+		t.Fatal("unexpected reconnection")
+		return nil, nil
+	})
+
+	event := sub.Recv()
+	require.NotNil(t, event.SubscriptionDropped)
+	assert.Equal(t, SubscriptionDropAccessDenied, event.SubscriptionDropped.Reason)
+}
+
+func TestPersistentSubscriptionClosedWhileReconnecting(t *testing.T) {
+	reopening := make(chan struct{})
+	closed := make(chan struct{})
+	openedCancelled := make(chan struct{})
+
+	sub := newReconnectingPersistentSubscription(status.Error(codes.Unavailable, "node shutting down"), func(*connectionHandle) (*PersistentSubscription, error) {
+		close(reopening)
+		<-closed
+		return NewPersistentSubscription(newScriptedPersistentReadClient(), "second", func() { close(openedCancelled) }, &logger{}), nil
+	})
+
+	go func() {
+		<-reopening
+		_ = sub.Ack(&ResolvedEvent{Event: &RecordedEvent{}})
+		sub.Close()
+		close(closed)
+	}()
+
+	event := sub.Recv()
+	require.NotNil(t, event.SubscriptionDropped)
+
+	select {
+	case <-openedCancelled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the connection opened after closing wasn't closed")
+	}
+}
+
+func TestPersistentSubscriptionDropsDeferredEventsOnReconnect(t *testing.T) {
+	inner := newScriptedPersistentReadClient(persistentEventResponse(1, 0))
+	sub := newReconnectingPersistentSubscription(status.Error(codes.Unavailable, "node shutting down"), func(*connectionHandle) (*PersistentSubscription, error) {
+		return NewPersistentSubscription(inner, "second", func() {}, &logger{}), nil
+	})
+	defer sub.Close()
+
+	deferred := &EventAppeared{Event: &ResolvedEvent{Event: &RecordedEvent{EventNumber: 0}}, SubscriptionID: "first"}
+	require.NoError(t, sub.NackRetryAfter(time.Hour, deferred))
+
+	require.NotNil(t, sub.Recv().Reconnected)
+	assert.Empty(t, sub.deferred)
+
+	event := sub.Recv().EventAppeared
+	require.NotNil(t, event)
+	assert.Equal(t, uint64(1), event.Event.OriginalEvent().EventNumber)
+	assert.Equal(t, "second", event.SubscriptionID)
+}
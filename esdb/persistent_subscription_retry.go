@@ -0,0 +1,139 @@
+package esdb
+
+import (
+	"container/heap"
+	"sync/atomic"
+	"time"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/protos/persistent"
+)
+
+// deferredRetry is an event redelivered by Recv once due.
+type deferredRetry struct {
+	due   time.Time
+	event EventAppeared
+}
+
+// deferredRetries is a heap of the events deferred by NackRetryAfter, the first one due first.
+type deferredRetries []deferredRetry
+
+func (r deferredRetries) Len() int           { return len(r) }
+func (r deferredRetries) Less(i, j int) bool { return r[i].due.Before(r[j].due) }
+func (r deferredRetries) Swap(i, j int)      { r[i], r[j] = r[j], r[i] }
+
+func (r *deferredRetries) Push(x interface{}) {
+	*r = append(*r, x.(deferredRetry))
+}
+
+func (r *deferredRetries) Pop() interface{} {
+	old := *r
+	last := old[len(old)-1]
+	*r = old[:len(old)-1]
+	return last
+}
+
+type persistentRecvResult struct {
+	resp *persistent.ReadResp
+	err  error
+}
+
+// NackRetryAfter holds the events locally and makes Recv deliver them again once the delay elapsed, with their
+// Deferrals incremented, without telling the server. Unlike Nack_Retry, which the server applies immediately, it
+// allows backing off between retries of a single event without blocking the others. The events stay in flight for the
+// server, which retries them itself past the MessageTimeout of the group, so the delay should stay below it. Deferred
+// events are lost when the subscription is closed or reconnects, and retried by the server.
+func (connection *PersistentSubscription) NackRetryAfter(delay time.Duration, events ...*EventAppeared) error {
+	if delay < 0 {
+		return invalidArgumentError("retry delay can't be negative, got %v", delay)
+	}
+
+	if atomic.LoadInt32(connection.closed) != 0 {
+		return persistentSubscriptionClosedError()
+	}
+
+	connection.deferLock.Lock()
+	defer connection.deferLock.Unlock()
+
+	due := time.Now().Add(delay)
+	for _, event := range events {
+		retry := deferredRetry{due: due, event: *event}
+		retry.event.Deferrals++
+		heap.Push(&connection.deferred, retry)
+	}
+
+	select {
+	case connection.deferWake <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// receive returns the next message of the call, or the first deferred event becoming due before it, leaving the
+// receive of the call pending for the next call.
+func (connection *PersistentSubscription) receive() (*persistent.ReadResp, *EventAppeared, error) {
+	if connection.pending == nil {
+		pending := make(chan persistentRecvResult, 1)
+		client := connection.call().client
+
+		go func() {
+			resp, err := client.Recv()
+			pending <- persistentRecvResult{resp: resp, err: err}
+		}()
+
+		connection.pending = pending
+	}
+
+	for {
+		retry, wait, wake := connection.nextDeferred(time.Now())
+		if retry != nil {
+			return nil, retry, nil
+		}
+
+		var timer *time.Timer
+		var timeout <-chan time.Time
+		if wait > 0 {
+			timer = time.NewTimer(wait)
+			timeout = timer.C
+		}
+
+		select {
+		case result := <-connection.pending:
+			connection.pending = nil
+			if timer != nil {
+				timer.Stop()
+			}
+
+			return result.resp, nil, result.err
+		case <-timeout:
+		case <-wake:
+			if timer != nil {
+				timer.Stop()
+			}
+		}
+	}
+}
+
+// dropDeferred forgets the deferred events, which belong to a connection the server already redelivers them from.
+func (connection *PersistentSubscription) dropDeferred() {
+	connection.deferLock.Lock()
+	defer connection.deferLock.Unlock()
+	connection.deferred = nil
+}
+
+// nextDeferred pops the first deferred event when due, or tells how long to wait for it, 0 meaning there is none.
+func (connection *PersistentSubscription) nextDeferred(now time.Time) (*EventAppeared, time.Duration, <-chan struct{}) {
+	connection.deferLock.Lock()
+	defer connection.deferLock.Unlock()
+
+	if len(connection.deferred) == 0 {
+		return nil, 0, connection.deferWake
+	}
+
+	if wait := connection.deferred[0].due.Sub(now); wait > 0 {
+		return nil, wait, connection.deferWake
+	}
+
+	retry := heap.Pop(&connection.deferred).(deferredRetry)
+	return &retry.event, 0, connection.deferWake
+}
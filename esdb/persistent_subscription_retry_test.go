@@ -0,0 +1,68 @@
+package esdb
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPersistentSubscriptionNackRetryAfter(t *testing.T) {
+	inner := newScriptedPersistentReadClient(persistentEventResponse(0, 2))
+	sub := NewPersistentSubscription(inner, "sub", func() {}, &logger{})
+
+	first := sub.Recv().EventAppeared
+	require.NotNil(t, first)
+	assert.Equal(t, 0, first.Deferrals)
+
+	start := time.Now()
+	require.NoError(t, sub.NackRetryAfter(20*time.Millisecond, first))
+	assertInvalidArgument(t, sub.NackRetryAfter(-time.Second, first))
+
+	retried := sub.Recv().EventAppeared
+	require.NotNil(t, retried)
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+	assert.Equal(t, 1, retried.Deferrals)
+	assert.Equal(t, 2, retried.RetryCount)
+	assert.Equal(t, first.Event, retried.Event)
+	assert.Empty(t, inner.sent)
+
+	require.NoError(t, sub.Ack(retried.Event))
+	assert.NotNil(t, sub.Recv().SubscriptionDropped)
+}
+
+func TestPersistentSubscriptionConsumerRetriesAfterDelay(t *testing.T) {
+	client := &Client{grpcClient: &grpcClient{logger: &logger{}}}
+	inner := newScriptedPersistentReadClient(persistentEventResponse(0, 0))
+
+	var deferrals []int
+	consumer, err := client.NewPersistentSubscriptionConsumer("orders", "group", func(_ context.Context, event *ResolvedEvent) error {
+		if len(deferrals) < 2 {
+			deferrals = append(deferrals, len(deferrals))
+			return errors.New("boom")
+		}
+
+		return nil
+	}, PersistentSubscriptionConsumerOptions{RetryDelay: time.Millisecond})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var subscriptions int
+	consumer.subscribe = func(context.Context) (*PersistentSubscription, error) {
+		subscriptions++
+		if subscriptions > 1 {
+			cancel()
+			return nil, errors.New("cancelled")
+		}
+
+		return NewPersistentSubscription(inner, "sub", func() {}, client.grpcClient.logger), nil
+	}
+
+	require.NoError(t, consumer.Run(ctx))
+	assert.Equal(t, []int{0, 1}, deferrals)
+	require.Len(t, inner.sent, 1)
+	assert.NotNil(t, inner.sent[0].GetAck())
+}
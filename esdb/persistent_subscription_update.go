@@ -0,0 +1,133 @@
+package esdb
+
+import (
+	"context"
+	"fmt"
+)
+
+// UpdatePersistentSubscriptionSettings reads the current settings of a persistent subscription group and only
+// changes the ones set in the patch, keeping the start position of the group unless ChangeStartFrom is set. Groups
+// subscribing to $all use "$all" as stream name. The read and the update aren't atomic, so concurrent updates of the
+// same group may overwrite each other.
+//
+// The gRPC API can't carry the PinnedByCorrelation strategy, so stream groups using it are updated through the HTTP
+// API instead. $all groups using it can't be updated.
+func (client *Client) UpdatePersistentSubscriptionSettings(
+	ctx context.Context,
+	streamName string,
+	groupName string,
+	opts UpdatePersistentSubscriptionSettingsOptions,
+) error {
+	if err := opts.validate(); err != nil {
+		return err
+	}
+
+	getOpts := GetPersistentSubscriptionOptions{Authenticated: opts.Authenticated, Deadline: opts.Deadline}
+
+	var info *PersistentSubscriptionInfo
+	var err error
+	if streamName == "$all" {
+		info, err = client.GetPersistentSubscriptionInfoToAll(ctx, groupName, getOpts)
+	} else {
+		info, err = client.GetPersistentSubscriptionInfo(ctx, streamName, groupName, getOpts)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if info.Settings == nil {
+		return &Error{code: ErrorParsing, err: fmt.Errorf("the server didn't return the settings of persistent subscription '%s' on '%s'", groupName, streamName)}
+	}
+
+	settings := opts.Patch.applyTo(*info.Settings)
+	startFrom := settings.StartFrom
+	if opts.ChangeStartFrom {
+		startFrom = opts.StartFrom
+	}
+
+	if position, ok := startFrom.(*Position); ok {
+		startFrom = *position
+	}
+
+	// Only a strategy the patch changes is checked, an unchanged one is kept as is.
+	pinnedByCorrelation := settings.ConsumerStrategyName == ConsumerStrategy_PinnedByCorrelation
+	if opts.Patch.ConsumerStrategyName != nil || (pinnedByCorrelation && streamName == "$all") {
+		handle, err := client.grpcClient.getConnectionHandle()
+		if err != nil {
+			return err
+		}
+
+		if err := settings.ConsumerStrategyName.checkSupported(handle, streamName == "$all"); err != nil {
+			return err
+		}
+	}
+
+	if streamName == "$all" {
+		from, ok := startFrom.(AllPosition)
+		if !ok {
+			return invalidArgumentError("the start position of a $all group must be an AllPosition, got %T", startFrom)
+		}
+
+		return client.UpdatePersistentSubscriptionToAll(ctx, groupName, PersistentAllSubscriptionOptions{
+			Settings:      &settings,
+			StartFrom:     from,
+			Authenticated: opts.Authenticated,
+			Deadline:      opts.Deadline,
+		})
+	}
+
+	from, ok := startFrom.(StreamPosition)
+	if !ok {
+		return invalidArgumentError("the start position of a stream group must be a StreamPosition, got %T", startFrom)
+	}
+
+	if pinnedByCorrelation {
+		return client.httpUpdatePersistentSubscription(streamName, groupName, from, settings, opts.Authenticated)
+	}
+
+	return client.UpdatePersistentSubscription(ctx, streamName, groupName, PersistentStreamSubscriptionOptions{
+		Settings:      &settings,
+		StartFrom:     from,
+		Authenticated: opts.Authenticated,
+		Deadline:      opts.Deadline,
+	})
+}
+
+// applyTo returns the settings with the changes of the patch applied.
+func (p SubscriptionSettingsPatch) applyTo(settings SubscriptionSettings) SubscriptionSettings {
+	if p.ResolveLinkTos != nil {
+		settings.ResolveLinkTos = *p.ResolveLinkTos
+	}
+
+	if p.ExtraStatistics != nil {
+		settings.ExtraStatistics = *p.ExtraStatistics
+	}
+
+	int32Settings := []struct {
+		change  *int32
+		setting *int32
+	}{
+		{p.MaxRetryCount, &settings.MaxRetryCount},
+		{p.CheckpointLowerBound, &settings.CheckpointLowerBound},
+		{p.CheckpointUpperBound, &settings.CheckpointUpperBound},
+		{p.MaxSubscriberCount, &settings.MaxSubscriberCount},
+		{p.LiveBufferSize, &settings.LiveBufferSize},
+		{p.ReadBatchSize, &settings.ReadBatchSize},
+		{p.HistoryBufferSize, &settings.HistoryBufferSize},
+		{p.MessageTimeout, &settings.MessageTimeout},
+		{p.CheckpointAfter, &settings.CheckpointAfter},
+	}
+
+	for _, s := range int32Settings {
+		if s.change != nil {
+			*s.setting = *s.change
+		}
+	}
+
+	if p.ConsumerStrategyName != nil {
+		settings.ConsumerStrategyName = *p.ConsumerStrategyName
+	}
+
+	return settings
+}
@@ -0,0 +1,53 @@
+package esdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscriptionSettingsPatch(t *testing.T) {
+	current := SubscriptionSettingsDefault()
+	current.StartFrom = Revision(42)
+
+	retries := int32(3)
+	strategy := ConsumerStrategy_Pinned
+	resolve := true
+
+	updated := SubscriptionSettingsPatch{
+		MaxRetryCount:        &retries,
+		ConsumerStrategyName: &strategy,
+		ResolveLinkTos:       &resolve,
+	}.applyTo(current)
+
+	expected := current
+	expected.MaxRetryCount = 3
+	expected.ConsumerStrategyName = ConsumerStrategy_Pinned
+	expected.ResolveLinkTos = true
+	assert.Equal(t, expected, updated)
+	assert.Equal(t, current, SubscriptionSettingsPatch{}.applyTo(current))
+}
+
+func TestUpdatePersistentSubscriptionSettingsOptionsValidation(t *testing.T) {
+	opts := UpdatePersistentSubscriptionSettingsOptions{StartFrom: Start{}}
+	assertInvalidArgument(t, opts.validate())
+
+	opts.ChangeStartFrom = true
+	assert.NoError(t, opts.validate())
+
+	opts = UpdatePersistentSubscriptionSettingsOptions{ChangeStartFrom: true}
+	assertInvalidArgument(t, opts.validate())
+}
+
+func TestPersistentSubscriptionConfigKeepsTheStrategyName(t *testing.T) {
+	settings := SubscriptionSettingsDefault()
+	settings.ConsumerStrategyName = ConsumerStrategy_PinnedByCorrelation
+
+	config := persistentSubscriptionConfigOf(Revision(42), settings)
+	assert.Equal(t, "PinnedByCorrelation", config.NamedConsumerStrategy)
+	assert.Equal(t, int64(42), config.StartFrom)
+	assert.Equal(t, int64(settings.HistoryBufferSize), config.BufferSize)
+
+	assert.Equal(t, int64(-1), persistentSubscriptionConfigOf(End{}, settings).StartFrom)
+	assert.Equal(t, int64(0), persistentSubscriptionConfigOf(Start{}, settings).StartFrom)
+}
@@ -1,5 +1,10 @@
 package esdb
 
+import (
+	"fmt"
+	"strconv"
+)
+
 type StreamRevision struct {
 	Value uint64
 }
@@ -10,6 +15,64 @@ func Revision(value uint64) StreamRevision {
 	}
 }
 
+// ParseStreamRevision parses a stream revision from the representation returned by StreamRevision.String.
+func ParseStreamRevision(input string) (StreamRevision, error) {
+	value, err := strconv.ParseUint(input, 10, 64)
+
+	if err != nil {
+		return StreamRevision{}, &Error{
+			code: ErrorParsing,
+			err:  fmt.Errorf("error when parsing a stream revision string representation: '%s'", input),
+		}
+	}
+
+	return Revision(value), nil
+}
+
+func (r StreamRevision) String() string {
+	return strconv.FormatUint(r.Value, 10)
+}
+
+// ParsePosition parses a position from the representation returned by Position.String, for example 'C:123/P:456'.
+func ParsePosition(input string) (Position, error) {
+	position, err := parsePosition(input)
+
+	if err != nil {
+		return Position{}, err
+	}
+
+	return *position, nil
+}
+
+func (p Position) String() string {
+	return fmt.Sprintf("C:%d/P:%d", p.Commit, p.Prepare)
+}
+
+// Compare returns -1 if the position comes before other in the transaction log, 1 if it comes after and 0 if both
+// positions are equal.
+func (p Position) Compare(other Position) int {
+	switch {
+	case p.Commit < other.Commit:
+		return -1
+	case p.Commit > other.Commit:
+		return 1
+	case p.Prepare < other.Prepare:
+		return -1
+	case p.Prepare > other.Prepare:
+		return 1
+	}
+
+	return 0
+}
+
+func (p Position) Before(other Position) bool {
+	return p.Compare(other) < 0
+}
+
+func (p Position) After(other Position) bool {
+	return p.Compare(other) > 0
+}
+
 type Start struct {
 }
 
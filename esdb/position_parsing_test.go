@@ -42,3 +42,32 @@ func TestPositionParsing(t *testing.T) {
 	assert.True(t, ok)
 	assert.Equal(t, uint64(42), value.Value)
 }
+
+func TestPositionStringRoundTrip(t *testing.T) {
+	for _, expected := range []Position{StartPosition, EndPosition, {Commit: 123, Prepare: 456}} {
+		pos, err := ParsePosition(expected.String())
+		assert.NoError(t, err)
+		assert.Equal(t, expected, pos)
+	}
+
+	_, err := ParsePosition("123/456")
+	assert.Error(t, err)
+
+	revision, err := ParseStreamRevision(Revision(42).String())
+	assert.NoError(t, err)
+	assert.Equal(t, Revision(42), revision)
+
+	_, err = ParseStreamRevision("-1")
+	assert.Error(t, err)
+}
+
+func TestPositionComparison(t *testing.T) {
+	pos := Position{Commit: 10, Prepare: 10}
+
+	assert.True(t, pos.Before(Position{Commit: 11, Prepare: 5}))
+	assert.True(t, pos.After(Position{Commit: 10, Prepare: 9}))
+	assert.False(t, pos.Before(pos))
+	assert.False(t, pos.After(pos))
+	assert.Equal(t, 0, pos.Compare(pos))
+	assert.True(t, StartPosition.Before(EndPosition))
+}
@@ -1,6 +1,7 @@
 package esdb
 
 import (
+	"encoding/binary"
 	"fmt"
 	"log"
 	"strconv"
@@ -97,6 +98,51 @@ func toProposedMessage(event EventData) *api.AppendReq_ProposedMessage {
 	}
 }
 
+// toProposedMessages converts events in bulk: the messages and their ids are allocated once for the whole append.
+// Ids use the same string format as toProposedMessage.
+func toProposedMessages(events []EventData) []*api.AppendReq_ProposedMessage {
+	messages := make([]api.AppendReq_ProposedMessage, len(events))
+	ids := make([]shared.UUID, len(events))
+	stringIDs := make([]shared.UUID_String_, len(events))
+	result := make([]*api.AppendReq_ProposedMessage, len(events))
+
+	for i, event := range events {
+		eventID := event.EventID
+		if eventID == uuid.Nil {
+			eventID = uuid.Must(uuid.NewV4())
+		}
+
+		stringIDs[i].String_ = eventID.String()
+		ids[i].Value = &stringIDs[i]
+
+		contentType := "application/octet-stream"
+		if event.ContentType == JsonContentType {
+			contentType = "application/json"
+		}
+
+		message := &messages[i]
+		message.Id = &ids[i]
+		message.Metadata = map[string]string{
+			systemMetadataKeysContentType: contentType,
+			systemMetadataKeysType:        event.EventType,
+		}
+		message.Data = event.Data
+		message.CustomMetadata = event.Metadata
+
+		if message.Data == nil {
+			message.Data = []byte{}
+		}
+
+		if message.CustomMetadata == nil {
+			message.CustomMetadata = []byte{}
+		}
+
+		result[i] = message
+	}
+
+	return result
+}
+
 // toReadDirectionFromDirection ...
 func toReadDirectionFromDirection(dir Direction) api.ReadReq_Options_ReadDirection {
 	var readDirection api.ReadReq_Options_ReadDirection
@@ -369,9 +415,7 @@ func toAllSubscriptionRequest(from AllPosition, resolveLinks bool, filterOptions
 
 // EventIDFromProto ...
 func EventIDFromProto(recordedEvent *api.ReadResp_ReadEvent_RecordedEvent) uuid.UUID {
-	id := recordedEvent.GetId()
-	idString := id.GetString_()
-	return uuid.FromStringOrNil(idString)
+	return fromProtoUUID(recordedEvent.GetId())
 }
 
 // createdFromProto ...
@@ -414,11 +458,16 @@ func recordedEventFromProto(result *api.ReadResp_ReadEvent) RecordedEvent {
 }
 func getRecordedEventFromProto(recordedEvent *api.ReadResp_ReadEvent_RecordedEvent) RecordedEvent {
 	streamIdentifier := recordedEvent.GetStreamIdentifier()
+	return recordedEventFromProtoWithStreamID(recordedEvent, string(streamIdentifier.StreamName))
+}
+
+// recordedEventFromProtoWithStreamID converts a recorded event whose stream name was already converted to a string.
+func recordedEventFromProtoWithStreamID(recordedEvent *api.ReadResp_ReadEvent_RecordedEvent, streamID string) RecordedEvent {
 	return RecordedEvent{
 		EventID:        EventIDFromProto(recordedEvent),
 		EventType:      recordedEvent.Metadata[systemMetadataKeysType],
 		ContentType:    getContentTypeFromProto(recordedEvent),
-		StreamID:       string(streamIdentifier.StreamName),
+		StreamID:       streamID,
 		EventNumber:    recordedEvent.GetStreamRevision(),
 		CreatedDate:    createdFromProto(recordedEvent),
 		Position:       positionFromProto(recordedEvent),
@@ -468,9 +517,20 @@ func getResolvedEventFromProto(result *api.ReadResp_ReadEvent) ResolvedEvent {
 }
 
 func eventIDFromPersistentProto(recordedEvent *persistent.ReadResp_ReadEvent_RecordedEvent) uuid.UUID {
-	id := recordedEvent.GetId()
-	idString := id.GetString_()
-	return uuid.FromStringOrNil(idString)
+	return fromProtoUUID(recordedEvent.GetId())
+}
+
+// fromProtoUUID supports both the string and the structured wire formats. The structured format holds the big-endian
+// most and least significant halves of the UUID.
+func fromProtoUUID(id *shared.UUID) uuid.UUID {
+	if structured := id.GetStructured(); structured != nil {
+		var result uuid.UUID
+		binary.BigEndian.PutUint64(result[:8], uint64(structured.MostSignificantBits))
+		binary.BigEndian.PutUint64(result[8:], uint64(structured.LeastSignificantBits))
+		return result
+	}
+
+	return uuid.FromStringOrNil(id.GetString_())
 }
 
 func toProtoUUID(id uuid.UUID) *shared.UUID {
@@ -703,7 +763,6 @@ func updatePersistentRequestConsumerStrategyProto(
 		return persistent.UpdateReq_DispatchToSingle
 	case ConsumerStrategy_Pinned:
 		return persistent.UpdateReq_Pinned
-	// FIXME: support Pinned by correlation case ConsumerStrategy_PinnedByCorrelation:
 	case ConsumerStrategy_RoundRobin:
 		return persistent.UpdateReq_RoundRobin
 	default:
@@ -890,6 +949,7 @@ func createPersistentSubscriptionSettingsProto(
 		NamedConsumerStrategy: consumerStrategyProto(settings.ConsumerStrategyName),
 		MessageTimeout:        messageTimeOutInMsProto(settings.MessageTimeout),
 		CheckpointAfter:       checkpointAfterMsProto(settings.CheckpointAfter),
+		ConsumerStrategy:      string(settings.ConsumerStrategyName),
 	}
 }
 
@@ -897,7 +957,8 @@ func consumerStrategyProto(strategy ConsumerStrategy) persistent.CreateReq_Consu
 	switch strategy {
 	case ConsumerStrategy_DispatchToSingle:
 		return persistent.CreateReq_DispatchToSingle
-	case ConsumerStrategy_Pinned:
+	// The deprecated enum doesn't know PinnedByCorrelation, which servers read from the string field.
+	case ConsumerStrategy_Pinned, ConsumerStrategy_PinnedByCorrelation:
 		return persistent.CreateReq_Pinned
 	case ConsumerStrategy_RoundRobin:
 		return persistent.CreateReq_RoundRobin
@@ -0,0 +1,199 @@
+package esdb
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// RangeReader reads a stream with several concurrent reads, each covering a range of revisions. It speeds up scans of
+// large streams, such as read model rebuilds, which a single read is too slow for.
+type RangeReader struct {
+	client   *Client
+	streamID string
+	options  RangeReaderOptions
+}
+
+type readRange struct {
+	index  int
+	events []ResolvedEvent
+	err    error
+}
+
+// NewRangeReader creates a RangeReader for the given stream. Nothing is read until Run is called.
+func NewRangeReader(client *Client, streamID string, opts RangeReaderOptions) (*RangeReader, error) {
+	opts.setDefaults()
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	return &RangeReader{client: client, streamID: streamID, options: opts}, nil
+}
+
+// Run reads the stream and calls fn for every event, from a single goroutine. It stops at the first read error or
+// error returned by fn, and returns it.
+func (reader *RangeReader) Run(ctx context.Context, fn func(*ResolvedEvent) error) error {
+	to, err := reader.end(ctx)
+	if err != nil {
+		return err
+	}
+
+	if to <= reader.options.From {
+		return nil
+	}
+
+	size := reader.options.RangeSize
+	count := int((to - reader.options.From + size - 1) / size)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// A slot is taken before reading a range and given back once its events are delivered, which bounds the number of
+	// ranges held in memory. Ranges are started in order, so the next range to deliver always holds a slot.
+	slots := make(chan struct{}, reader.options.Concurrency)
+	ranges := make(chan readRange, reader.options.Concurrency)
+	dispatched := make(chan struct{})
+	var workers sync.WaitGroup
+
+	go func() {
+		defer close(dispatched)
+
+		for i := 0; i < count; i++ {
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+
+			from := reader.options.From + uint64(i)*size
+			until := from + size
+			if until > to {
+				until = to
+			}
+
+			workers.Add(1)
+			go func(index int) {
+				defer workers.Done()
+				events, err := reader.readRange(ctx, from, until)
+				ranges <- readRange{index: index, events: events, err: err}
+			}(i)
+		}
+	}()
+
+	err = reader.deliver(ctx, count, slots, ranges, fn)
+	cancel()
+
+	// Reads still running get cancelled, drain their ranges until they all exit.
+	<-dispatched
+	go func() {
+		workers.Wait()
+		close(ranges)
+	}()
+
+	for range ranges {
+	}
+
+	return err
+}
+
+func (reader *RangeReader) deliver(
+	ctx context.Context,
+	count int,
+	slots chan struct{},
+	ranges chan readRange,
+	fn func(*ResolvedEvent) error,
+) error {
+	pending := make(map[int]readRange)
+	next := 0
+
+	for delivered := 0; delivered < count; {
+		var received readRange
+		select {
+		case received = <-ranges:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		if received.err != nil {
+			return received.err
+		}
+
+		pending[received.index] = received
+		for {
+			index := next
+			if reader.options.Unordered {
+				index = received.index
+			}
+
+			current, ok := pending[index]
+			if !ok {
+				break
+			}
+
+			delete(pending, index)
+			for i := range current.events {
+				if err := fn(&current.events[i]); err != nil {
+					return err
+				}
+			}
+
+			<-slots
+			delivered++
+			next++
+
+			if reader.options.Unordered {
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// end returns the revision the read stops at.
+func (reader *RangeReader) end(ctx context.Context) (uint64, error) {
+	if reader.options.To != nil {
+		return *reader.options.To, nil
+	}
+
+	head, err := reader.client.readStreamHeadRevision(ctx, reader.streamID, reader.options.Authenticated, reader.options.Deadline)
+	if err != nil {
+		return 0, err
+	}
+
+	return head + 1, nil
+}
+
+// readRange reads the events with a revision in [from, until). A truncated range makes the server start at the first
+// revision still readable, so events belonging to the following ranges are dropped.
+func (reader *RangeReader) readRange(ctx context.Context, from uint64, until uint64) ([]ResolvedEvent, error) {
+	stream, err := reader.client.ReadStream(ctx, reader.streamID, ReadStreamOptions{
+		Direction:      Forwards,
+		From:           Revision(from),
+		ResolveLinkTos: reader.options.ResolveLinkTos,
+		Authenticated:  reader.options.Authenticated,
+		Deadline:       reader.options.Deadline,
+	}, until-from)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := stream.Collect(int(until - from))
+	if err != nil {
+		var esdbErr *Error
+		if errors.As(err, &esdbErr) && esdbErr.Code() == ErrorResourceNotFound {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	kept := events[:0]
+	for _, event := range events {
+		if event.OriginalEvent().EventNumber < until {
+			kept = append(kept, event)
+		}
+	}
+
+	return kept, nil
+}
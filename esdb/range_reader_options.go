@@ -0,0 +1,44 @@
+package esdb
+
+import "time"
+
+// RangeReaderOptions configures a RangeReader.
+type RangeReaderOptions struct {
+	// First revision to read. Defaults to 0.
+	From uint64
+	// Revision to stop reading at, exclusive. Defaults to the revision following the last event of the stream when
+	// the read starts.
+	To *uint64
+	// Number of ranges read concurrently. At most that many ranges are held in memory at once. Defaults to 4.
+	Concurrency int
+	// Number of revisions of every range. Defaults to 1000.
+	RangeSize uint64
+	// Delivers the events of a range as soon as it is read, instead of in stream order. The events of a range stay
+	// ordered, and their revision tells where they belong.
+	Unordered      bool
+	ResolveLinkTos bool
+	Authenticated  *Credentials
+	Deadline       *time.Duration
+}
+
+func (o *RangeReaderOptions) setDefaults() {
+	if o.Concurrency == 0 {
+		o.Concurrency = 4
+	}
+
+	if o.RangeSize == 0 {
+		o.RangeSize = 1000
+	}
+}
+
+func (o *RangeReaderOptions) validate() error {
+	if o.Concurrency < 0 {
+		return invalidArgumentError("Concurrency must be positive, got %d", o.Concurrency)
+	}
+
+	if o.To != nil && *o.To < o.From {
+		return invalidArgumentError("To (%d) must not be lower than From (%d)", *o.To, o.From)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package esdb
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testRange(index int, revisions ...uint64) readRange {
+	events := make([]ResolvedEvent, len(revisions))
+	for i, revision := range revisions {
+		events[i] = ResolvedEvent{Event: &RecordedEvent{EventNumber: revision}}
+	}
+
+	return readRange{index: index, events: events}
+}
+
+func deliverRanges(t *testing.T, unordered bool, received ...readRange) ([]uint64, error) {
+	reader := &RangeReader{options: RangeReaderOptions{Unordered: unordered}}
+	slots := make(chan struct{}, len(received))
+	ranges := make(chan readRange, len(received))
+	for _, r := range received {
+		slots <- struct{}{}
+		ranges <- r
+	}
+
+	var revisions []uint64
+	err := reader.deliver(context.Background(), len(received), slots, ranges, func(event *ResolvedEvent) error {
+		revisions = append(revisions, event.OriginalEvent().EventNumber)
+		return nil
+	})
+
+	if err == nil {
+		assert.Empty(t, slots, "every slot is released")
+	}
+
+	return revisions, err
+}
+
+func TestRangeReaderDeliversInOrder(t *testing.T) {
+	revisions, err := deliverRanges(t, false, testRange(2, 4, 5), testRange(0, 0, 1), testRange(1, 2, 3))
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{0, 1, 2, 3, 4, 5}, revisions)
+}
+
+func TestRangeReaderDeliversUnordered(t *testing.T) {
+	revisions, err := deliverRanges(t, true, testRange(2, 4, 5), testRange(0, 0, 1), testRange(1, 2, 3))
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{4, 5, 0, 1, 2, 3}, revisions)
+}
+
+func TestRangeReaderStopsOnError(t *testing.T) {
+	failure := errors.New("read failed")
+	_, err := deliverRanges(t, false, testRange(0, 0), readRange{index: 1, err: failure})
+	assert.ErrorIs(t, err, failure)
+}
+
+func TestRangeReaderOptionsValidation(t *testing.T) {
+	to := uint64(5)
+	_, err := NewRangeReader(nil, "stream", RangeReaderOptions{From: 10, To: &to})
+	assertInvalidArgument(t, err)
+
+	_, err = NewRangeReader(nil, "stream", RangeReaderOptions{Concurrency: -1})
+	assertInvalidArgument(t, err)
+}
@@ -0,0 +1,97 @@
+package esdb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/protos/shared"
+	api "github.com/EventStore/EventStore-Client-Go/v2/protos/streams"
+	uuid "github.com/gofrs/uuid"
+)
+
+// RawEventData is an event appended with every field of the proposed message set by the caller. Unlike EventData,
+// the system metadata is sent as is, which is mostly useful to tools copying events between streams or databases.
+type RawEventData struct {
+	// Generated when not set.
+	EventID uuid.UUID
+	// Must hold the "type" and "content-type" entries. The server assigns the "created" entry itself, so tools
+	// preserving the original creation date have to store it in CustomMetadata.
+	SystemMetadata map[string]string
+	CustomMetadata []byte
+	Data           []byte
+}
+
+// RawEventDataFromRecordedEvent creates the RawEventData needed to append a copy of a recorded event, keeping its id,
+// system metadata and custom metadata.
+func RawEventDataFromRecordedEvent(event *RecordedEvent) RawEventData {
+	systemMetadata := make(map[string]string, len(event.SystemMetadata))
+	for key, value := range event.SystemMetadata {
+		systemMetadata[key] = value
+	}
+
+	return RawEventData{
+		EventID:        event.EventID,
+		SystemMetadata: systemMetadata,
+		CustomMetadata: event.UserMetadata,
+		Data:           event.Data,
+	}
+}
+
+// AppendRawToStream appends events like AppendToStream, sending their proposed messages as built by the caller.
+func (client *Client) AppendRawToStream(
+	context context.Context,
+	streamID string,
+	opts AppendToStreamOptions,
+	events ...RawEventData,
+) (*WriteResult, error) {
+	opts.setDefaults()
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	messages := make([]*api.AppendReq_ProposedMessage, 0, len(events))
+	for i, event := range events {
+		message, err := toRawProposedMessage(event)
+		if err != nil {
+			return nil, invalidArgumentError("event %d: %v", i, err)
+		}
+
+		messages = append(messages, message)
+	}
+
+	return client.appendMessages(context, streamID, &opts, messages)
+}
+
+func toRawProposedMessage(event RawEventData) (*api.AppendReq_ProposedMessage, error) {
+	for _, key := range []string{systemMetadataKeysType, systemMetadataKeysContentType} {
+		if event.SystemMetadata[key] == "" {
+			return nil, fmt.Errorf("system metadata entry '%s' is required", key)
+		}
+	}
+
+	eventID := event.EventID
+	if eventID == uuid.Nil {
+		eventID = uuid.Must(uuid.NewV4())
+	}
+
+	data := event.Data
+	if data == nil {
+		data = []byte{}
+	}
+
+	customMetadata := event.CustomMetadata
+	if customMetadata == nil {
+		customMetadata = []byte{}
+	}
+
+	return &api.AppendReq_ProposedMessage{
+		Id: &shared.UUID{
+			Value: &shared.UUID_String_{
+				String_: eventID.String(),
+			},
+		},
+		Metadata:       event.SystemMetadata,
+		CustomMetadata: customMetadata,
+		Data:           data,
+	}, nil
+}
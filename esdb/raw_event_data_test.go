@@ -0,0 +1,42 @@
+package esdb
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToRawProposedMessage(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	message, err := toRawProposedMessage(RawEventData{
+		EventID:        id,
+		SystemMetadata: map[string]string{"type": "copied", "content-type": "application/json", "source": "legacy"},
+		Data:           []byte("{}"),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, id, fromProtoUUID(message.Id))
+	assert.Equal(t, "legacy", message.Metadata["source"])
+	assert.Equal(t, []byte{}, message.CustomMetadata)
+
+	_, err = toRawProposedMessage(RawEventData{SystemMetadata: map[string]string{"type": "copied"}})
+	assert.Error(t, err)
+}
+
+func TestRawEventDataFromRecordedEvent(t *testing.T) {
+	recorded := &RecordedEvent{
+		EventID:        uuid.Must(uuid.NewV4()),
+		SystemMetadata: map[string]string{"type": "copied", "content-type": "application/json"},
+		UserMetadata:   []byte("meta"),
+		Data:           []byte("data"),
+	}
+
+	raw := RawEventDataFromRecordedEvent(recorded)
+	raw.SystemMetadata["type"] = "changed"
+
+	assert.Equal(t, recorded.EventID, raw.EventID)
+	assert.Equal(t, "copied", recorded.SystemMetadata["type"])
+	assert.Equal(t, []byte("meta"), raw.CustomMetadata)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"io/ioutil"
+	"strings"
 	"testing"
 	"time"
 
@@ -18,6 +19,7 @@ func ReadAllTests(t *testing.T, populatedDBClient *esdb.Client) {
 		t.Run("readAllEventsForwardsFromNonZeroPosition", readAllEventsForwardsFromNonZeroPosition(populatedDBClient))
 		t.Run("readAllEventsBackwardsFromZeroPosition", readAllEventsBackwardsFromZeroPosition(populatedDBClient))
 		t.Run("readAllEventsBackwardsFromNonZeroPosition", readAllEventsBackwardsFromNonZeroPosition(populatedDBClient))
+		t.Run("readAllLatest", readAllLatest(populatedDBClient))
 	})
 }
 
@@ -216,3 +218,30 @@ func readAllEventsBackwardsFromNonZeroPosition(db *esdb.Client) TestCall {
 		}
 	}
 }
+
+func readAllLatest(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		stream, err := db.ReadAll(context.Background(), esdb.ReadAllOptions{Direction: esdb.Backwards, From: esdb.End{}}, 10)
+		require.NoError(t, err)
+
+		backwards, err := stream.Collect(10)
+		require.NoError(t, err)
+		require.Len(t, backwards, 10)
+
+		latest, err := db.ReadAllLatest(context.Background(), 10, esdb.ReadAllLatestOptions{})
+		require.NoError(t, err)
+		require.Len(t, latest, 10)
+
+		for i := range latest {
+			assert.Equal(t, backwards[len(backwards)-1-i].OriginalEvent().EventID, latest[i].OriginalEvent().EventID)
+		}
+
+		filter := &esdb.SubscriptionFilter{Type: esdb.EventFilterType, Prefixes: []string{"$"}}
+		latest, err = db.ReadAllLatest(context.Background(), 5, esdb.ReadAllLatestOptions{Filter: filter})
+		require.NoError(t, err)
+
+		for _, event := range latest {
+			assert.True(t, strings.HasPrefix(event.OriginalEvent().EventType, "$"))
+		}
+	}
+}
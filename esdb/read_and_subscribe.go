@@ -0,0 +1,180 @@
+package esdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// ReadAndSubscription delivers the events of a stream from a given revision, first by reading them and then through
+// a subscription started from the last event read.
+type ReadAndSubscription struct {
+	client   *Client
+	ctx      context.Context
+	streamID string
+	opts     ReadAndSubscribeOptions
+
+	lock   sync.Mutex
+	read   *ReadStream
+	sub    *Subscription
+	closed bool
+	// Revision of the last event delivered, nil before the first one.
+	last *uint64
+	// Drop returned by every call to Recv once the read or the subscription failed.
+	dropped *SubscriptionEvent
+}
+
+// ReadAndSubscribe delivers every event of a stream from opts.From, then the events written afterwards. Past events
+// are read with a regular read, which is faster than a subscription catching up, and the subscription starts from the
+// last event read. Events are delivered exactly once and in order, the subscription skipping those the read already
+// delivered.
+func (client *Client) ReadAndSubscribe(
+	ctx context.Context,
+	streamID string,
+	opts ReadAndSubscribeOptions,
+) (*ReadAndSubscription, error) {
+	opts.setDefaults()
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	read, err := client.ReadStream(ctx, streamID, ReadStreamOptions{
+		From:           opts.From,
+		ResolveLinkTos: opts.ResolveLinkTos,
+		Authenticated:  opts.Authenticated,
+	}, ^uint64(0))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReadAndSubscription{
+		client:   client,
+		ctx:      ctx,
+		streamID: streamID,
+		opts:     opts,
+		read:     read,
+	}, nil
+}
+
+// Recv returns the next event, blocking once every past event got delivered. Only EventAppeared,
+// SubscriptionDropped and the caught-up or fell-behind notifications of the subscription are set.
+func (r *ReadAndSubscription) Recv() *SubscriptionEvent {
+	if r.dropped != nil {
+		return r.dropped
+	}
+
+	if r.read != nil {
+		event, err := r.read.Recv()
+
+		if err == nil {
+			revision := event.OriginalEvent().EventNumber
+			r.last = &revision
+			return &SubscriptionEvent{EventAppeared: event}
+		}
+
+		var esdbErr *Error
+		if !errors.Is(err, io.EOF) && !(errors.As(err, &esdbErr) && esdbErr.Code() == ErrorResourceNotFound) {
+			return r.drop(err)
+		}
+
+		r.read.Close()
+		r.lock.Lock()
+		r.read = nil
+		r.lock.Unlock()
+
+		if err := r.subscribe(); err != nil {
+			return r.drop(err)
+		}
+	}
+
+	for {
+		event := r.sub.Recv()
+
+		if event.EventAppeared != nil {
+			revision := event.EventAppeared.OriginalEvent().EventNumber
+			if r.last != nil && revision <= *r.last {
+				continue
+			}
+
+			r.last = &revision
+		}
+
+		if event.CheckPointReached != nil {
+			continue
+		}
+
+		return event
+	}
+}
+
+// subscribe starts the subscription from the last event read, or right before opts.From when nothing was read.
+func (r *ReadAndSubscription) subscribe() error {
+	var from StreamPosition = Start{}
+	if r.last != nil {
+		from = Revision(*r.last)
+	} else if revision, ok := r.opts.From.(StreamRevision); ok && revision.Value > 0 {
+		from = Revision(revision.Value - 1)
+	}
+
+	sub, err := r.client.SubscribeToStream(r.ctx, r.streamID, SubscribeToStreamOptions{
+		From:           from,
+		ResolveLinkTos: r.opts.ResolveLinkTos,
+		Authenticated:  r.opts.Authenticated,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.sub = sub
+	if r.closed {
+		_ = sub.Close()
+	}
+
+	return nil
+}
+
+func (r *ReadAndSubscription) drop(err error) *SubscriptionEvent {
+	_ = r.Close()
+
+	reason := SubscriptionDropServerError
+	var esdbErr *Error
+	if errors.As(err, &esdbErr) {
+		switch esdbErr.Code() {
+		case ErrorAccessDenied, ErrorUnauthenticated:
+			reason = SubscriptionDropAccessDenied
+		case ErrorStreamDeleted:
+			reason = SubscriptionDropStreamDeleted
+		}
+	}
+
+	r.dropped = &SubscriptionEvent{SubscriptionDropped: &SubscriptionDropped{
+		Error:  fmt.Errorf("failed to read stream '%s': %w", r.streamID, err),
+		Reason: reason,
+	}}
+
+	return r.dropped
+}
+
+// Close stops the read or the subscription.
+func (r *ReadAndSubscription) Close() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.closed = true
+	if r.read != nil {
+		r.read.Close()
+	}
+
+	if r.sub != nil {
+		return r.sub.Close()
+	}
+
+	return nil
+}
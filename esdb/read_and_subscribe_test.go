@@ -0,0 +1,32 @@
+package esdb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestReadAndSubscriptionKeepsReturningItsDrop(t *testing.T) {
+	closed := int32(1)
+	client := &Client{grpcClient: &grpcClient{channel: make(chan msg, 10), closeFlag: &closed, logger: &logger{}}}
+	read := newReadStream(readStreamParams{
+		client:   client.grpcClient,
+		handle:   &connectionHandle{},
+		cancel:   func() {},
+		inner:    &scriptedReadClient{},
+		headers:  &metadata.MD{},
+		trailers: &metadata.MD{},
+	})
+
+	r := &ReadAndSubscription{client: client, ctx: context.Background(), streamID: "stream", read: read}
+
+	first := r.Recv()
+	require.NotNil(t, first.SubscriptionDropped)
+
+	second := r.Recv()
+	require.NotNil(t, second.SubscriptionDropped)
+	assert.Equal(t, first.SubscriptionDropped, second.SubscriptionDropped)
+}
@@ -0,0 +1,46 @@
+package esdb
+
+import (
+	api "github.com/EventStore/EventStore-Client-Go/v2/protos/streams"
+)
+
+// readBuffers holds the values reused across the events of a read using ReuseBuffers. Recv returns a pointer to
+// resolved, whose event and link point to the recorded events below, so every event overwrites the previous one.
+type readBuffers struct {
+	resolved ResolvedEvent
+	event    RecordedEvent
+	link     RecordedEvent
+	commit   uint64
+}
+
+func (buffers *readBuffers) fill(result *api.ReadResp_ReadEvent) *ResolvedEvent {
+	buffers.resolved = ResolvedEvent{}
+
+	if position, ok := result.GetPosition().(*api.ReadResp_ReadEvent_CommitPosition); ok {
+		buffers.commit = position.CommitPosition
+		buffers.resolved.Commit = &buffers.commit
+	}
+
+	if wire := result.GetEvent(); wire != nil {
+		fillRecordedEvent(&buffers.event, wire)
+		buffers.resolved.Event = &buffers.event
+	}
+
+	if wire := result.GetLink(); wire != nil {
+		fillRecordedEvent(&buffers.link, wire)
+		buffers.resolved.Link = &buffers.link
+	}
+
+	return &buffers.resolved
+}
+
+// fillRecordedEvent overwrites target with the recorded event, keeping its stream id when the stream name didn't
+// change, which saves converting it to a string for every event of a stream read.
+func fillRecordedEvent(target *RecordedEvent, wire *api.ReadResp_ReadEvent_RecordedEvent) {
+	streamID := target.StreamID
+	if name := wire.GetStreamIdentifier().GetStreamName(); string(name) != streamID {
+		streamID = string(name)
+	}
+
+	*target = recordedEventFromProtoWithStreamID(wire, streamID)
+}
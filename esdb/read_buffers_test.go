@@ -0,0 +1,108 @@
+package esdb
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/protos/shared"
+	api "github.com/EventStore/EventStore-Client-Go/v2/protos/streams"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func testReadEvent(revision uint64) *api.ReadResp_ReadEvent {
+	return &api.ReadResp_ReadEvent{
+		Event: &api.ReadResp_ReadEvent_RecordedEvent{
+			Id:               toProtoUUID(uuid.Must(uuid.NewV4())),
+			StreamIdentifier: &shared.StreamIdentifier{StreamName: []byte("buffers-stream")},
+			StreamRevision:   revision,
+			Metadata: map[string]string{
+				systemMetadataKeysType:        "TestEvent",
+				systemMetadataKeysContentType: "application/json",
+				systemMetadataKeysCreated:     "16000000000000000",
+			},
+			Data: []byte(strconv.FormatUint(revision, 10)),
+		},
+		Position: &api.ReadResp_ReadEvent_CommitPosition{CommitPosition: revision * 100},
+	}
+}
+
+func TestReadBuffersReuseEvents(t *testing.T) {
+	buffers := &readBuffers{}
+
+	first := buffers.fill(testReadEvent(1))
+	kept := first.Clone()
+
+	second := buffers.fill(testReadEvent(2))
+	assert.Same(t, first, second)
+	assert.Equal(t, uint64(2), second.OriginalEvent().EventNumber)
+	assert.Equal(t, "buffers-stream", second.OriginalEvent().StreamID)
+	require.NotNil(t, second.Commit)
+	assert.Equal(t, uint64(200), *second.Commit)
+
+	assert.Equal(t, uint64(1), kept.OriginalEvent().EventNumber)
+	assert.Equal(t, []byte("1"), kept.OriginalEvent().Data)
+	assert.Equal(t, uint64(100), *kept.Commit)
+	assert.Nil(t, kept.Link)
+}
+
+func newReusingReadStream(revisions ...uint64) *ReadStream {
+	stream := newReadStream(readStreamParams{
+		client:   &grpcClient{channel: make(chan msg, 10), logger: &logger{}},
+		handle:   &connectionHandle{},
+		cancel:   func() {},
+		inner:    &scriptedReadClient{responses: readEventResponses(revisions...)},
+		headers:  &metadata.MD{},
+		trailers: &metadata.MD{},
+	})
+	stream.buffers = &readBuffers{}
+
+	return stream
+}
+
+func TestCollectClonesReusedBuffers(t *testing.T) {
+	events, err := newReusingReadStream(0, 1, 2).Collect(10)
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+
+	for i, event := range events {
+		assert.Equal(t, uint64(i), event.OriginalEvent().EventNumber)
+		assert.Equal(t, []byte(strconv.Itoa(i)), event.OriginalEvent().Data)
+		assert.Equal(t, uint64(i*100), *event.Commit)
+	}
+}
+
+func TestChanClonesReusedBuffers(t *testing.T) {
+	var events []*ResolvedEvent
+	for result := range newReusingReadStream(0, 1, 2).Chan() {
+		require.NoError(t, result.Err)
+		events = append(events, result.Event)
+	}
+
+	require.Len(t, events, 3)
+	for i, event := range events {
+		assert.Equal(t, uint64(i), event.OriginalEvent().EventNumber)
+	}
+}
+
+func BenchmarkReadEventConversion(b *testing.B) {
+	event := testReadEvent(1)
+
+	b.Run("allocating", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			resolved := getResolvedEventFromProto(event)
+			_ = &resolved
+		}
+	})
+
+	b.Run("reused", func(b *testing.B) {
+		buffers := &readBuffers{}
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			buffers.fill(event)
+		}
+	})
+}
@@ -10,6 +10,29 @@ type ReadStreamOptions struct {
 	ResolveLinkTos bool
 	Authenticated  *Credentials
 	Deadline       *time.Duration
+	// Overrides Configuration.RetryPolicy. Only failures happening before the first event is received are
+	// retried, unless ResumeOnReconnect is set. Rejected when the client retries reads at the gRPC level, see
+	// Configuration.EnableGrpcRetries.
+	RetryPolicy *RetryPolicy
+	// Makes a read failing after returning events reopen transparently from the last event it returned, which is
+	// never returned twice. Failures are retried according to RetryPolicy, DefaultRetryPolicy being used when
+	// retries are otherwise disabled. Events written in the meantime may be read, as the resumed read sees the
+	// stream as it is when reopened.
+	ResumeOnReconnect bool
+	// Overrides Configuration.Compression, NoCompression disabling it.
+	Compression string
+	// Makes Recv reuse the same ResolvedEvent and RecordedEvent values for every event, which are only valid until
+	// the next call to Recv. Use ResolvedEvent.Clone to keep an event around. It reduces the allocations of large
+	// scans, while the event payloads are still allocated when decoding the gRPC messages. Collect and Chan clone
+	// every event, so they give up the savings but remain safe to use.
+	ReuseBuffers bool
+	// Number of messages received ahead of Recv by a background goroutine, overlapping network receives with the
+	// processing of the events. Defaults to 0, which disables prefetching.
+	PrefetchCount int
+	// Fails the read with ErrorDeadlineExceeded when the server sends no message for that long, unlike Deadline
+	// which bounds the whole read. Time spent by the caller between two calls to Recv isn't counted. Defaults to 0,
+	// which disables it.
+	IdleTimeout time.Duration
 }
 
 func (o *ReadStreamOptions) kind() operationKind {
@@ -30,12 +53,100 @@ func (o *ReadStreamOptions) setDefaults() {
 	}
 }
 
+func (o *ReadStreamOptions) validate() error {
+	if err := validateCompression(o.Compression); err != nil {
+		return err
+	}
+
+	if o.PrefetchCount < 0 {
+		return invalidArgumentError("PrefetchCount must be positive, got %d", o.PrefetchCount)
+	}
+
+	if err := validateIdleTimeout(o.IdleTimeout); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ReadEventOptions configures ReadEvent.
+type ReadEventOptions struct {
+	ResolveLinkTos bool
+	Authenticated  *Credentials
+	Deadline       *time.Duration
+}
+
+// GetStreamLengthOptions configures GetStreamLength.
+type GetStreamLengthOptions struct {
+	Authenticated *Credentials
+	Deadline      *time.Duration
+}
+
+// ReadStreamAllOptions configures ReadStreamAll.
+type ReadStreamAllOptions struct {
+	ReadStreamOptions
+	// Maximum number of events read before failing with ErrorReadLimitExceeded. Defaults to 10000.
+	MaxCount int
+}
+
+func (o *ReadStreamAllOptions) setDefaults() {
+	if o.MaxCount == 0 {
+		o.MaxCount = 10000
+	}
+}
+
+func (o *ReadStreamAllOptions) validate() error {
+	if o.MaxCount < 0 {
+		return invalidArgumentError("MaxCount must be positive, got %d", o.MaxCount)
+	}
+
+	return nil
+}
+
+// ReadAllLatestOptions configures ReadAllLatest.
+type ReadAllLatestOptions struct {
+	// Only returns the events matching the filter.
+	Filter *SubscriptionFilter
+	// Maximum number of events the server scans looking for a match of Filter. Defaults to 32 when Filter is set.
+	MaxSearchWindow int
+	ResolveLinkTos  bool
+	Authenticated   *Credentials
+	Deadline        *time.Duration
+}
+
 type ReadAllOptions struct {
 	Direction      Direction
 	From           AllPosition
 	ResolveLinkTos bool
 	Authenticated  *Credentials
 	Deadline       *time.Duration
+	// Overrides Configuration.RetryPolicy. Only failures happening before the first event is received are
+	// retried, unless ResumeOnReconnect is set. Rejected when the client retries reads at the gRPC level, see
+	// Configuration.EnableGrpcRetries.
+	RetryPolicy *RetryPolicy
+	// Makes a read failing after returning events reopen transparently from the last event it returned, which is
+	// never returned twice. Failures are retried according to RetryPolicy, DefaultRetryPolicy being used when
+	// retries are otherwise disabled. Events written in the meantime may be read, as the resumed read sees the
+	// stream as it is when reopened.
+	ResumeOnReconnect bool
+	// Overrides Configuration.Compression, NoCompression disabling it.
+	Compression string
+	// Makes Recv reuse the same ResolvedEvent and RecordedEvent values for every event, which are only valid until
+	// the next call to Recv. Use ResolvedEvent.Clone to keep an event around. It reduces the allocations of large
+	// scans, while the event payloads are still allocated when decoding the gRPC messages. Collect and Chan clone
+	// every event, so they give up the savings but remain safe to use.
+	ReuseBuffers bool
+	// Number of messages received ahead of Recv by a background goroutine, overlapping network receives with the
+	// processing of the events. Defaults to 0, which disables prefetching.
+	PrefetchCount int
+	// Fails the read with ErrorDeadlineExceeded when the server sends no message for that long, unlike Deadline
+	// which bounds the whole read. Time spent by the caller between two calls to Recv isn't counted. Defaults to 0,
+	// which disables it.
+	IdleTimeout time.Duration
+	// Only reads the events matching the filter.
+	Filter *SubscriptionFilter
+	// Maximum number of events the server scans looking for a match of Filter. Defaults to 32 when Filter is set.
+	MaxSearchWindow int
 }
 
 func (o *ReadAllOptions) kind() operationKind {
@@ -54,4 +165,30 @@ func (o *ReadAllOptions) setDefaults() {
 	if o.From == nil {
 		o.From = Start{}
 	}
+
+	if o.Filter != nil && o.MaxSearchWindow == 0 {
+		o.MaxSearchWindow = 32
+	}
+}
+
+func (o *ReadAllOptions) validate() error {
+	if err := validateCompression(o.Compression); err != nil {
+		return err
+	}
+
+	if o.PrefetchCount < 0 {
+		return invalidArgumentError("PrefetchCount must be positive, got %d", o.PrefetchCount)
+	}
+
+	if err := validateIdleTimeout(o.IdleTimeout); err != nil {
+		return err
+	}
+
+	if o.Filter != nil {
+		if err := validateSubscriptionFilter(o.Filter, o.MaxSearchWindow); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
@@ -0,0 +1,123 @@
+package esdb
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PageRequest configures ReadStreamPage.
+type PageRequest struct {
+	// Continuation token of the page to read, as returned with the previous page. Empty reads the first page, which
+	// starts at the beginning of the stream when reading forwards and at its end when reading backwards.
+	From string
+	// Maximum number of events of the page. Defaults to 20.
+	PageSize       uint64
+	Direction      Direction
+	ResolveLinkTos bool
+	Authenticated  *Credentials
+	Deadline       *time.Duration
+}
+
+func (r *PageRequest) setDefaults() {
+	if r.PageSize == 0 {
+		r.PageSize = 20
+	}
+}
+
+// StreamPage is a page of events returned by ReadStreamPage.
+type StreamPage struct {
+	Events []ResolvedEvent
+	// Token to pass as PageRequest.From to read the next page. Empty when IsEndOfStream is true.
+	ContinuationToken string
+	// Tells whether the page holds the last events of the stream in the read direction.
+	IsEndOfStream bool
+}
+
+// ReadStreamPage reads a page of events of a stream. Pages are chained with opaque continuation tokens, which stay
+// valid as long as the stream isn't truncated or deleted, so they can be handed out to the clients of an HTTP API.
+// A stream which doesn't exist fails with ErrorResourceNotFound.
+func (client *Client) ReadStreamPage(
+	ctx context.Context,
+	streamID string,
+	request PageRequest,
+) (*StreamPage, error) {
+	request.setDefaults()
+
+	opts := ReadStreamOptions{
+		Direction:      request.Direction,
+		ResolveLinkTos: request.ResolveLinkTos,
+		Authenticated:  request.Authenticated,
+		Deadline:       request.Deadline,
+	}
+
+	if request.Direction == Backwards {
+		opts.From = End{}
+	}
+
+	if request.From != "" {
+		revision, err := parsePageToken(request.From, request.Direction)
+		if err != nil {
+			return nil, err
+		}
+
+		opts.From = Revision(revision)
+	}
+
+	// Reading one event more than the page size tells whether another page follows, and gives its first revision
+	// without having to add or subtract one depending on the direction.
+	stream, err := client.ReadStream(ctx, streamID, opts, request.PageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := stream.Collect(int(request.PageSize) + 1)
+	if err != nil {
+		return nil, err
+	}
+
+	if uint64(len(events)) <= request.PageSize {
+		return &StreamPage{Events: events, IsEndOfStream: true}, nil
+	}
+
+	next := events[request.PageSize].OriginalEvent().EventNumber
+	return &StreamPage{
+		Events:            events[:request.PageSize],
+		ContinuationToken: newPageToken(next, request.Direction),
+	}, nil
+}
+
+func pageTokenPrefix(direction Direction) string {
+	if direction == Backwards {
+		return "b:"
+	}
+
+	return "f:"
+}
+
+func newPageToken(revision uint64, direction Direction) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(pageTokenPrefix(direction) + strconv.FormatUint(revision, 10)))
+}
+
+// parsePageToken returns the revision a continuation token starts at. A token can only continue a read in the
+// direction it was created with.
+func parsePageToken(token string, direction Direction) (uint64, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, invalidArgumentError("malformed continuation token '%s'", token)
+	}
+
+	value := string(decoded)
+	if !strings.HasPrefix(value, pageTokenPrefix(direction)) {
+		return 0, invalidArgumentError("continuation token '%s' doesn't match the read direction", token)
+	}
+
+	revision, err := strconv.ParseUint(strings.TrimPrefix(value, pageTokenPrefix(direction)), 10, 64)
+	if err != nil {
+		return 0, invalidArgumentError("malformed continuation token '%s': %v", token, err)
+	}
+
+	return revision, nil
+}
@@ -0,0 +1,27 @@
+package esdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageTokenRoundTrip(t *testing.T) {
+	for _, direction := range []Direction{Forwards, Backwards} {
+		revision, err := parsePageToken(newPageToken(42, direction), direction)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(42), revision)
+	}
+}
+
+func TestPageTokenRejectsInvalidTokens(t *testing.T) {
+	_, err := parsePageToken(newPageToken(42, Forwards), Backwards)
+	assertInvalidArgument(t, err)
+
+	_, err = parsePageToken("not a token!", Forwards)
+	assertInvalidArgument(t, err)
+
+	_, err = parsePageToken(newPageToken(42, Forwards)[:2], Forwards)
+	assertInvalidArgument(t, err)
+}
@@ -0,0 +1,73 @@
+package esdb
+
+import (
+	"io"
+
+	api "github.com/EventStore/EventStore-Client-Go/v2/protos/streams"
+	"google.golang.org/grpc/status"
+)
+
+type prefetchedMessage struct {
+	msg *api.ReadResp
+	err error
+}
+
+// prefetchReadClient receives the messages of a read call in a background goroutine, buffering up to count of them
+// ahead of Recv. The goroutine stops after the first error, or once the call is cancelled.
+type prefetchReadClient struct {
+	api.Streams_ReadClient
+	messages chan prefetchedMessage
+	// Called with true when the goroutine starts waiting on the server, and with false once a message arrived. Nil
+	// when nothing observes the call.
+	observe func(waiting bool)
+}
+
+func newPrefetchReadClient(inner api.Streams_ReadClient, count int, observe func(waiting bool)) *prefetchReadClient {
+	client := &prefetchReadClient{
+		Streams_ReadClient: inner,
+		messages:           make(chan prefetchedMessage, count),
+		observe:            observe,
+	}
+
+	go client.run()
+	return client
+}
+
+func (client *prefetchReadClient) run() {
+	defer close(client.messages)
+
+	for {
+		if client.observe != nil {
+			client.observe(true)
+		}
+
+		msg, err := client.Streams_ReadClient.Recv()
+		if client.observe != nil && err == nil {
+			client.observe(false)
+		}
+
+		select {
+		case client.messages <- prefetchedMessage{msg: msg, err: err}:
+		case <-client.Context().Done():
+			return
+		}
+
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (client *prefetchReadClient) Recv() (*api.ReadResp, error) {
+	prefetched, ok := <-client.messages
+	if !ok {
+		// The goroutine only stops without delivering an error when the call got cancelled.
+		if err := client.Context().Err(); err != nil {
+			return nil, status.FromContextError(err).Err()
+		}
+
+		return nil, io.EOF
+	}
+
+	return prefetched.msg, prefetched.err
+}
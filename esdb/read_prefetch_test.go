@@ -0,0 +1,57 @@
+package esdb
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPrefetchReadClientDeliversEveryMessage(t *testing.T) {
+	client := newPrefetchReadClient(&fakeReadClient{remaining: 10}, 3, nil)
+
+	for i := 0; i < 10; i++ {
+		msg, err := client.Recv()
+		require.NoError(t, err)
+		assert.NotNil(t, msg.GetEvent())
+	}
+
+	_, err := client.Recv()
+	assert.ErrorIs(t, err, io.EOF)
+
+	_, err = client.Recv()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestPrefetchReadClientStopsWhenCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	client := newPrefetchReadClient(&fakeReadClient{ctx: ctx, remaining: 1000}, 2, nil)
+
+	_, err := client.Recv()
+	require.NoError(t, err)
+	cancel()
+
+	// Buffered messages may still be delivered, but the read must end with the cancellation.
+	for {
+		_, err = client.Recv()
+		if err != nil {
+			break
+		}
+	}
+
+	assert.Equal(t, codes.Canceled, status.Code(err))
+}
+
+func TestReadStreamPrefetch(t *testing.T) {
+	stream := newFakeReadStream(5)
+	stream.prefetch = 2
+	stream.wrapCall()
+
+	events, err := stream.Collect(10)
+	require.NoError(t, err)
+	assert.Len(t, events, 5)
+}
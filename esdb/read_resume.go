@@ -0,0 +1,52 @@
+package esdb
+
+import (
+	"math"
+
+	api "github.com/EventStore/EventStore-Client-Go/v2/protos/streams"
+	"google.golang.org/protobuf/proto"
+)
+
+// resumeReadRequest returns a copy of the request reading the remaining events from the last one received. It also
+// tells whether the server sends the last event again, which is the case of every read but backward reads of $all.
+func resumeReadRequest(request *api.ReadReq, last RecordedEvent, remaining uint64) (*api.ReadReq, bool) {
+	resumed := proto.Clone(request).(*api.ReadReq)
+	options := resumed.Options
+	duplicate := true
+
+	switch option := options.StreamOption.(type) {
+	case *api.ReadReq_Options_Stream:
+		streamID := string(option.Stream.StreamIdentifier.StreamName)
+		options.StreamOption = toReadStreamOptionsFromStreamAndStreamRevision(streamID, StreamRevision{Value: last.EventNumber})
+	case *api.ReadReq_Options_All:
+		options.StreamOption = toAllReadOptionsFromPosition(last.Position)
+		duplicate = options.ReadDirection == api.ReadReq_Options_Forwards
+	}
+
+	if duplicate && remaining < math.MaxUint64 {
+		remaining++
+	}
+
+	options.CountOption = &api.ReadReq_Options_Count{Count: remaining}
+	return resumed, duplicate
+}
+
+// isDuplicate tells whether the event is the one a resumed read was expected to send again, which is only checked for
+// the first event received after resuming.
+func (retry *readRetry) isDuplicate(event *ResolvedEvent) bool {
+	duplicate := retry.duplicate
+	if duplicate == nil {
+		return false
+	}
+
+	retry.duplicate = nil
+	original := event.OriginalEvent()
+	return original != nil && original.EventNumber == duplicate.EventNumber && original.Position == duplicate.Position
+}
+
+// resumedAll tells whether a read resuming on reconnection already returned every event it asked for, in which case a
+// failure is the end of the read.
+func (stream *ReadStream) resumedAll() bool {
+	retry := stream.retry
+	return retry != nil && retry.resume && retry.delivered >= retry.count
+}
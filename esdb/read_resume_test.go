@@ -0,0 +1,108 @@
+package esdb
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	api "github.com/EventStore/EventStore-Client-Go/v2/protos/streams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// failingReadClient replays the given responses, then fails with err.
+type failingReadClient struct {
+	scriptedReadClient
+	err error
+}
+
+func (c *failingReadClient) Recv() (*api.ReadResp, error) {
+	if len(c.responses) == 0 {
+		return nil, c.err
+	}
+
+	return c.scriptedReadClient.Recv()
+}
+
+func readEventResponses(revisions ...uint64) []*api.ReadResp {
+	var responses []*api.ReadResp
+	for _, revision := range revisions {
+		responses = append(responses, &api.ReadResp{Content: &api.ReadResp_Event{Event: testReadEvent(revision)}})
+	}
+
+	return responses
+}
+
+// newResumingReadStream returns a read resuming on reconnection, whose reopened calls replay the given revisions. The
+// returned slice receives the requests of the reopened calls.
+func newResumingReadStream(count uint64, inner api.Streams_ReadClient, replay ...uint64) (*ReadStream, *[]*api.ReadReq) {
+	reopened := new([]*api.ReadReq)
+	client := &grpcClient{channel: make(chan msg, 10), logger: &logger{}}
+	params := func(inner api.Streams_ReadClient) readStreamParams {
+		return readStreamParams{
+			client:   client,
+			handle:   &connectionHandle{},
+			cancel:   func() {},
+			inner:    inner,
+			headers:  &metadata.MD{},
+			trailers: &metadata.MD{},
+		}
+	}
+
+	stream := newReadStream(params(inner))
+	stream.retry = &readRetry{
+		ctx:     context.Background(),
+		policy:  &RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond, Multiplier: 1, RetryableCodes: []ErrorCode{ErrorUnknown}},
+		request: toReadStreamRequest("stream", Forwards, Start{}, count, false),
+		openFrom: func(request *api.ReadReq) (*ReadStream, error) {
+			*reopened = append(*reopened, request)
+			return newReadStream(params(&scriptedReadClient{responses: readEventResponses(replay...)})), nil
+		},
+		attempt: 1,
+		resume:  true,
+		count:   count,
+	}
+
+	return stream, reopened
+}
+
+func TestReadStreamResumesFromLastEvent(t *testing.T) {
+	inner := &failingReadClient{
+		scriptedReadClient: scriptedReadClient{responses: readEventResponses(0, 1)},
+		err:                status.Error(codes.Unavailable, "connection lost"),
+	}
+	stream, reopened := newResumingReadStream(3, inner, 1, 2)
+
+	events, err := stream.Collect(10)
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+
+	for i, event := range events {
+		assert.Equal(t, uint64(i), event.OriginalEvent().EventNumber)
+	}
+
+	require.Len(t, *reopened, 1)
+	request := (*reopened)[0]
+	assert.Equal(t, uint64(1), request.Options.GetStream().GetRevision())
+	assert.Equal(t, uint64(2), request.Options.GetCount())
+}
+
+func TestReadStreamResumeEndsOnceEveryEventIsReturned(t *testing.T) {
+	inner := &failingReadClient{
+		scriptedReadClient: scriptedReadClient{responses: readEventResponses(0, 1)},
+		err:                status.Error(codes.Unavailable, "connection lost"),
+	}
+	stream, reopened := newResumingReadStream(2, inner)
+
+	events, err := stream.Collect(10)
+	require.NoError(t, err)
+	assert.Len(t, events, 2)
+	assert.Empty(t, *reopened)
+
+	_, err = stream.Recv()
+	assert.ErrorIs(t, err, io.EOF)
+}
@@ -47,6 +47,11 @@ func ReadStreamTests(t *testing.T, emptyDBClient *esdb.Client, populatedDBClient
 		t.Run("readStreamReturnsEOFAfterCompletion", readStreamReturnsEOFAfterCompletion(emptyDBClient))
 		t.Run("readStreamNotFound", readStreamNotFound(emptyDBClient))
 		t.Run("readStreamWithMaxAge", readStreamWithMaxAge(emptyDBClient))
+		t.Run("readStreamForEach", readStreamForEach(emptyDBClient))
+		t.Run("readStreamAll", readStreamAll(emptyDBClient))
+		t.Run("readStreamPage", readStreamPage(emptyDBClient))
+		t.Run("readEvent", readEvent(emptyDBClient))
+		t.Run("rangeReader", rangeReader(emptyDBClient))
 	})
 }
 
@@ -236,3 +241,147 @@ func readStreamWithMaxAge(db *esdb.Client) TestCall {
 		require.True(t, errors.Is(err, io.EOF))
 	}
 }
+
+func readStreamForEach(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		streamName := NAME_GENERATOR.Generate()
+		_, err := db.AppendToStream(context.Background(), streamName, esdb.AppendToStreamOptions{}, testCreateEvents(3)...)
+		require.NoError(t, err)
+
+		stream, err := db.ReadStream(context.Background(), streamName, esdb.ReadStreamOptions{}, 10)
+		require.NoError(t, err)
+
+		count := 0
+		err = stream.ForEach(context.Background(), func(*esdb.ResolvedEvent) error {
+			count++
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, count)
+
+		stream, err = db.ReadStream(context.Background(), streamName, esdb.ReadStreamOptions{}, 10)
+		require.NoError(t, err)
+
+		stopErr := errors.New("stop")
+		err = stream.ForEach(context.Background(), func(*esdb.ResolvedEvent) error {
+			return stopErr
+		})
+
+		require.True(t, errors.Is(err, stopErr))
+	}
+}
+
+func readStreamAll(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		streamName := NAME_GENERATOR.Generate()
+		_, err := db.AppendToStream(context.Background(), streamName, esdb.AppendToStreamOptions{}, testCreateEvents(3)...)
+		require.NoError(t, err)
+
+		events, err := db.ReadStreamAll(context.Background(), streamName, esdb.ReadStreamAllOptions{})
+		require.NoError(t, err)
+		assert.Len(t, events, 3)
+
+		events, err = db.ReadStreamAll(context.Background(), streamName, esdb.ReadStreamAllOptions{MaxCount: 3})
+		require.NoError(t, err)
+		assert.Len(t, events, 3)
+
+		_, err = db.ReadStreamAll(context.Background(), streamName, esdb.ReadStreamAllOptions{MaxCount: 2})
+		require.True(t, errors.Is(err, esdb.ErrReadLimitExceeded))
+		var limitErr *esdb.Error
+		require.True(t, errors.As(err, &limitErr))
+		require.Equal(t, esdb.ErrorReadLimitExceeded, limitErr.Code())
+
+		_, err = db.ReadStreamAll(context.Background(), NAME_GENERATOR.Generate(), esdb.ReadStreamAllOptions{})
+		esdbErr, ok := esdb.FromError(err)
+		require.False(t, ok)
+		require.Equal(t, esdb.ErrorResourceNotFound, esdbErr.Code())
+	}
+}
+
+func readStreamPage(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		streamName := NAME_GENERATOR.Generate()
+		_, err := db.AppendToStream(context.Background(), streamName, esdb.AppendToStreamOptions{}, testCreateEvents(5)...)
+		require.NoError(t, err)
+
+		for _, direction := range []esdb.Direction{esdb.Forwards, esdb.Backwards} {
+			var revisions []uint64
+			request := esdb.PageRequest{PageSize: 2, Direction: direction}
+
+			for {
+				page, err := db.ReadStreamPage(context.Background(), streamName, request)
+				require.NoError(t, err)
+
+				for _, event := range page.Events {
+					revisions = append(revisions, event.OriginalEvent().EventNumber)
+				}
+
+				if page.IsEndOfStream {
+					assert.Empty(t, page.ContinuationToken)
+					break
+				}
+
+				request.From = page.ContinuationToken
+			}
+
+			if direction == esdb.Forwards {
+				assert.Equal(t, []uint64{0, 1, 2, 3, 4}, revisions)
+			} else {
+				assert.Equal(t, []uint64{4, 3, 2, 1, 0}, revisions)
+			}
+		}
+	}
+}
+
+func readEvent(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		streamName := NAME_GENERATOR.Generate()
+		_, err := db.AppendToStream(context.Background(), streamName, esdb.AppendToStreamOptions{}, testCreateEvents(3)...)
+		require.NoError(t, err)
+
+		event, err := db.ReadEvent(context.Background(), streamName, 1, esdb.ReadEventOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(1), event.OriginalEvent().EventNumber)
+
+		_, err = db.ReadEvent(context.Background(), streamName, 3, esdb.ReadEventOptions{})
+		require.True(t, errors.Is(err, esdb.ErrEventNotFound))
+
+		_, err = db.ReadEvent(context.Background(), NAME_GENERATOR.Generate(), 0, esdb.ReadEventOptions{})
+		require.True(t, errors.Is(err, esdb.ErrEventNotFound))
+		var esdbErr *esdb.Error
+		require.True(t, errors.As(err, &esdbErr))
+		require.Equal(t, esdb.ErrorEventNotFound, esdbErr.Code())
+	}
+}
+
+func rangeReader(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		streamName := NAME_GENERATOR.Generate()
+		_, err := db.AppendToStream(context.Background(), streamName, esdb.AppendToStreamOptions{}, testCreateEvents(25)...)
+		require.NoError(t, err)
+
+		for _, unordered := range []bool{false, true} {
+			reader, err := esdb.NewRangeReader(db, streamName, esdb.RangeReaderOptions{
+				Concurrency: 3,
+				RangeSize:   4,
+				Unordered:   unordered,
+			})
+			require.NoError(t, err)
+
+			var revisions []uint64
+			err = reader.Run(context.Background(), func(event *esdb.ResolvedEvent) error {
+				revisions = append(revisions, event.OriginalEvent().EventNumber)
+				return nil
+			})
+			require.NoError(t, err)
+			require.Len(t, revisions, 25)
+
+			if !unordered {
+				for i, revision := range revisions {
+					assert.Equal(t, uint64(i), revision)
+				}
+			}
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"io"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	api "github.com/EventStore/EventStore-Client-Go/v2/protos/streams"
 	"google.golang.org/grpc/metadata"
@@ -15,7 +16,47 @@ import (
 type ReadStream struct {
 	once   *sync.Once
 	closed *int32
+	// Closed along with the stream, unblocking the goroutine started by Chan.
+	done chan struct{}
+	// Set once the gRPC call completed, making its headers and trailers safe to read.
+	finished *int32
+	// Guards params, which are replaced when the read is retried.
+	lock   *sync.Mutex
 	params readStreamParams
+	retry  *readRetry
+	// Only set when the read reuses its buffers.
+	buffers *readBuffers
+	// Number of messages prefetched from every call the stream reads from, 0 when prefetching is disabled.
+	prefetch int
+	// Idle timeout applied to every call the stream reads from, 0 when disabled.
+	idleTimeout time.Duration
+	// Guards the last* fields, which are read by LastPosition and LastStreamRevision.
+	lastLock     *sync.Mutex
+	hasLast      bool
+	lastRevision uint64
+	lastPosition Position
+}
+
+// readRetry holds the state needed to reopen a read failing before its first event, or at any point when it resumes
+// on reconnection. Without a policy, the read is only reopened when the server rejected its compression.
+type readRetry struct {
+	ctx      context.Context
+	policy   *RetryPolicy
+	openFrom func(*api.ReadReq) (*ReadStream, error)
+	request  *api.ReadReq
+	attempt  int
+	received bool
+	// Set when the read resumes from its last event after a failure. count is the number of events requested and
+	// delivered the number of events returned so far.
+	resume    bool
+	count     uint64
+	delivered uint64
+	// Set after resuming from an event the server sends again, which must be skipped.
+	duplicate *RecordedEvent
+}
+
+func (retry *readRetry) open() (*ReadStream, error) {
+	return retry.openFrom(retry.request)
 }
 
 type readStreamParams struct {
@@ -25,12 +66,17 @@ type readStreamParams struct {
 	inner    api.Streams_ReadClient
 	headers  *metadata.MD
 	trailers *metadata.MD
+	// Empty when the read isn't compressed.
+	compressor string
 }
 
 func (stream *ReadStream) Close() {
 	stream.once.Do(func() {
 		atomic.StoreInt32(stream.closed, 1)
+		close(stream.done)
+		stream.lock.Lock()
 		stream.params.cancel()
+		stream.lock.Unlock()
 	})
 }
 
@@ -42,19 +88,67 @@ func (stream *ReadStream) Recv() (*ResolvedEvent, error) {
 	msg, err := stream.params.inner.Recv()
 
 	if err != nil {
-		atomic.StoreInt32(stream.closed, 1)
+		if stream.params.compressor != "" && isCompressionRejected(err) {
+			stream.params.client.rejectCompression(stream.params.compressor)
+
+			if stream.reopenUncompressed() {
+				return stream.Recv()
+			}
+		}
 
 		if !errors.Is(err, io.EOF) {
 			err = stream.params.client.handleError(stream.params.handle, *stream.params.headers, *stream.params.trailers, err)
+
+			if stream.resumedAll() {
+				err = io.EOF
+			} else if stream.reopen(err) {
+				return stream.Recv()
+			}
 		}
 
+		atomic.StoreInt32(stream.closed, 1)
+		atomic.StoreInt32(stream.finished, 1)
+
 		return nil, err
 	}
 
 	switch msg.Content.(type) {
 	case *api.ReadResp_Event:
-		resolvedEvent := getResolvedEventFromProto(msg.GetEvent())
-		return &resolvedEvent, nil
+		var resolvedEvent *ResolvedEvent
+		if stream.buffers != nil {
+			resolvedEvent = stream.buffers.fill(msg.GetEvent())
+		} else {
+			resolved := getResolvedEventFromProto(msg.GetEvent())
+			resolvedEvent = &resolved
+		}
+
+		if retry := stream.retry; retry != nil {
+			retry.received = true
+
+			if retry.resume {
+				if retry.isDuplicate(resolvedEvent) {
+					return stream.Recv()
+				}
+
+				// A resumed read asks for one more event than needed when it expects a duplicate, which may not come.
+				if retry.delivered >= retry.count {
+					stream.Close()
+					atomic.StoreInt32(stream.finished, 1)
+					return nil, io.EOF
+				}
+
+				// Every failure gets the full number of attempts once the read made progress again.
+				retry.attempt = 1
+				retry.delivered++
+			}
+		}
+
+		stream.received(resolvedEvent)
+		return resolvedEvent, nil
+	case *api.ReadResp_Checkpoint_, *api.ReadResp_CaughtUp_, *api.ReadResp_FellBehind_:
+		// Filtered reads of $all may report the position they reached without yielding an event, and reads have no
+		// live phase to report a transition to.
+		return stream.Recv()
 	case *api.ReadResp_StreamNotFound_:
 		atomic.StoreInt32(stream.closed, 1)
 		streamName := string(msg.Content.(*api.ReadResp_StreamNotFound_).StreamNotFound.StreamIdentifier.StreamName)
@@ -64,6 +158,154 @@ func (stream *ReadStream) Recv() (*ResolvedEvent, error) {
 	panic("unreachable code")
 }
 
+// RecvRaw returns the next gRPC message of the read as is, including the checkpoints and live transitions Recv skips,
+// for tools needing fields the client doesn't map. Errors are mapped like those of Recv, but a failure is never
+// retried and ResumeOnReconnect stops applying to the read. LastPosition and LastStreamRevision ignore the events it
+// returns.
+func (stream *ReadStream) RecvRaw() (*api.ReadResp, error) {
+	if atomic.LoadInt32(stream.closed) != 0 {
+		return nil, io.EOF
+	}
+
+	if stream.retry != nil {
+		stream.retry.received = true
+		stream.retry.resume = false
+	}
+
+	msg, err := stream.params.inner.Recv()
+	if err != nil {
+		if !errors.Is(err, io.EOF) {
+			err = stream.params.client.handleError(stream.params.handle, *stream.params.headers, *stream.params.trailers, err)
+		}
+
+		atomic.StoreInt32(stream.closed, 1)
+		atomic.StoreInt32(stream.finished, 1)
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// received records the last event returned by Recv.
+func (stream *ReadStream) received(event *ResolvedEvent) {
+	original := event.OriginalEvent()
+	if original == nil {
+		return
+	}
+
+	stream.lastLock.Lock()
+	defer stream.lastLock.Unlock()
+
+	stream.hasLast = true
+	stream.lastRevision = original.EventNumber
+	stream.lastPosition = original.Position
+}
+
+// LastPosition returns the $all position of the last event returned by Recv, which is the position of the link for
+// resolved links. It stays available once the stream is closed or exhausted, and is false before any event.
+func (stream *ReadStream) LastPosition() (Position, bool) {
+	stream.lastLock.Lock()
+	defer stream.lastLock.Unlock()
+
+	return stream.lastPosition, stream.hasLast
+}
+
+// LastStreamRevision returns the revision of the last event returned by Recv in the stream it was read from. It
+// stays available once the stream is closed or exhausted, and is false before any event.
+func (stream *ReadStream) LastStreamRevision() (uint64, bool) {
+	stream.lastLock.Lock()
+	defer stream.lastLock.Unlock()
+
+	return stream.lastRevision, stream.hasLast
+}
+
+// ResponseMetadata returns the gRPC headers and trailers of the read. They are only available once Recv returned
+// io.EOF or an error, and are empty before that.
+func (stream *ReadStream) ResponseMetadata() *ResponseMetadata {
+	if atomic.LoadInt32(stream.finished) == 0 {
+		return &ResponseMetadata{}
+	}
+
+	return newResponseMetadata(*stream.params.headers, *stream.params.trailers)
+}
+
+// reopen retries the read if its policy allows it and no event was received yet, or from the last event received when
+// the read resumes on reconnection. It returns false when the read should fail with err.
+func (stream *ReadStream) reopen(err error) bool {
+	retry := stream.retry
+	if retry == nil || retry.policy == nil || (retry.received && !retry.resume) || retry.attempt >= retry.policy.MaxAttempts || !retry.policy.retryable(err) {
+		return false
+	}
+
+	if retry.received && retry.duplicate == nil {
+		stream.lastLock.Lock()
+		last := RecordedEvent{EventNumber: stream.lastRevision, Position: stream.lastPosition}
+		stream.lastLock.Unlock()
+
+		request, duplicate := resumeReadRequest(retry.request, last, retry.count-retry.delivered)
+		retry.request = request
+		if duplicate {
+			retry.duplicate = &last
+		}
+	}
+
+	stream.params.client.logger.warn("read failed on attempt %d, retrying: %v", retry.attempt, err)
+	if retry.policy.wait(retry.ctx, retry.attempt) != nil {
+		return false
+	}
+
+	retry.attempt++
+	reopened, openErr := retry.open()
+	if openErr != nil {
+		return stream.reopen(openErr)
+	}
+
+	stream.replace(reopened.params)
+	return true
+}
+
+// reopenUncompressed reopens a read whose compression was rejected before any event was received. The compression
+// is disabled client-wide by then, so the new call isn't compressed.
+func (stream *ReadStream) reopenUncompressed() bool {
+	if stream.retry == nil || stream.retry.received {
+		return false
+	}
+
+	reopened, err := stream.retry.open()
+	if err != nil {
+		return false
+	}
+
+	stream.replace(reopened.params)
+	return true
+}
+
+// replace swaps the underlying call of the stream, cancelling the previous one.
+func (stream *ReadStream) replace(params readStreamParams) {
+	stream.lock.Lock()
+	defer stream.lock.Unlock()
+	stream.params.cancel()
+	stream.params = params
+
+	if atomic.LoadInt32(stream.closed) != 0 {
+		stream.params.cancel()
+	}
+
+	stream.wrapCall()
+}
+
+// wrapCall applies the idle timeout of the stream to its current call, and makes it receive its messages in the
+// background if prefetching is enabled. The prefetching goroutine waiting on the server is what the timeout bounds.
+func (stream *ReadStream) wrapCall() {
+	if stream.idleTimeout > 0 {
+		stream.params.inner = newIdleReadClient(stream.params.inner, stream.idleTimeout, stream.params.cancel)
+	}
+
+	if stream.prefetch > 0 {
+		stream.params.inner = newPrefetchReadClient(stream.params.inner, stream.prefetch, nil)
+	}
+}
+
 func newReadStream(params readStreamParams) *ReadStream {
 	once := new(sync.Once)
 	closed := new(int32)
@@ -71,8 +313,143 @@ func newReadStream(params readStreamParams) *ReadStream {
 	atomic.StoreInt32(closed, 0)
 
 	return &ReadStream{
-		once:   once,
-		closed: closed,
-		params: params,
+		once:     once,
+		closed:   closed,
+		done:     make(chan struct{}),
+		finished: new(int32),
+		lock:     new(sync.Mutex),
+		lastLock: new(sync.Mutex),
+		params:   params,
+	}
+}
+
+// ForEach calls fn for every event of the stream until the stream is exhausted, fn returns an error or the context
+// gets cancelled. Reaching the end of the stream isn't considered an error. The stream is closed when ForEach returns.
+func (stream *ReadStream) ForEach(ctx context.Context, fn func(*ResolvedEvent) error) error {
+	defer stream.Close()
+
+	stop := closeOnDone(ctx, stream.Close)
+	defer stop()
+
+	var count int
+	for {
+		event, err := stream.Recv()
+
+		if ctx.Err() != nil {
+			return fmt.Errorf("read stopped after %d events: %w", count, ctx.Err())
+		}
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("read failed after %d events: %w", count, err)
+		}
+
+		if err = fn(event); err != nil {
+			return fmt.Errorf("processing event %d of stream '%s' failed: %w", event.OriginalEvent().EventNumber, event.OriginalEvent().StreamID, err)
+		}
+
+		count++
+	}
+}
+
+// ErrEventNotFound is wrapped in the ErrorEventNotFound error returned by Client.ReadEvent when the requested revision
+// doesn't exist.
+var ErrEventNotFound = errors.New("event not found")
+
+// ErrReadLimitExceeded is wrapped in the ErrorReadLimitExceeded error returned by Collect and Client.ReadStreamAll
+// when a read holds more events than allowed.
+var ErrReadLimitExceeded = errors.New("read holds more events than allowed")
+
+// Collect reads the remaining events of the stream into a slice and closes the stream. Reading more than max events
+// fails with ErrorReadLimitExceeded, which guards against loading an unexpectedly large stream in memory. A stream
+// which doesn't exist fails with ErrorResourceNotFound.
+func (stream *ReadStream) Collect(max int) ([]ResolvedEvent, error) {
+	defer stream.Close()
+
+	var events []ResolvedEvent
+	for {
+		event, err := stream.Recv()
+
+		if errors.Is(err, io.EOF) {
+			return events, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(events) == max {
+			return nil, &Error{code: ErrorReadLimitExceeded, err: fmt.Errorf("%w: limit is %d", ErrReadLimitExceeded, max)}
+		}
+
+		if stream.buffers != nil {
+			events = append(events, event.Clone())
+		} else {
+			events = append(events, *event)
+		}
+	}
+}
+
+// ReadResult is an item received from the channel returned by ReadStream.Chan. Exactly one of Event and Err is set.
+type ReadResult struct {
+	Event *ResolvedEvent
+	Err   error
+}
+
+// Chan consumes the stream in a background goroutine and returns a channel receiving its events. The channel is
+// closed at the end of the stream, right after delivering the error if the read failed. Closing the stream stops the
+// goroutine, so a caller giving up on the channel early must call Close.
+func (stream *ReadStream) Chan() <-chan ReadResult {
+	results := make(chan ReadResult)
+
+	go func() {
+		defer close(results)
+
+		for {
+			event, err := stream.Recv()
+
+			if errors.Is(err, io.EOF) {
+				return
+			}
+
+			// The receiver reads the event concurrently with the next Recv, which would overwrite reused buffers.
+			if event != nil && stream.buffers != nil {
+				clone := event.Clone()
+				event = &clone
+			}
+
+			select {
+			case results <- ReadResult{Event: event, Err: err}:
+			case <-stream.done:
+				return
+			}
+
+			if err != nil {
+				stream.Close()
+				return
+			}
+		}
+	}()
+
+	return results
+}
+
+// closeOnDone calls closeFn if the context gets cancelled before the returned stop function is called.
+func closeOnDone(ctx context.Context, closeFn func()) func() {
+	done := make(chan struct{})
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			closeFn()
+		case <-done:
+		}
+	}()
+
+	return func() {
+		close(done)
 	}
 }
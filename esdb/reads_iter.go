@@ -0,0 +1,35 @@
+//go:build go1.23
+
+package esdb
+
+import (
+	"errors"
+	"io"
+	"iter"
+)
+
+// Events returns an iterator over the events of the stream, to be used with a range loop. The iteration ends with the
+// stream, after yielding the error if the read failed. The stream is closed once the loop exits, including on break.
+// Events is only compiled with Go 1.23 or later, older toolchains have to use Recv.
+func (stream *ReadStream) Events() iter.Seq2[*ResolvedEvent, error] {
+	return func(yield func(*ResolvedEvent, error) bool) {
+		defer stream.Close()
+
+		for {
+			event, err := stream.Recv()
+
+			if errors.Is(err, io.EOF) {
+				return
+			}
+
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			if !yield(event, nil) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,31 @@
+//go:build go1.23
+
+package esdb
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadStreamEvents(t *testing.T) {
+	var count int
+	for event, err := range newFakeReadStream(3).Events() {
+		assert.NoError(t, err)
+		assert.NotNil(t, event)
+		count++
+	}
+
+	assert.Equal(t, 3, count)
+}
+
+func TestReadStreamEventsClosesOnBreak(t *testing.T) {
+	stream := newFakeReadStream(10)
+	for range stream.Events() {
+		break
+	}
+
+	_, err := stream.Recv()
+	assert.ErrorIs(t, err, io.EOF)
+}
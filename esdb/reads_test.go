@@ -0,0 +1,129 @@
+package esdb
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	api "github.com/EventStore/EventStore-Client-Go/v2/protos/streams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// fakeReadClient replays a fixed number of empty events, then io.EOF.
+type fakeReadClient struct {
+	grpc.ClientStream
+	ctx       context.Context
+	remaining int
+}
+
+func (c *fakeReadClient) Context() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+
+	return c.ctx
+}
+
+func (c *fakeReadClient) Recv() (*api.ReadResp, error) {
+	if c.remaining == 0 {
+		return nil, io.EOF
+	}
+
+	c.remaining--
+	return &api.ReadResp{Content: &api.ReadResp_Event{Event: &api.ReadResp_ReadEvent{}}}, nil
+}
+
+func newFakeReadStream(count int) *ReadStream {
+	return newReadStream(readStreamParams{
+		cancel: func() {},
+		inner:  &fakeReadClient{remaining: count},
+	})
+}
+
+func TestReadStreamChan(t *testing.T) {
+	var count int
+	for result := range newFakeReadStream(3).Chan() {
+		assert.NoError(t, result.Err)
+		assert.NotNil(t, result.Event)
+		count++
+	}
+
+	assert.Equal(t, 3, count)
+}
+
+func TestReadStreamChanStopsOnClose(t *testing.T) {
+	stream := newFakeReadStream(100)
+	results := stream.Chan()
+
+	<-results
+	stream.Close()
+
+	// The goroutine must not stay blocked on the channel once the stream is closed.
+	for range results {
+	}
+
+	_, err := stream.Recv()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestReadStreamCollect(t *testing.T) {
+	events, err := newFakeReadStream(3).Collect(3)
+	assert.NoError(t, err)
+	assert.Len(t, events, 3)
+
+	_, err = newFakeReadStream(4).Collect(3)
+	assert.ErrorIs(t, err, ErrReadLimitExceeded)
+	var esdbErr *Error
+	require.ErrorAs(t, err, &esdbErr)
+	assert.Equal(t, ErrorReadLimitExceeded, esdbErr.Code())
+}
+
+func TestReadStreamLastPosition(t *testing.T) {
+	event := testReadEvent(7)
+	event.Event.CommitPosition = 700
+	event.Event.PreparePosition = 690
+
+	stream := newReadStream(readStreamParams{
+		cancel: func() {},
+		inner:  &scriptedReadClient{responses: []*api.ReadResp{{Content: &api.ReadResp_Event{Event: event}}}},
+	})
+
+	_, ok := stream.LastPosition()
+	assert.False(t, ok)
+
+	_, err := stream.Recv()
+	assert.NoError(t, err)
+	stream.Close()
+
+	revision, ok := stream.LastStreamRevision()
+	assert.True(t, ok)
+	assert.Equal(t, uint64(7), revision)
+
+	position, ok := stream.LastPosition()
+	assert.True(t, ok)
+	assert.Equal(t, Position{Commit: 700, Prepare: 690}, position)
+}
+
+func TestReadStreamRecvRaw(t *testing.T) {
+	stream := newReadStream(readStreamParams{
+		cancel: func() {},
+		inner:  &scriptedReadClient{responses: liveTransitionResponses()},
+	})
+
+	var kinds []interface{}
+	for {
+		msg, err := stream.RecvRaw()
+		if err == io.EOF {
+			break
+		}
+
+		require.NoError(t, err)
+		kinds = append(kinds, msg.Content)
+	}
+
+	require.Len(t, kinds, 4)
+	assert.IsType(t, &api.ReadResp_CaughtUp_{}, kinds[1])
+	assert.IsType(t, &api.ReadResp_FellBehind_{}, kinds[2])
+}
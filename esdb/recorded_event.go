@@ -1,6 +1,7 @@
 package esdb
 
 import (
+	"encoding/json"
 	"time"
 
 	uuid "github.com/gofrs/uuid"
@@ -8,14 +9,83 @@ import (
 
 // RecordedEvent ...
 type RecordedEvent struct {
-	EventID        uuid.UUID
-	EventType      string
-	ContentType    string
-	StreamID       string
-	EventNumber    uint64
-	Position       Position
+	EventID     uuid.UUID
+	EventType   string
+	ContentType string
+	StreamID    string
+	EventNumber uint64
+	Position    Position
+	// When the server wrote the event, in UTC.
 	CreatedDate    time.Time
 	Data           []byte
 	SystemMetadata map[string]string
 	UserMetadata   []byte
 }
+
+// wellKnownMetadata holds the metadata properties EventStoreDB and its projections give a meaning to.
+type wellKnownMetadata struct {
+	CorrelationID string `json:"$correlationId"`
+	CausationID   string `json:"$causationId"`
+}
+
+// CorrelationID returns the $correlationId property of the user metadata, if the metadata is a JSON object holding
+// it.
+func (event *RecordedEvent) CorrelationID() (string, bool) {
+	metadata := event.wellKnownMetadata()
+	return metadata.CorrelationID, metadata.CorrelationID != ""
+}
+
+// CausationID returns the $causationId property of the user metadata, if the metadata is a JSON object holding it.
+func (event *RecordedEvent) CausationID() (string, bool) {
+	metadata := event.wellKnownMetadata()
+	return metadata.CausationID, metadata.CausationID != ""
+}
+
+// IsJSON tells whether the event data is JSON.
+func (event *RecordedEvent) IsJSON() bool {
+	return event.ContentType == "application/json"
+}
+
+// CreatedIn returns the time the event was written at, in the given location.
+func (event *RecordedEvent) CreatedIn(location *time.Location) time.Time {
+	return event.CreatedDate.In(location)
+}
+
+func (event *RecordedEvent) wellKnownMetadata() wellKnownMetadata {
+	var metadata wellKnownMetadata
+	if len(event.UserMetadata) > 0 {
+		// Metadata which isn't a JSON object, or whose properties aren't strings, has none of the properties.
+		if json.Unmarshal(event.UserMetadata, &metadata) != nil {
+			return wellKnownMetadata{}
+		}
+	}
+
+	return metadata
+}
+
+func (event *RecordedEvent) clone() *RecordedEvent {
+	if event == nil {
+		return nil
+	}
+
+	clone := *event
+	clone.Data = cloneBytes(event.Data)
+	clone.UserMetadata = cloneBytes(event.UserMetadata)
+
+	if event.SystemMetadata != nil {
+		clone.SystemMetadata = make(map[string]string, len(event.SystemMetadata))
+		for key, value := range event.SystemMetadata {
+			clone.SystemMetadata[key] = value
+		}
+	}
+
+	return &clone
+}
+
+func cloneBytes(value []byte) []byte {
+	if value == nil {
+		return nil
+	}
+
+	return append(make([]byte, 0, len(value)), value...)
+}
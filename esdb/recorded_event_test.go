@@ -0,0 +1,36 @@
+package esdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordedEventWellKnownMetadata(t *testing.T) {
+	event := &RecordedEvent{UserMetadata: []byte(`{"$correlationId":"order-42","$causationId":"cmd-7","tenant":"acme"}`)}
+
+	correlationID, ok := event.CorrelationID()
+	assert.True(t, ok)
+	assert.Equal(t, "order-42", correlationID)
+
+	causationID, ok := event.CausationID()
+	assert.True(t, ok)
+	assert.Equal(t, "cmd-7", causationID)
+
+	for _, metadata := range []string{"", "not json", `{"$correlationId":42}`, `{"tenant":"acme"}`} {
+		event = &RecordedEvent{UserMetadata: []byte(metadata)}
+		_, ok = event.CorrelationID()
+		assert.False(t, ok, metadata)
+	}
+}
+
+func TestRecordedEventCreatedIn(t *testing.T) {
+	location := time.FixedZone("UTC+2", 2*60*60)
+	event := &RecordedEvent{CreatedDate: time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC), ContentType: "application/json"}
+
+	created := event.CreatedIn(location)
+	assert.Equal(t, 12, created.Hour())
+	assert.True(t, created.Equal(event.CreatedDate))
+	assert.True(t, event.IsJSON())
+}
@@ -0,0 +1,172 @@
+package esdb
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// RenameStreamResult describes the outcome of a RenameStream call.
+type RenameStreamResult struct {
+	// Number of events in the source stream.
+	Events uint64
+	// SHA-256 fingerprint of the ids, types, data and metadata of the source stream events.
+	Digest []byte
+	// Result of deleting the source stream, nil on dry runs.
+	DeleteResult *DeleteResult
+}
+
+// RenameStream copies every event of the source stream to a destination stream that must not exist yet, verifies
+// both streams hold the same events, then deletes the source stream. The source stream is deleted with the revision
+// that was copied as the expected revision, so events appended to it during the rename make the call fail rather
+// than being lost. The copy is appended expecting the destination stream not to exist, so a concurrent writer to it
+// makes the call fail. A copy which doesn't match the source stream is deleted, while a copy interrupted by a failure
+// remains and must be deleted before retrying.
+func (client *Client) RenameStream(
+	ctx context.Context,
+	sourceStreamID string,
+	destinationStreamID string,
+	opts RenameStreamOptions,
+) (*RenameStreamResult, error) {
+	_, err := client.readStreamHeadRevision(ctx, destinationStreamID, opts.Authenticated, opts.Deadline)
+
+	if err == nil {
+		return nil, &Error{code: ErrorResourceAlreadyExists, err: fmt.Errorf("destination stream '%s' already exists", destinationStreamID)}
+	}
+
+	if esdbErr, ok := FromError(err); !ok && esdbErr.Code() != ErrorResourceNotFound {
+		return nil, err
+	}
+
+	if opts.DryRun {
+		count, digest, err := client.streamDigest(ctx, sourceStreamID, opts.Authenticated, opts.Deadline)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &RenameStreamResult{Events: count, Digest: digest}, nil
+	}
+
+	progress, err := client.CopyStream(ctx, sourceStreamID, destinationStreamID, CopyStreamOptions{
+		ExpectedRevision: NoStream{},
+		BatchSize:        opts.BatchSize,
+		Authenticated:    opts.Authenticated,
+		Deadline:         opts.Deadline,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if progress.Read == 0 {
+		return nil, &Error{code: ErrorResourceNotFound, err: fmt.Errorf("source stream '%s' is empty", sourceStreamID)}
+	}
+
+	count, digest, err := client.streamDigest(ctx, sourceStreamID, opts.Authenticated, opts.Deadline)
+
+	if err != nil {
+		return nil, err
+	}
+
+	destinationCount, destinationDigest, err := client.streamDigest(ctx, destinationStreamID, opts.Authenticated, opts.Deadline)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if count != destinationCount || !bytes.Equal(digest, destinationDigest) {
+		mismatch := fmt.Errorf("stream '%s' doesn't match its copy '%s', source stream left untouched", sourceStreamID, destinationStreamID)
+		if progress.LastWrite == nil {
+			return nil, &Error{code: ErrorInternalClient, err: mismatch}
+		}
+
+		_, err := client.DeleteStream(ctx, destinationStreamID, DeleteStreamOptions{
+			ExpectedRevision: progress.LastWrite.NextExpectedRevision,
+			Authenticated:    opts.Authenticated,
+			Deadline:         opts.Deadline,
+		})
+
+		if err != nil {
+			mismatch = fmt.Errorf("%v, deleting the copy failed: %w", mismatch, err)
+		}
+
+		return nil, &Error{code: ErrorInternalClient, err: mismatch}
+	}
+
+	result := &RenameStreamResult{Events: count, Digest: digest}
+	expected := Revision(progress.NextRevision.Value - 1)
+
+	if opts.Tombstone {
+		result.DeleteResult, err = client.TombstoneStream(ctx, sourceStreamID, TombstoneStreamOptions{
+			ExpectedRevision: expected,
+			Authenticated:    opts.Authenticated,
+			Deadline:         opts.Deadline,
+		})
+	} else {
+		result.DeleteResult, err = client.DeleteStream(ctx, sourceStreamID, DeleteStreamOptions{
+			ExpectedRevision: expected,
+			Authenticated:    opts.Authenticated,
+			Deadline:         opts.Deadline,
+		})
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (client *Client) streamDigest(
+	ctx context.Context,
+	streamID string,
+	credentials *Credentials,
+	deadline *time.Duration,
+) (uint64, []byte, error) {
+	stream, err := client.ReadStream(ctx, streamID, ReadStreamOptions{
+		Authenticated: credentials,
+		Deadline:      deadline,
+	}, ^uint64(0))
+
+	if err != nil {
+		return 0, nil, err
+	}
+
+	defer stream.Close()
+
+	hash := sha256.New()
+	var count uint64
+
+	writeField := func(field []byte) {
+		var size [8]byte
+		binary.BigEndian.PutUint64(size[:], uint64(len(field)))
+		hash.Write(size[:])
+		hash.Write(field)
+	}
+
+	for {
+		event, err := stream.Recv()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return 0, nil, err
+		}
+
+		count++
+		writeField(event.Event.EventID.Bytes())
+		writeField([]byte(event.Event.EventType))
+		writeField(event.Event.Data)
+		writeField(event.Event.UserMetadata)
+	}
+
+	return count, hash.Sum(nil), nil
+}
@@ -0,0 +1,14 @@
+package esdb
+
+import "time"
+
+type RenameStreamOptions struct {
+	// Only reads and fingerprints the source stream, nothing gets written or deleted.
+	DryRun bool
+	// Tombstones the source stream instead of soft-deleting it. A tombstoned stream name can never be reused.
+	Tombstone bool
+	// How many events are read and appended at a time.
+	BatchSize     uint64 // Defaults to 500.
+	Authenticated *Credentials
+	Deadline      *time.Duration
+}
@@ -1,7 +1,10 @@
 package esdb
 
 type ResolvedEvent struct {
-	Link   *RecordedEvent
+	// The link event itself, with its own metadata, when the read resolved a link. Nil for regular events.
+	Link *RecordedEvent
+	// The event, or the event the link points to when it got resolved. Nil for broken links, whose target was
+	// deleted or is otherwise unreadable.
 	Event  *RecordedEvent
 	Commit *uint64
 }
@@ -13,3 +16,40 @@ func (resolved ResolvedEvent) OriginalEvent() *RecordedEvent {
 
 	return resolved.Event
 }
+
+// IsBrokenLink tells whether the event is a link whose target couldn't be resolved. Such events are returned like any
+// other instead of failing the read, with only Link set.
+func (resolved ResolvedEvent) IsBrokenLink() bool {
+	return resolved.Link != nil && resolved.Event == nil
+}
+
+// LinkStreamID returns the stream the link lives in, or an empty string when the event isn't a resolved link.
+func (resolved ResolvedEvent) LinkStreamID() string {
+	if resolved.Link == nil {
+		return ""
+	}
+
+	return resolved.Link.StreamID
+}
+
+// OriginalStreamRevision returns the revision of the event in the stream it was read from, which is the revision of
+// the link when the event is a resolved link.
+func (resolved ResolvedEvent) OriginalStreamRevision() uint64 {
+	return resolved.OriginalEvent().EventNumber
+}
+
+// Clone returns a deep copy of the event, sharing no memory with it. Events of a read using ReuseBuffers must be
+// cloned to outlive the next call to Recv.
+func (resolved ResolvedEvent) Clone() ResolvedEvent {
+	clone := ResolvedEvent{
+		Event: resolved.Event.clone(),
+		Link:  resolved.Link.clone(),
+	}
+
+	if resolved.Commit != nil {
+		commit := *resolved.Commit
+		clone.Commit = &commit
+	}
+
+	return clone
+}
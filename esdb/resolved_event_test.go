@@ -0,0 +1,26 @@
+package esdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolvedEventLinkHelpers(t *testing.T) {
+	event := &RecordedEvent{StreamID: "account-1", EventNumber: 4}
+	link := &RecordedEvent{StreamID: "$ce-account", EventNumber: 12}
+
+	regular := ResolvedEvent{Event: event}
+	assert.False(t, regular.IsBrokenLink())
+	assert.Equal(t, "", regular.LinkStreamID())
+	assert.Equal(t, uint64(4), regular.OriginalStreamRevision())
+
+	resolved := ResolvedEvent{Event: event, Link: link}
+	assert.False(t, resolved.IsBrokenLink())
+	assert.Equal(t, "$ce-account", resolved.LinkStreamID())
+	assert.Equal(t, uint64(12), resolved.OriginalStreamRevision())
+
+	broken := ResolvedEvent{Link: link}
+	assert.True(t, broken.IsBrokenLink())
+	assert.Equal(t, uint64(12), broken.OriginalStreamRevision())
+}
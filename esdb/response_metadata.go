@@ -0,0 +1,32 @@
+package esdb
+
+import (
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// ResponseMetadata holds the gRPC headers and trailers the server sent along with a response, such as the node
+// version or leader endpoint hints.
+type ResponseMetadata struct {
+	Headers  map[string][]string
+	Trailers map[string][]string
+}
+
+// Get returns the values of the key, looked up in the headers then in the trailers. Keys are case-insensitive.
+func (m *ResponseMetadata) Get(key string) []string {
+	key = strings.ToLower(key)
+
+	if values, ok := m.Headers[key]; ok {
+		return values
+	}
+
+	return m.Trailers[key]
+}
+
+func newResponseMetadata(headers metadata.MD, trailers metadata.MD) *ResponseMetadata {
+	return &ResponseMetadata{
+		Headers:  headers.Copy(),
+		Trailers: trailers.Copy(),
+	}
+}
@@ -0,0 +1,21 @@
+package esdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestResponseMetadataGet(t *testing.T) {
+	headers := metadata.Pairs("Content-Type", "application/grpc")
+	trailers := metadata.Pairs("exception", "not-leader")
+	m := newResponseMetadata(headers, trailers)
+
+	assert.Equal(t, []string{"application/grpc"}, m.Get("content-type"))
+	assert.Equal(t, []string{"not-leader"}, m.Get("Exception"))
+	assert.Nil(t, m.Get("missing"))
+
+	headers.Set("content-type", "changed")
+	assert.Equal(t, []string{"application/grpc"}, m.Get("content-type"), "metadata is copied")
+}
@@ -0,0 +1,77 @@
+package esdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// RestoreStream undoes a soft delete by writing back the stream metadata as it was before the stream got deleted.
+// Only the events that haven't been scavenged yet can be recovered, and tombstoned streams can't be restored at all.
+// Restoring a stream which isn't soft-deleted fails with ErrorInvalidArgument.
+func (client *Client) RestoreStream(
+	ctx context.Context,
+	streamID string,
+	opts RestoreStreamOptions,
+) (*WriteResult, error) {
+	stream, err := client.ReadStream(ctx, MetadataStreamName(streamID), ReadStreamOptions{
+		Direction:     Backwards,
+		From:          End{},
+		Authenticated: opts.Authenticated,
+		Deadline:      opts.Deadline,
+	}, 2)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer stream.Close()
+
+	var events []*ResolvedEvent
+	for {
+		event, err := stream.Recv()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("unexpected error when reading stream metadata: %w", err)
+		}
+
+		events = append(events, event)
+	}
+
+	if len(events) == 0 {
+		return nil, invalidArgumentError("stream '%s' is not soft-deleted", streamID)
+	}
+
+	latest, err := streamMetadataFromEvent(events[0])
+
+	if err != nil {
+		return nil, err
+	}
+
+	if tb := latest.TruncateBefore(); tb == nil || *tb < math.MaxInt64 {
+		return nil, invalidArgumentError("stream '%s' is not soft-deleted", streamID)
+	}
+
+	restored := latest
+	restored.ClearTruncateBefore()
+
+	if len(events) > 1 {
+		restored, err = streamMetadataFromEvent(events[1])
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return client.SetStreamMetadata(ctx, streamID, AppendToStreamOptions{
+		ExpectedRevision: Revision(events[0].OriginalEvent().EventNumber),
+		Authenticated:    opts.Authenticated,
+		Deadline:         opts.Deadline,
+	}, *restored)
+}
@@ -0,0 +1,8 @@
+package esdb
+
+import "time"
+
+type RestoreStreamOptions struct {
+	Authenticated *Credentials
+	Deadline      *time.Duration
+}
@@ -0,0 +1,156 @@
+package esdb
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// RetryPolicy configures the automatic retries of appends and reads failing with a transient error. Between
+// attempts, the client drops the failing connection and re-discovers the leader, so the next attempt goes to the
+// node currently accepting writes.
+type RetryPolicy struct {
+	// Maximum number of attempts, including the first one. Defaults to 3.
+	MaxAttempts int
+	// Delay before the first retry. Defaults to 100 milliseconds.
+	InitialBackoff time.Duration
+	// Upper bound of the delay between attempts. Defaults to 5 seconds.
+	MaxBackoff time.Duration
+	// Factor applied to the delay after every attempt. Defaults to 2.
+	Multiplier float64
+	// Fraction of the delay randomly added or removed, between 0 and 1. Defaults to 0.2.
+	Jitter float64
+	// Error codes worth retrying. ErrorUnknown covers transport failures such as UNAVAILABLE. Defaults to
+	// ErrorNotLeader and ErrorUnknown.
+	RetryableCodes []ErrorCode
+}
+
+// DefaultRetryPolicy returns a RetryPolicy with every setting at its default value.
+func DefaultRetryPolicy() *RetryPolicy {
+	policy := &RetryPolicy{}
+	policy.setDefaults()
+	return policy
+}
+
+func (p *RetryPolicy) setDefaults() {
+	if p.MaxAttempts == 0 {
+		p.MaxAttempts = 3
+	}
+
+	if p.InitialBackoff == 0 {
+		p.InitialBackoff = 100 * time.Millisecond
+	}
+
+	if p.MaxBackoff == 0 {
+		p.MaxBackoff = 5 * time.Second
+	}
+
+	if p.Multiplier == 0 {
+		p.Multiplier = 2
+	}
+
+	if p.Jitter == 0 {
+		p.Jitter = 0.2
+	}
+
+	if p.RetryableCodes == nil {
+		p.RetryableCodes = []ErrorCode{ErrorNotLeader, ErrorUnknown}
+	}
+}
+
+func (p *RetryPolicy) validate() error {
+	if p.MaxAttempts < 1 {
+		return invalidArgumentError("RetryPolicy.MaxAttempts must be at least 1, got %d", p.MaxAttempts)
+	}
+
+	if p.InitialBackoff < 0 || p.MaxBackoff < 0 {
+		return invalidArgumentError("RetryPolicy backoffs can't be negative")
+	}
+
+	if p.Multiplier < 1 {
+		return invalidArgumentError("RetryPolicy.Multiplier must be at least 1, got %v", p.Multiplier)
+	}
+
+	if p.Jitter < 0 || p.Jitter > 1 {
+		return invalidArgumentError("RetryPolicy.Jitter must be between 0 and 1, got %v", p.Jitter)
+	}
+
+	return nil
+}
+
+// backoff returns the delay to wait after the given failed attempt, starting at 1.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	delay := float64(p.InitialBackoff) * math.Pow(p.Multiplier, float64(attempt-1))
+	if delay > float64(p.MaxBackoff) {
+		delay = float64(p.MaxBackoff)
+	}
+
+	delay += delay * p.Jitter * (2*rand.Float64() - 1)
+	return time.Duration(delay)
+}
+
+func (p *RetryPolicy) retryable(err error) bool {
+	code := ErrorUnknown
+	var esdbErr *Error
+	if errors.As(err, &esdbErr) {
+		code = esdbErr.Code()
+	}
+
+	for _, retryable := range p.RetryableCodes {
+		if code == retryable {
+			return true
+		}
+	}
+
+	return false
+}
+
+// wait sleeps for the backoff of the given attempt, returning early with an error if the context is done.
+func (p *RetryPolicy) wait(ctx context.Context, attempt int) error {
+	timer := time.NewTimer(p.backoff(attempt))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// retryPolicy resolves the policy of a call, falling back to the client-wide one. It returns nil when retries are
+// disabled.
+func (client *Client) retryPolicy(override *RetryPolicy) (*RetryPolicy, error) {
+	policy := override
+	if policy == nil {
+		policy = client.Config.RetryPolicy
+	}
+
+	if policy == nil {
+		return nil, nil
+	}
+
+	resolved := *policy
+	resolved.setDefaults()
+	if err := resolved.validate(); err != nil {
+		return nil, err
+	}
+
+	if resolved.MaxAttempts == 1 {
+		return nil, nil
+	}
+
+	return &resolved, nil
+}
+
+// readRetryPolicy resolves the policy of a read. A read policy can't be combined with the gRPC retries of the
+// connection, which would retry every attempt of the client on its own.
+func (client *Client) readRetryPolicy(override *RetryPolicy) (*RetryPolicy, error) {
+	if override != nil && client.Config.grpcRetriesEnabled() {
+		return nil, invalidArgumentError("a read RetryPolicy can't be combined with EnableGrpcRetries, set Configuration.RetryPolicy instead")
+	}
+
+	return client.retryPolicy(override)
+}
@@ -0,0 +1,83 @@
+package esdb
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryPolicyDefaults(t *testing.T) {
+	policy := DefaultRetryPolicy()
+	assert.Equal(t, 3, policy.MaxAttempts)
+	assert.Equal(t, 100*time.Millisecond, policy.InitialBackoff)
+	assert.Equal(t, []ErrorCode{ErrorNotLeader, ErrorUnknown}, policy.RetryableCodes)
+	assert.NoError(t, policy.validate())
+
+	policy.Jitter = 2
+	assertInvalidArgument(t, policy.validate())
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := &RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second, Multiplier: 2, Jitter: 0.5}
+
+	for i := 0; i < 100; i++ {
+		first := policy.backoff(1)
+		assert.GreaterOrEqual(t, first, 50*time.Millisecond)
+		assert.LessOrEqual(t, first, 150*time.Millisecond)
+
+		capped := policy.backoff(10)
+		assert.GreaterOrEqual(t, capped, 500*time.Millisecond)
+		assert.LessOrEqual(t, capped, 1500*time.Millisecond)
+	}
+}
+
+func TestRetryPolicyRetryable(t *testing.T) {
+	policy := DefaultRetryPolicy()
+
+	assert.True(t, policy.retryable(&Error{code: ErrorNotLeader}))
+	assert.True(t, policy.retryable(fmt.Errorf("transport failure")))
+	assert.False(t, policy.retryable(fmt.Errorf("wrapped: %w", &Error{code: ErrorWrongExpectedVersion})))
+}
+
+func TestClientRetryPolicyResolution(t *testing.T) {
+	client := &Client{Config: &Configuration{}}
+
+	policy, err := client.retryPolicy(nil)
+	require.NoError(t, err)
+	assert.Nil(t, policy)
+
+	client.Config.RetryPolicy = &RetryPolicy{MaxAttempts: 5}
+	policy, err = client.retryPolicy(nil)
+	require.NoError(t, err)
+	assert.Equal(t, 5, policy.MaxAttempts)
+	assert.Equal(t, 0, len(client.Config.RetryPolicy.RetryableCodes))
+
+	policy, err = client.retryPolicy(&RetryPolicy{MaxAttempts: 1})
+	require.NoError(t, err)
+	assert.Nil(t, policy)
+
+	_, err = client.retryPolicy(&RetryPolicy{MaxAttempts: -1})
+	assertInvalidArgument(t, err)
+}
+
+func TestRetryLayersDontStack(t *testing.T) {
+	client := &Client{Config: &Configuration{EnableGrpcRetries: true}}
+	assert.True(t, client.Config.grpcRetriesEnabled())
+
+	_, err := client.readRetryPolicy(&RetryPolicy{MaxAttempts: 5})
+	assertInvalidArgument(t, err)
+
+	policy, err := client.readRetryPolicy(nil)
+	require.NoError(t, err)
+	assert.Nil(t, policy)
+
+	client.Config.RetryPolicy = &RetryPolicy{MaxAttempts: 5}
+	assert.False(t, client.Config.grpcRetriesEnabled())
+
+	policy, err = client.readRetryPolicy(&RetryPolicy{MaxAttempts: 2})
+	require.NoError(t, err)
+	assert.Equal(t, 2, policy.MaxAttempts)
+}
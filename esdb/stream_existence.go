@@ -0,0 +1,129 @@
+package esdb
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+// StreamExistence tells whether a stream exists and, when it doesn't, why.
+type StreamExistence int
+
+const (
+	// StreamExistenceFound means the stream has at least one readable event.
+	StreamExistenceFound StreamExistence = iota
+	// StreamExistenceNotFound means the stream has no readable event. It includes streams which never existed and
+	// streams whose events are all truncated or expired.
+	StreamExistenceNotFound
+	// StreamExistenceSoftDeleted means the stream got deleted with DeleteStream. It can be restored or written to
+	// again.
+	StreamExistenceSoftDeleted
+	// StreamExistenceTombstoned means the stream got deleted with TombstoneStream. It can't be written to anymore.
+	StreamExistenceTombstoned
+)
+
+func (s StreamExistence) String() string {
+	switch s {
+	case StreamExistenceFound:
+		return "found"
+	case StreamExistenceSoftDeleted:
+		return "soft-deleted"
+	case StreamExistenceTombstoned:
+		return "tombstoned"
+	default:
+		return "not found"
+	}
+}
+
+// StreamLastEvent is the outcome of GetStreamLastEvent.
+type StreamLastEvent struct {
+	Existence StreamExistence
+	// The last event of the stream, only set when Existence is StreamExistenceFound.
+	Event *ResolvedEvent
+}
+
+// GetStreamLastEvent reads the last event of a stream with a single backward read. Missing, soft-deleted and
+// tombstoned streams aren't errors but are told apart by the Existence of the result. Telling a soft-deleted stream
+// from a missing one takes an extra read of the stream metadata, which only happens when the stream has no event.
+func (client *Client) GetStreamLastEvent(
+	ctx context.Context,
+	streamID string,
+	opts GetStreamLastEventOptions,
+) (*StreamLastEvent, error) {
+	stream, err := client.ReadStream(ctx, streamID, ReadStreamOptions{
+		Direction:      Backwards,
+		From:           End{},
+		ResolveLinkTos: opts.ResolveLinkTos,
+		Authenticated:  opts.Authenticated,
+		Deadline:       opts.Deadline,
+	}, 1)
+
+	if err != nil {
+		return streamLastEventFromError(err)
+	}
+
+	defer stream.Close()
+	event, err := stream.Recv()
+
+	if errors.Is(err, io.EOF) {
+		return client.streamWithoutEvents(ctx, streamID, opts.Authenticated, opts.Deadline)
+	}
+
+	if err != nil {
+		var esdbErr *Error
+		if errors.As(err, &esdbErr) && esdbErr.Code() == ErrorResourceNotFound {
+			return client.streamWithoutEvents(ctx, streamID, opts.Authenticated, opts.Deadline)
+		}
+
+		return streamLastEventFromError(err)
+	}
+
+	return &StreamLastEvent{Existence: StreamExistenceFound, Event: event}, nil
+}
+
+// StreamExists tells whether a stream has at least one readable event and, when it doesn't, whether it was deleted.
+func (client *Client) StreamExists(
+	ctx context.Context,
+	streamID string,
+	opts StreamExistsOptions,
+) (StreamExistence, error) {
+	last, err := client.GetStreamLastEvent(ctx, streamID, GetStreamLastEventOptions{
+		Authenticated: opts.Authenticated,
+		Deadline:      opts.Deadline,
+	})
+
+	if err != nil {
+		return StreamExistenceNotFound, err
+	}
+
+	return last.Existence, nil
+}
+
+func streamLastEventFromError(err error) (*StreamLastEvent, error) {
+	if isStreamTombstoned(err) {
+		return &StreamLastEvent{Existence: StreamExistenceTombstoned}, nil
+	}
+
+	return nil, err
+}
+
+// streamWithoutEvents tells a soft-deleted stream from a missing one, see GetStreamDeletionStatus.
+func (client *Client) streamWithoutEvents(
+	ctx context.Context,
+	streamID string,
+	credentials *Credentials,
+	deadline *time.Duration,
+) (*StreamLastEvent, error) {
+	status, err := client.readSoftDeletionStatus(ctx, streamID, credentials, deadline)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status.State == StreamSoftDeleted {
+		return &StreamLastEvent{Existence: StreamExistenceSoftDeleted}, nil
+	}
+
+	return &StreamLastEvent{Existence: StreamExistenceNotFound}, nil
+}
@@ -0,0 +1,60 @@
+package esdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// GetStreamLength returns the number of readable events of a stream, using a backward read of its last event and a
+// read of its metadata. Events before the truncate before ($tb) revision and beyond the max count ($maxCount) aren't
+// counted. Events expired by a max age ($maxAge) are still counted, since telling them apart takes reading them. A
+// missing or soft-deleted stream has a length of 0, while a tombstoned stream fails with ErrorStreamDeleted.
+func (client *Client) GetStreamLength(
+	ctx context.Context,
+	streamID string,
+	opts GetStreamLengthOptions,
+) (uint64, error) {
+	last, err := client.readStreamHeadRevision(ctx, streamID, opts.Authenticated, opts.Deadline)
+	if err != nil {
+		var esdbErr *Error
+		if errors.As(err, &esdbErr) && esdbErr.Code() == ErrorResourceNotFound {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	meta, _, err := client.getStreamMetadataOrEmpty(ctx, streamID, ReadStreamOptions{
+		Direction:     Backwards,
+		From:          End{},
+		Authenticated: opts.Authenticated,
+		Deadline:      opts.Deadline,
+	})
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to read metadata of stream '%s': %w", streamID, err)
+	}
+
+	return readableEventCount(last, meta), nil
+}
+
+// readableEventCount returns the number of events of a stream whose last revision is last, once the truncate before
+// and max count of its metadata are applied.
+func readableEventCount(last uint64, meta *StreamMetadata) uint64 {
+	length := last + 1
+
+	if truncateBefore := meta.TruncateBefore(); truncateBefore != nil {
+		if *truncateBefore >= length {
+			return 0
+		}
+
+		length -= *truncateBefore
+	}
+
+	if maxCount := meta.MaxCount(); maxCount != nil && *maxCount < length {
+		length = *maxCount
+	}
+
+	return length
+}
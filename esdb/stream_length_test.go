@@ -0,0 +1,24 @@
+package esdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadableEventCount(t *testing.T) {
+	meta := &StreamMetadata{}
+	assert.Equal(t, uint64(10), readableEventCount(9, meta))
+
+	meta.SetTruncateBefore(4)
+	assert.Equal(t, uint64(6), readableEventCount(9, meta))
+
+	meta.SetMaxCount(3)
+	assert.Equal(t, uint64(3), readableEventCount(9, meta))
+
+	meta.SetMaxCount(8)
+	assert.Equal(t, uint64(6), readableEventCount(9, meta))
+
+	meta.SetTruncateBefore(12)
+	assert.Equal(t, uint64(0), readableEventCount(9, meta))
+}
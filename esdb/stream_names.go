@@ -0,0 +1,55 @@
+package esdb
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// MetadataEventType is the event type of the events written to a metadata stream.
+	MetadataEventType = "$metadata"
+	// StreamDeletedEventType is the event type written by the server when a stream gets deleted.
+	StreamDeletedEventType = "$streamDeleted"
+	// LinkEventType is the event type of link events, pointing to an event in another stream.
+	LinkEventType = "$>"
+)
+
+const (
+	systemStreamPrefix    = "$"
+	metadataStreamPrefix  = "$$"
+	categoryStreamPrefix  = "$ce-"
+	eventTypeStreamPrefix = "$et-"
+
+	persistentSubscriptionStreamPrefix = "$persistentsubscription-"
+)
+
+// IsSystemStream tells if the stream is a system stream, meaning its name starts with '$'.
+func IsSystemStream(streamID string) bool {
+	return strings.HasPrefix(streamID, systemStreamPrefix)
+}
+
+// IsMetadataStream tells if the stream is the metadata stream of another stream.
+func IsMetadataStream(streamID string) bool {
+	return strings.HasPrefix(streamID, metadataStreamPrefix)
+}
+
+// MetadataStreamName returns the name of the stream holding the metadata of the given stream.
+func MetadataStreamName(streamID string) string {
+	return metadataStreamPrefix + streamID
+}
+
+// CategoryStream returns the name of the stream maintained by the $by_category system projection.
+func CategoryStream(category string) string {
+	return categoryStreamPrefix + category
+}
+
+// EventTypeStream returns the name of the stream maintained by the $by_event_type system projection.
+func EventTypeStream(eventType string) string {
+	return eventTypeStreamPrefix + eventType
+}
+
+// ParkedMessagesStreamName returns the name of the stream holding the parked messages of a persistent subscription
+// group. Groups subscribing to $all use "$all" as stream name.
+func ParkedMessagesStreamName(streamName, groupName string) string {
+	return fmt.Sprintf("%s%s::%s-parked", persistentSubscriptionStreamPrefix, streamName, groupName)
+}
@@ -0,0 +1,23 @@
+package esdb_test
+
+import (
+	"testing"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamNameHelpers(t *testing.T) {
+	assert.True(t, esdb.IsSystemStream("$ce-account"))
+	assert.True(t, esdb.IsSystemStream("$$account-1"))
+	assert.False(t, esdb.IsSystemStream("account-1"))
+
+	assert.True(t, esdb.IsMetadataStream("$$account-1"))
+	assert.False(t, esdb.IsMetadataStream("$ce-account"))
+
+	assert.Equal(t, "$$account-1", esdb.MetadataStreamName("account-1"))
+	assert.Equal(t, "$ce-account", esdb.CategoryStream("account"))
+	assert.Equal(t, "$et-AccountOpened", esdb.EventTypeStream("AccountOpened"))
+	assert.Equal(t, "$persistentsubscription-account-1::billing-parked", esdb.ParkedMessagesStreamName("account-1", "billing"))
+	assert.Equal(t, "$persistentsubscription-$all::billing-parked", esdb.ParkedMessagesStreamName("$all", "billing"))
+}
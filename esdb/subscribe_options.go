@@ -1,6 +1,7 @@
 package esdb
 
 import (
+	"context"
 	"time"
 )
 
@@ -9,6 +10,35 @@ type SubscribeToStreamOptions struct {
 	ResolveLinkTos bool
 	Authenticated  *Credentials
 	Deadline       *time.Duration
+	// Drops the subscription when the server sends no message for that long, unlike Deadline which bounds the whole
+	// subscription. A subscription to a quiet stream receives nothing once caught up, so the timeout must exceed the
+	// expected gap between events. Defaults to 0, which disables it.
+	IdleTimeout time.Duration
+	// Only delivers the events matching the filter, on the event type or the stream ID depending on its type. The
+	// server only filters subscriptions to $all, so every event is still sent over the network and the filter is
+	// applied by the client. MaxSearchWindow and checkpoints don't apply.
+	Filter *SubscriptionFilter
+	// Reads the head of the stream when subscribing even if the server sends caught-up notifications, which
+	// Subscription.LiveReached then doesn't have to wait for. The head is always read from servers which don't send
+	// them. It costs an extra read.
+	DetectLive bool
+	// Called from a background goroutine when the subscription receives no message for StallThreshold, once per
+	// silence. It only reports the silence, IdleTimeout drops the subscription instead. A subscription to a quiet
+	// stream receives nothing once caught up, which is reported as well. Messages are then received one ahead of Recv
+	// by a background goroutine, so time spent processing them isn't mistaken for a silence of the server.
+	OnStalled func(sinceLastMessage time.Duration)
+	// Silence after which OnStalled is called. Defaults to 30 seconds when OnStalled is set.
+	StallThreshold time.Duration
+	// Called with the confirmation of the server before the subscription is returned, so subscriptions started with
+	// a handler can record where they start before the handler gets any event.
+	OnConfirmed func(SubscriptionConfirmation)
+	// Makes a subscription from End{} read the last event of the stream first and subscribe from it, so its
+	// confirmation reports where it starts. It costs an extra read before subscribing. Defaults to false, which
+	// subscribes from End{} as is and leaves the start unreported.
+	ResolveEnd bool
+	// Makes Subscription.Pause cancel the call to the server, which Resume reopens from the last event delivered,
+	// releasing the server resources of paused subscriptions.
+	ReleaseOnPause bool
 }
 
 func (o *SubscribeToStreamOptions) kind() operationKind {
@@ -27,6 +57,26 @@ func (o *SubscribeToStreamOptions) setDefaults() {
 	if o.From == nil {
 		o.From = End{}
 	}
+
+	if o.OnStalled != nil && o.StallThreshold == 0 {
+		o.StallThreshold = 30 * time.Second
+	}
+}
+
+func (o *SubscribeToStreamOptions) validate() error {
+	if err := validateIdleTimeout(o.IdleTimeout); err != nil {
+		return err
+	}
+
+	if o.StallThreshold < 0 {
+		return invalidArgumentError("StallThreshold can't be negative, got %v", o.StallThreshold)
+	}
+
+	if o.Filter != nil {
+		return validateSubscriptionFilter(o.Filter, NoMaxSearchWindow)
+	}
+
+	return nil
 }
 
 type SubscribeToAllOptions struct {
@@ -37,6 +87,42 @@ type SubscribeToAllOptions struct {
 	Filter             *SubscriptionFilter
 	Authenticated      *Credentials
 	Deadline           *time.Duration
+	// Drops the subscription when the server sends no message for that long, unlike Deadline which bounds the whole
+	// subscription. A subscription to a quiet stream receives nothing once caught up, so the timeout must exceed the
+	// expected gap between events. Defaults to 0, which disables it.
+	IdleTimeout time.Duration
+	// Called from Recv with every checkpoint of a filtered subscription, before the checkpoint is returned, so
+	// progress can be persisted during long gaps without matching events. Every event before the checkpoint has
+	// already been returned by Recv when it is called. Returning an error drops the subscription with
+	// SubscriptionDropHandlerError. It is called with the context the subscription was started with.
+	CheckpointReached func(ctx context.Context, position Position) error
+	// Reads the head of $all when subscribing even if the server sends caught-up notifications, which
+	// Subscription.LiveReached then doesn't have to wait for. The head is always read from servers which don't send
+	// them. It costs an extra read.
+	DetectLive bool
+	// Called from a background goroutine when the subscription receives no message for StallThreshold, once per
+	// silence. It only reports the silence, IdleTimeout drops the subscription instead. A subscription to a quiet
+	// stream receives nothing once caught up, which is reported as well. Messages are then received one ahead of Recv
+	// by a background goroutine, so time spent processing them isn't mistaken for a silence of the server.
+	OnStalled func(sinceLastMessage time.Duration)
+	// Silence after which OnStalled is called. Defaults to 30 seconds when OnStalled is set.
+	StallThreshold time.Duration
+	// Called with the confirmation of the server before the subscription is returned, so subscriptions started with
+	// a handler can record where they start before the handler gets any event.
+	OnConfirmed func(SubscriptionConfirmation)
+	// Makes a subscription from End{} read the last event of $all first and subscribe from it, so its confirmation
+	// reports where it starts. It costs an extra read before subscribing. Defaults to false, which subscribes from
+	// End{} as is and leaves the start unreported.
+	ResolveEnd bool
+	// Makes Subscription.Pause cancel the call to the server, which Resume reopens from the last event delivered,
+	// releasing the server resources of paused subscriptions.
+	ReleaseOnPause bool
+	// Override Configuration.InitialWindowSize and Configuration.ReadBufferSize. gRPC configures flow control per
+	// connection, so setting either makes the subscription use a dedicated client, discovering the node to connect
+	// to like the shared one, closed along with the subscription. Defaults to 0, which shares the connection of the
+	// client.
+	InitialWindowSize int32
+	ReadBufferSize    int
 }
 
 func (o *SubscribeToAllOptions) kind() operationKind {
@@ -56,6 +142,10 @@ func (o *SubscribeToAllOptions) setDefaults() {
 		o.From = End{}
 	}
 
+	if o.OnStalled != nil && o.StallThreshold == 0 {
+		o.StallThreshold = 30 * time.Second
+	}
+
 	if o.Filter != nil {
 		if o.MaxSearchWindow == 0 {
 			o.MaxSearchWindow = 32
@@ -66,3 +156,69 @@ func (o *SubscribeToAllOptions) setDefaults() {
 		}
 	}
 }
+
+func (o *SubscribeToAllOptions) validate() error {
+	if err := validateIdleTimeout(o.IdleTimeout); err != nil {
+		return err
+	}
+
+	if o.StallThreshold < 0 {
+		return invalidArgumentError("StallThreshold can't be negative, got %v", o.StallThreshold)
+	}
+
+	if o.InitialWindowSize < 0 {
+		return invalidArgumentError("InitialWindowSize can't be negative, got %d", o.InitialWindowSize)
+	}
+
+	if o.ReadBufferSize < 0 {
+		return invalidArgumentError("ReadBufferSize can't be negative, got %d", o.ReadBufferSize)
+	}
+
+	if o.Filter == nil {
+		return nil
+	}
+
+	if o.CheckpointInterval < 1 {
+		return invalidArgumentError("checkpoint interval must be strictly positive, got %d", o.CheckpointInterval)
+	}
+
+	return validateSubscriptionFilter(o.Filter, o.MaxSearchWindow)
+}
+
+func validateSubscriptionFilter(filter *SubscriptionFilter, maxSearchWindow int) error {
+	if len(filter.Prefixes) == 0 && len(filter.Regex) == 0 {
+		return invalidArgumentError("the subscription filter requires a set of prefixes or a regex")
+	}
+
+	if len(filter.Prefixes) > 0 && len(filter.Regex) > 0 {
+		return invalidArgumentError("the subscription filter may only contain a regex or a set of prefixes, but not both")
+	}
+
+	if maxSearchWindow < 1 && maxSearchWindow != NoMaxSearchWindow {
+		return invalidArgumentError("max search window must be strictly positive or NoMaxSearchWindow, got %d", maxSearchWindow)
+	}
+
+	return nil
+}
+
+// ReadAndSubscribeOptions configures ReadAndSubscribe.
+type ReadAndSubscribeOptions struct {
+	// First event delivered, either Start{} or a StreamRevision. Defaults to Start{}.
+	From           StreamPosition
+	ResolveLinkTos bool
+	Authenticated  *Credentials
+}
+
+func (o *ReadAndSubscribeOptions) setDefaults() {
+	if o.From == nil {
+		o.From = Start{}
+	}
+}
+
+func (o *ReadAndSubscribeOptions) validate() error {
+	if _, ok := o.From.(End); ok {
+		return invalidArgumentError("ReadAndSubscribe can't start from the end of the stream, use SubscribeToStream instead")
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package esdb
+
+import (
+	"context"
+	"errors"
+)
+
+// SubscriptionConfirmation describes a subscription as confirmed by the server.
+type SubscriptionConfirmation struct {
+	SubscriptionID string
+	// Revision of the event a subscription to a stream starts after. Nil when it starts from the beginning of the
+	// stream, from End{} without ResolveEnd, or for subscriptions to $all.
+	Revision *uint64
+	// Position of the event a subscription to $all starts after. Nil when it starts from the beginning of $all, from
+	// End{} without ResolveEnd, or for subscriptions to a stream.
+	Position *Position
+}
+
+// Confirmation returns the subscription ID and the start of the subscription. Subscriptions from End{} only report
+// where they start with ResolveEnd, which reads the end of the stream or $all first and subscribes from there.
+func (sub *Subscription) Confirmation() SubscriptionConfirmation {
+	return sub.confirmation
+}
+
+// resolveStreamStart turns End{} into the revision of the last event of the stream when ResolveEnd is set, which the
+// subscription starts from so its start can be confirmed. A stream without events is subscribed from its start.
+func (client *Client) resolveStreamStart(ctx context.Context, streamID string, opts *SubscribeToStreamOptions) (StreamPosition, error) {
+	if _, ok := opts.From.(End); !ok || !opts.ResolveEnd {
+		return opts.From, nil
+	}
+
+	return client.streamEnd(ctx, streamID, opts)
+}
+
+// streamEnd returns the revision of the last event of the stream, or Start{} when the stream has no event.
+func (client *Client) streamEnd(ctx context.Context, streamID string, opts *SubscribeToStreamOptions) (StreamPosition, error) {
+	head, err := client.readStreamHeadRevision(ctx, streamID, opts.Authenticated, opts.Deadline)
+	if err != nil {
+		var esdbErr *Error
+		if errors.As(err, &esdbErr) && esdbErr.Code() == ErrorResourceNotFound {
+			return Start{}, nil
+		}
+
+		return nil, err
+	}
+
+	return Revision(head), nil
+}
+
+// resolveAllStart is resolveStreamStart for $all.
+func (client *Client) resolveAllStart(ctx context.Context, opts *SubscribeToAllOptions) (AllPosition, error) {
+	if _, ok := opts.From.(End); !ok || !opts.ResolveEnd {
+		return opts.From, nil
+	}
+
+	return client.allEnd(ctx, opts)
+}
+
+// allEnd is streamEnd for $all.
+func (client *Client) allEnd(ctx context.Context, opts *SubscribeToAllOptions) (AllPosition, error) {
+	head, err := client.readAllHeadPosition(ctx, opts.Authenticated, opts.Deadline)
+	if err != nil {
+		return nil, err
+	}
+
+	if head == nil {
+		return Start{}, nil
+	}
+
+	return *head, nil
+}
+
+func streamConfirmation(id string, from StreamPosition) SubscriptionConfirmation {
+	confirmation := SubscriptionConfirmation{SubscriptionID: id}
+	if revision, ok := from.(StreamRevision); ok {
+		confirmation.Revision = &revision.Value
+	}
+
+	return confirmation
+}
+
+func allConfirmation(id string, from AllPosition) SubscriptionConfirmation {
+	confirmation := SubscriptionConfirmation{SubscriptionID: id}
+	if position, ok := from.(Position); ok {
+		confirmation.Position = &position
+	}
+
+	return confirmation
+}
@@ -0,0 +1,44 @@
+package esdb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscriptionConfirmation(t *testing.T) {
+	confirmation := streamConfirmation("id", Revision(41))
+	assert.Equal(t, "id", confirmation.SubscriptionID)
+	require.NotNil(t, confirmation.Revision)
+	assert.Equal(t, uint64(41), *confirmation.Revision)
+	assert.Nil(t, confirmation.Position)
+
+	assert.Nil(t, streamConfirmation("id", Start{}).Revision)
+
+	confirmation = allConfirmation("id", Position{Commit: 10, Prepare: 10})
+	require.NotNil(t, confirmation.Position)
+	assert.Equal(t, Position{Commit: 10, Prepare: 10}, *confirmation.Position)
+	assert.Nil(t, confirmation.Revision)
+}
+
+func TestResolveStartKeepsPositionsWithoutResolveEnd(t *testing.T) {
+	client := &Client{grpcClient: &grpcClient{logger: &logger{}}}
+
+	from, err := client.resolveStreamStart(context.Background(), "stream", &SubscribeToStreamOptions{From: Revision(3)})
+	require.NoError(t, err)
+	assert.Equal(t, Revision(3), from)
+
+	allFrom, err := client.resolveAllStart(context.Background(), &SubscribeToAllOptions{From: Start{}})
+	require.NoError(t, err)
+	assert.Equal(t, Start{}, allFrom)
+
+	from, err = client.resolveStreamStart(context.Background(), "stream", &SubscribeToStreamOptions{From: End{}})
+	require.NoError(t, err)
+	assert.Equal(t, End{}, from)
+
+	allFrom, err = client.resolveAllStart(context.Background(), &SubscribeToAllOptions{From: End{}})
+	require.NoError(t, err)
+	assert.Equal(t, End{}, allFrom)
+}
@@ -1,21 +1,192 @@
 package esdb
 
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// SubscriptionEvent is a message delivered by a subscription, exactly one of its fields being set. Kind tells which.
 type SubscriptionEvent struct {
 	EventAppeared       *ResolvedEvent
 	SubscriptionDropped *SubscriptionDropped
-	CheckPointReached   *Position
+	// Position up to which a filtered subscription to $all scanned events, without carrying an event.
+	CheckPointReached *Position
+	// Set when the subscription reached the end of the stream and now delivers events as they are written. Only sent
+	// by servers supporting it.
+	CaughtUp *SubscriptionCaughtUp
+	// Set when the subscription fell behind the live events and catches up by reading past ones again. Only sent by
+	// servers supporting it.
+	FellBehind *SubscriptionFellBehind
+}
+
+// SubscriptionEventKind tells which message a SubscriptionEvent carries.
+type SubscriptionEventKind int
+
+const (
+	// SubscriptionUnknownKind is the kind of a SubscriptionEvent without any field set.
+	SubscriptionUnknownKind SubscriptionEventKind = iota
+	SubscriptionEventAppearedKind
+	SubscriptionCheckpointReachedKind
+	SubscriptionCaughtUpKind
+	SubscriptionFellBehindKind
+	SubscriptionDroppedKind
+)
+
+func (k SubscriptionEventKind) String() string {
+	switch k {
+	case SubscriptionEventAppearedKind:
+		return "event appeared"
+	case SubscriptionCheckpointReachedKind:
+		return "checkpoint reached"
+	case SubscriptionCaughtUpKind:
+		return "caught up"
+	case SubscriptionFellBehindKind:
+		return "fell behind"
+	case SubscriptionDroppedKind:
+		return "dropped"
+	default:
+		return "unknown"
+	}
+}
+
+// Kind tells which message the event carries, so every kind can be handled with a switch.
+func (e *SubscriptionEvent) Kind() SubscriptionEventKind {
+	switch {
+	case e.EventAppeared != nil:
+		return SubscriptionEventAppearedKind
+	case e.CheckPointReached != nil:
+		return SubscriptionCheckpointReachedKind
+	case e.CaughtUp != nil:
+		return SubscriptionCaughtUpKind
+	case e.FellBehind != nil:
+		return SubscriptionFellBehindKind
+	case e.SubscriptionDropped != nil:
+		return SubscriptionDroppedKind
+	default:
+		return SubscriptionUnknownKind
+	}
+}
+
+// Event returns the event which appeared, if the message carries one.
+func (e *SubscriptionEvent) Event() (*ResolvedEvent, bool) {
+	return e.EventAppeared, e.EventAppeared != nil
+}
+
+// Checkpoint returns the position of a checkpoint, if the message is one.
+func (e *SubscriptionEvent) Checkpoint() (Position, bool) {
+	if e.CheckPointReached == nil {
+		return Position{}, false
+	}
+
+	return *e.CheckPointReached, true
+}
+
+// Dropped tells why the subscription dropped, if the message says it did.
+func (e *SubscriptionEvent) Dropped() (SubscriptionDropped, bool) {
+	if e.SubscriptionDropped == nil {
+		return SubscriptionDropped{}, false
+	}
+
+	return *e.SubscriptionDropped, true
 }
 
+type SubscriptionCaughtUp struct{}
+
+type SubscriptionFellBehind struct{}
+
 type PersistentSubscriptionEvent struct {
 	EventAppeared       *EventAppeared
 	SubscriptionDropped *SubscriptionDropped
 	CheckPointReached   *Position
+	// Set when a subscription with Reconnect set connected again to its group after losing its connection.
+	Reconnected *PersistentSubscriptionReconnected
 }
 type SubscriptionDropped struct {
-	Error error
+	Error  error
+	Reason SubscriptionDropReason
+}
+
+// SubscriptionDropReason tells why a subscription dropped.
+type SubscriptionDropReason int
+
+const (
+	// SubscriptionDropServerError means the subscription failed with an error from the server or the connection.
+	SubscriptionDropServerError SubscriptionDropReason = iota
+	// SubscriptionDropUnsubscribed means the subscription got closed, or the context it was started with cancelled.
+	SubscriptionDropUnsubscribed
+	// SubscriptionDropHandlerError means the handler of a subscription started with a handler, or the
+	// CheckpointReached callback of a subscription to $all, returned an error.
+	SubscriptionDropHandlerError
+	// SubscriptionDropAccessDenied means the credentials of the subscription don't allow reading the stream.
+	SubscriptionDropAccessDenied
+	// SubscriptionDropStreamDeleted means the stream subscribed to got deleted.
+	SubscriptionDropStreamDeleted
+	// SubscriptionDropServerUnavailable means the connection to the server got lost, when the node shuts down for
+	// instance. Subscribing again, possibly to another node, is expected to succeed.
+	SubscriptionDropServerUnavailable
+)
+
+func (r SubscriptionDropReason) String() string {
+	switch r {
+	case SubscriptionDropUnsubscribed:
+		return "unsubscribed"
+	case SubscriptionDropHandlerError:
+		return "handler error"
+	case SubscriptionDropAccessDenied:
+		return "access denied"
+	case SubscriptionDropStreamDeleted:
+		return "stream deleted"
+	case SubscriptionDropServerUnavailable:
+		return "server unavailable"
+	default:
+		return "server error"
+	}
 }
 
+// EventAppeared is an event delivered by a persistent subscription.
 type EventAppeared struct {
-	Event      *ResolvedEvent
+	Event *ResolvedEvent
+	// Number of times the server delivered the event before, after it got negatively acknowledged with Nack_Retry or
+	// timed out. Handlers can compare it to a budget to decide when to park the event. Always 0 with servers which
+	// don't report it, see RetryCountReported.
 	RetryCount int
+	// Tells whether the server reported RetryCount.
+	RetryCountReported bool
+	// Number of times the event got delivered again by PersistentSubscription.NackRetryAfter, which the server
+	// doesn't count in RetryCount.
+	Deferrals int
+	// ID of the persistent subscription which delivered the event, to acknowledge it.
+	SubscriptionID string
+}
+
+// Retried tells whether the event was delivered before.
+func (e *EventAppeared) Retried() bool {
+	return e.RetryCount > 0 || e.Deferrals > 0
+}
+
+// dropReason tells why a subscription failing with err dropped, using the exception the server reports in the
+// trailers when there is one. Closing a subscription or cancelling its context cancels its gRPC call.
+func dropReason(err error, trailers *metadata.MD) SubscriptionDropReason {
+	if trailers != nil {
+		if values := trailers.Get("exception"); len(values) > 0 {
+			switch values[0] {
+			case "stream-deleted":
+				return SubscriptionDropStreamDeleted
+			case "access-denied":
+				return SubscriptionDropAccessDenied
+			}
+		}
+	}
+
+	switch status.Code(err) {
+	case codes.Canceled:
+		return SubscriptionDropUnsubscribed
+	case codes.PermissionDenied, codes.Unauthenticated:
+		return SubscriptionDropAccessDenied
+	case codes.Unavailable:
+		return SubscriptionDropServerUnavailable
+	}
+
+	return SubscriptionDropServerError
 }
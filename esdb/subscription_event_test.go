@@ -0,0 +1,90 @@
+package esdb
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	api "github.com/EventStore/EventStore-Client-Go/v2/protos/streams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// scriptedReadClient replays the given responses, then io.EOF.
+type scriptedReadClient struct {
+	grpc.ClientStream
+	responses []*api.ReadResp
+}
+
+func (c *scriptedReadClient) Recv() (*api.ReadResp, error) {
+	if len(c.responses) == 0 {
+		return nil, io.EOF
+	}
+
+	response := c.responses[0]
+	c.responses = c.responses[1:]
+	return response, nil
+}
+
+func (c *scriptedReadClient) Context() context.Context {
+	return context.Background()
+}
+
+func liveTransitionResponses() []*api.ReadResp {
+	return []*api.ReadResp{
+		{Content: &api.ReadResp_Event{Event: &api.ReadResp_ReadEvent{}}},
+		{Content: &api.ReadResp_CaughtUp_{CaughtUp: &api.ReadResp_CaughtUp{}}},
+		{Content: &api.ReadResp_FellBehind_{FellBehind: &api.ReadResp_FellBehind{}}},
+		{Content: &api.ReadResp_Event{Event: &api.ReadResp_ReadEvent{}}},
+	}
+}
+
+func TestSubscriptionSurfacesLiveTransitions(t *testing.T) {
+	sub := NewSubscription(nil, func() {}, &scriptedReadClient{responses: liveTransitionResponses()}, "id")
+
+	assert.NotNil(t, sub.Recv().EventAppeared)
+	assert.NotNil(t, sub.Recv().CaughtUp)
+	assert.NotNil(t, sub.Recv().FellBehind)
+	assert.NotNil(t, sub.Recv().EventAppeared)
+}
+
+func TestReadStreamSkipsLiveTransitions(t *testing.T) {
+	stream := newReadStream(readStreamParams{
+		cancel: func() {},
+		inner:  &scriptedReadClient{responses: liveTransitionResponses()},
+	})
+
+	events, err := stream.Collect(10)
+	require.NoError(t, err)
+	assert.Len(t, events, 2)
+}
+
+func TestSubscriptionEventKind(t *testing.T) {
+	event := &SubscriptionEvent{EventAppeared: &ResolvedEvent{}}
+	assert.Equal(t, SubscriptionEventAppearedKind, event.Kind())
+	resolved, ok := event.Event()
+	assert.True(t, ok)
+	assert.Same(t, event.EventAppeared, resolved)
+	_, ok = event.Checkpoint()
+	assert.False(t, ok)
+
+	event = &SubscriptionEvent{CheckPointReached: &Position{Commit: 10, Prepare: 10}}
+	assert.Equal(t, SubscriptionCheckpointReachedKind, event.Kind())
+	position, ok := event.Checkpoint()
+	assert.True(t, ok)
+	assert.Equal(t, Position{Commit: 10, Prepare: 10}, position)
+	_, ok = event.Event()
+	assert.False(t, ok)
+
+	event = &SubscriptionEvent{SubscriptionDropped: &SubscriptionDropped{Reason: SubscriptionDropUnsubscribed}}
+	assert.Equal(t, SubscriptionDroppedKind, event.Kind())
+	dropped, ok := event.Dropped()
+	assert.True(t, ok)
+	assert.Equal(t, SubscriptionDropUnsubscribed, dropped.Reason)
+
+	assert.Equal(t, SubscriptionCaughtUpKind, (&SubscriptionEvent{CaughtUp: &SubscriptionCaughtUp{}}).Kind())
+	assert.Equal(t, SubscriptionFellBehindKind, (&SubscriptionEvent{FellBehind: &SubscriptionFellBehind{}}).Kind())
+	assert.Equal(t, SubscriptionUnknownKind, (&SubscriptionEvent{}).Kind())
+	assert.Equal(t, "checkpoint reached", SubscriptionCheckpointReachedKind.String())
+}
@@ -0,0 +1,83 @@
+package esdb
+
+import (
+	"regexp"
+	"strings"
+	"sync/atomic"
+)
+
+// eventFilter applies a SubscriptionFilter on the client, for subscriptions the server doesn't filter.
+type eventFilter struct {
+	// Kept first for the 64-bit alignment atomic operations require.
+	delivered  uint64
+	skipped    uint64
+	filterType FilterType
+	prefixes   []string
+	regex      *regexp.Regexp
+}
+
+func newEventFilter(filter *SubscriptionFilter) (*eventFilter, error) {
+	if err := validateSubscriptionFilter(filter, NoMaxSearchWindow); err != nil {
+		return nil, err
+	}
+
+	compiled := &eventFilter{filterType: filter.Type, prefixes: filter.Prefixes}
+	if filter.Regex != "" {
+		regex, err := regexp.Compile(filter.Regex)
+		if err != nil {
+			return nil, invalidArgumentError("invalid filter regex: %v", err)
+		}
+
+		compiled.regex = regex
+	}
+
+	return compiled, nil
+}
+
+// matches tells whether the event passes the filter, counting it either way. Resolved links are matched on the event
+// they point to.
+func (f *eventFilter) matches(event *ResolvedEvent) bool {
+	recorded := event.Event
+	if recorded == nil {
+		recorded = event.OriginalEvent()
+	}
+
+	value := recorded.EventType
+	if f.filterType == StreamFilterType {
+		value = recorded.StreamID
+	}
+
+	matched := f.regex != nil && f.regex.MatchString(value)
+	for _, prefix := range f.prefixes {
+		matched = matched || strings.HasPrefix(value, prefix)
+	}
+
+	if matched {
+		atomic.AddUint64(&f.delivered, 1)
+	} else {
+		atomic.AddUint64(&f.skipped, 1)
+	}
+
+	return matched
+}
+
+// FilterStats counts the events of a subscription filtered on the client.
+type FilterStats struct {
+	// Number of events matching the filter.
+	Delivered uint64
+	// Number of events received from the server but skipped by the filter.
+	Skipped uint64
+}
+
+// FilterStats returns the number of events delivered and skipped by the client-side filter of a subscription to a
+// stream. It is empty when the subscription has no filter.
+func (sub *Subscription) FilterStats() FilterStats {
+	if sub.filter == nil {
+		return FilterStats{}
+	}
+
+	return FilterStats{
+		Delivered: atomic.LoadUint64(&sub.filter.delivered),
+		Skipped:   atomic.LoadUint64(&sub.filter.skipped),
+	}
+}
@@ -0,0 +1,129 @@
+package esdb
+
+import (
+	"regexp"
+	"strings"
+)
+
+// SubscriptionFilterBuilder builds a SubscriptionFilter, validating it on the client. Start one with
+// FilterOnEventType or FilterOnStreamName.
+//
+// Regexes are checked with the regexp package, whose syntax is close to, but narrower than, the .NET one of the
+// server. Build a SubscriptionFilter directly to use constructs only the server supports, such as lookaheads.
+type SubscriptionFilterBuilder struct {
+	filterType         FilterType
+	prefixes           []string
+	regex              string
+	excludeSystem      bool
+	maxSearchWindow    int
+	checkpointInterval int
+}
+
+// FilterOnEventType starts a filter matching the type of the events.
+func FilterOnEventType() *SubscriptionFilterBuilder {
+	return &SubscriptionFilterBuilder{filterType: EventFilterType}
+}
+
+// FilterOnStreamName starts a filter matching the name of the stream of the events.
+func FilterOnStreamName() *SubscriptionFilterBuilder {
+	return &SubscriptionFilterBuilder{filterType: StreamFilterType}
+}
+
+// WithPrefixes matches the events whose event type or stream name starts with one of the prefixes.
+func (b *SubscriptionFilterBuilder) WithPrefixes(prefixes ...string) *SubscriptionFilterBuilder {
+	b.prefixes = append(b.prefixes, prefixes...)
+	return b
+}
+
+// WithRegex matches the events whose event type or stream name matches the regex.
+func (b *SubscriptionFilterBuilder) WithRegex(regex string) *SubscriptionFilterBuilder {
+	b.regex = regex
+	return b
+}
+
+// ExcludeSystemEvents skips the events whose event type or stream name starts with '$'. Without prefixes or regex,
+// every other event matches. It can't be combined with a regex, which must exclude them itself.
+func (b *SubscriptionFilterBuilder) ExcludeSystemEvents() *SubscriptionFilterBuilder {
+	b.excludeSystem = true
+	return b
+}
+
+// WithMaxSearchWindow sets the maximum number of events the server scans looking for a match before sending a
+// checkpoint. Defaults to 32.
+func (b *SubscriptionFilterBuilder) WithMaxSearchWindow(maxSearchWindow int) *SubscriptionFilterBuilder {
+	b.maxSearchWindow = maxSearchWindow
+	return b
+}
+
+// WithCheckpointInterval sets how many search windows the server scans between two checkpoints, which are sent every
+// MaxSearchWindow * CheckpointInterval events scanned. Defaults to 1.
+func (b *SubscriptionFilterBuilder) WithCheckpointInterval(checkpointInterval int) *SubscriptionFilterBuilder {
+	b.checkpointInterval = checkpointInterval
+	return b
+}
+
+// Build validates the filter and returns it.
+func (b *SubscriptionFilterBuilder) Build() (*SubscriptionFilter, error) {
+	if len(b.prefixes) > 0 && b.regex != "" {
+		return nil, invalidArgumentError("the subscription filter may only contain a regex or a set of prefixes, but not both")
+	}
+
+	for _, prefix := range b.prefixes {
+		if prefix == "" {
+			return nil, invalidArgumentError("empty prefixes match every event, use ExcludeSystemEvents or no filter instead")
+		}
+
+		if b.excludeSystem && strings.HasPrefix(prefix, "$") {
+			return nil, invalidArgumentError("prefix '%s' only matches the system events excluded by ExcludeSystemEvents", prefix)
+		}
+	}
+
+	if b.regex != "" {
+		if b.excludeSystem {
+			return nil, invalidArgumentError("ExcludeSystemEvents can't be combined with a regex, exclude system events in the regex instead")
+		}
+
+		if _, err := regexp.Compile(b.regex); err != nil {
+			return nil, invalidArgumentError("invalid subscription filter regex '%s': %v", b.regex, err)
+		}
+	}
+
+	filter := &SubscriptionFilter{
+		Type:     b.filterType,
+		Prefixes: b.prefixes,
+		Regex:    b.regex,
+	}
+
+	if b.excludeSystem && len(b.prefixes) == 0 {
+		filter.Regex = ExcludeSystemEventsFilter().Regex
+	}
+
+	if len(filter.Prefixes) == 0 && filter.Regex == "" {
+		return nil, invalidArgumentError("the subscription filter requires a set of prefixes, a regex or ExcludeSystemEvents")
+	}
+
+	return filter, nil
+}
+
+// ApplyTo builds the filter and sets it on the options, along with its search window and checkpoint interval.
+func (b *SubscriptionFilterBuilder) ApplyTo(opts *SubscribeToAllOptions) error {
+	filter, err := b.Build()
+	if err != nil {
+		return err
+	}
+
+	applied := *opts
+	applied.Filter = filter
+	applied.MaxSearchWindow = b.maxSearchWindow
+	applied.CheckpointInterval = b.checkpointInterval
+	applied.setDefaults()
+
+	if err := applied.validate(); err != nil {
+		return err
+	}
+
+	opts.Filter = applied.Filter
+	opts.MaxSearchWindow = applied.MaxSearchWindow
+	opts.CheckpointInterval = applied.CheckpointInterval
+	return nil
+}
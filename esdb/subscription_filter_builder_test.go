@@ -0,0 +1,58 @@
+package esdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscriptionFilterBuilder(t *testing.T) {
+	filter, err := FilterOnEventType().WithPrefixes("order-", "invoice-").ExcludeSystemEvents().Build()
+	require.NoError(t, err)
+	assert.Equal(t, &SubscriptionFilter{Type: EventFilterType, Prefixes: []string{"order-", "invoice-"}}, filter)
+
+	filter, err = FilterOnStreamName().ExcludeSystemEvents().Build()
+	require.NoError(t, err)
+	assert.Equal(t, &SubscriptionFilter{Type: StreamFilterType, Regex: "^[^\\$].*"}, filter)
+
+	filter, err = FilterOnStreamName().WithRegex("^account-[0-9]+$").Build()
+	require.NoError(t, err)
+	assert.Equal(t, "^account-[0-9]+$", filter.Regex)
+}
+
+func TestSubscriptionFilterBuilderValidation(t *testing.T) {
+	_, err := FilterOnEventType().Build()
+	assertInvalidArgument(t, err)
+
+	_, err = FilterOnEventType().WithRegex("^(order").Build()
+	assertInvalidArgument(t, err)
+
+	_, err = FilterOnEventType().WithRegex("^order").WithPrefixes("invoice").Build()
+	assertInvalidArgument(t, err)
+
+	_, err = FilterOnEventType().WithRegex("^order").ExcludeSystemEvents().Build()
+	assertInvalidArgument(t, err)
+
+	_, err = FilterOnStreamName().WithPrefixes("$ce-").ExcludeSystemEvents().Build()
+	assertInvalidArgument(t, err)
+
+	_, err = FilterOnStreamName().WithPrefixes("").Build()
+	assertInvalidArgument(t, err)
+}
+
+func TestSubscriptionFilterBuilderApplyTo(t *testing.T) {
+	opts := SubscribeToAllOptions{From: Start{}}
+	require.NoError(t, FilterOnEventType().ExcludeSystemEvents().ApplyTo(&opts))
+	assert.Equal(t, 32, opts.MaxSearchWindow)
+	assert.Equal(t, 1, opts.CheckpointInterval)
+	assert.Equal(t, Start{}, opts.From)
+
+	require.NoError(t, FilterOnEventType().ExcludeSystemEvents().WithMaxSearchWindow(100).WithCheckpointInterval(5).ApplyTo(&opts))
+	assert.Equal(t, 100, opts.MaxSearchWindow)
+	assert.Equal(t, 5, opts.CheckpointInterval)
+
+	opts = SubscribeToAllOptions{}
+	assertInvalidArgument(t, FilterOnEventType().ExcludeSystemEvents().WithCheckpointInterval(-1).ApplyTo(&opts))
+	assert.Nil(t, opts.Filter)
+}
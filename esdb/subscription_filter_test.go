@@ -0,0 +1,52 @@
+package esdb
+
+import (
+	"testing"
+
+	api "github.com/EventStore/EventStore-Client-Go/v2/protos/streams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func typedReadEventResponse(eventType string) *api.ReadResp {
+	event := testReadEvent(0)
+	event.Event.Metadata[systemMetadataKeysType] = eventType
+	return &api.ReadResp{Content: &api.ReadResp_Event{Event: event}}
+}
+
+func TestSubscriptionFiltersOnClient(t *testing.T) {
+	filter, err := newEventFilter(&SubscriptionFilter{Type: EventFilterType, Prefixes: []string{"Order"}})
+	require.NoError(t, err)
+
+	client := &Client{grpcClient: &grpcClient{logger: &logger{}}}
+	sub := NewSubscription(client, func() {}, &scriptedReadClient{responses: []*api.ReadResp{
+		typedReadEventResponse("OrderPlaced"),
+		typedReadEventResponse("$metadata"),
+		typedReadEventResponse("PaymentReceived"),
+		typedReadEventResponse("OrderShipped"),
+	}}, "id")
+	sub.filter = filter
+
+	var types []string
+	for {
+		event := sub.Recv()
+		if event.SubscriptionDropped != nil {
+			break
+		}
+
+		types = append(types, event.EventAppeared.Event.EventType)
+	}
+
+	assert.Equal(t, []string{"OrderPlaced", "OrderShipped"}, types)
+	assert.Equal(t, FilterStats{Delivered: 2, Skipped: 2}, sub.FilterStats())
+}
+
+func TestEventFilterValidation(t *testing.T) {
+	_, err := newEventFilter(&SubscriptionFilter{Regex: "("})
+	assertInvalidArgument(t, err)
+
+	filter, err := newEventFilter(ExcludeSystemEventsFilter())
+	require.NoError(t, err)
+	assert.False(t, filter.matches(&ResolvedEvent{Event: &RecordedEvent{EventType: "$metadata"}}))
+	assert.True(t, filter.matches(&ResolvedEvent{Event: &RecordedEvent{EventType: "OrderPlaced"}}))
+}
@@ -0,0 +1,83 @@
+package esdb
+
+import (
+	"context"
+	"fmt"
+)
+
+// SubscriptionHandler handles an event delivered to a subscription started with a handler. Returning an error drops
+// the subscription with SubscriptionDropHandlerError.
+type SubscriptionHandler func(ctx context.Context, event *ResolvedEvent) error
+
+// SubscribeToStreamWithHandler subscribes to a stream and calls handler for every event from a background goroutine,
+// one event at a time. Checkpoints and caught-up or fell-behind notifications are skipped. onDropped is called once
+// when the subscription drops, including when it gets closed or ctx cancelled, and may be nil. The returned
+// subscription is only meant to be closed.
+func (client *Client) SubscribeToStreamWithHandler(
+	ctx context.Context,
+	streamID string,
+	opts SubscribeToStreamOptions,
+	handler SubscriptionHandler,
+	onDropped func(SubscriptionDropped),
+) (*Subscription, error) {
+	sub, err := client.SubscribeToStream(ctx, streamID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	go sub.runHandler(ctx, handler, onDropped)
+	return sub, nil
+}
+
+// SubscribeToAllWithHandler is SubscribeToStreamWithHandler for $all.
+func (client *Client) SubscribeToAllWithHandler(
+	ctx context.Context,
+	opts SubscribeToAllOptions,
+	handler SubscriptionHandler,
+	onDropped func(SubscriptionDropped),
+) (*Subscription, error) {
+	sub, err := client.SubscribeToAll(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	go sub.runHandler(ctx, handler, onDropped)
+	return sub, nil
+}
+
+func (sub *Subscription) runHandler(ctx context.Context, handler SubscriptionHandler, onDropped func(SubscriptionDropped)) {
+	dropped := sub.handle(ctx, handler)
+	_ = sub.Close()
+
+	if onDropped != nil {
+		onDropped(dropped)
+	}
+}
+
+// handle calls handler for every event of the subscription until it drops, and returns why it did.
+func (sub *Subscription) handle(ctx context.Context, handler SubscriptionHandler) SubscriptionDropped {
+	for {
+		event := sub.Recv()
+
+		if ctx.Err() != nil {
+			return SubscriptionDropped{Error: ctx.Err(), Reason: SubscriptionDropUnsubscribed}
+		}
+
+		if event.SubscriptionDropped != nil {
+			return *event.SubscriptionDropped
+		}
+
+		if event.EventAppeared == nil {
+			continue
+		}
+
+		if err := handler(ctx, event.EventAppeared); err != nil {
+			original := event.EventAppeared.OriginalEvent()
+
+			return SubscriptionDropped{
+				Error:  fmt.Errorf("processing event %d of stream '%s' failed: %w", original.EventNumber, original.StreamID, err),
+				Reason: SubscriptionDropHandlerError,
+			}
+		}
+	}
+}
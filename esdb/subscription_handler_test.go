@@ -0,0 +1,117 @@
+package esdb
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	api "github.com/EventStore/EventStore-Client-Go/v2/protos/streams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func newScriptedSubscription() *Subscription {
+	client := &Client{grpcClient: &grpcClient{logger: &logger{}}}
+	return NewSubscription(client, func() {}, &scriptedReadClient{responses: readEventResponses(0, 1)}, "id")
+}
+
+func TestSubscriptionHandlerReceivesEvents(t *testing.T) {
+	var count int
+	dropped := newScriptedSubscription().handle(context.Background(), func(context.Context, *ResolvedEvent) error {
+		count++
+		return nil
+	})
+
+	assert.Equal(t, 2, count)
+	assert.Equal(t, SubscriptionDropServerError, dropped.Reason)
+}
+
+func TestSubscriptionHandlerFailure(t *testing.T) {
+	failure := errors.New("boom")
+	drops := make(chan SubscriptionDropped, 1)
+
+	sub := newScriptedSubscription()
+	sub.runHandler(context.Background(), func(context.Context, *ResolvedEvent) error {
+		return failure
+	}, func(dropped SubscriptionDropped) {
+		drops <- dropped
+	})
+
+	dropped := <-drops
+	assert.Equal(t, SubscriptionDropHandlerError, dropped.Reason)
+	assert.ErrorIs(t, dropped.Error, failure)
+
+	// The subscription is closed once the handler failed.
+	event := sub.Recv()
+	require.NotNil(t, event.SubscriptionDropped)
+	assert.Equal(t, SubscriptionDropUnsubscribed, event.SubscriptionDropped.Reason)
+}
+
+func TestDropReason(t *testing.T) {
+	assert.Equal(t, SubscriptionDropUnsubscribed, dropReason(status.Error(codes.Canceled, "closed"), nil))
+	assert.Equal(t, SubscriptionDropServerUnavailable, dropReason(status.Error(codes.Unavailable, "node down"), nil))
+	assert.Equal(t, SubscriptionDropAccessDenied, dropReason(status.Error(codes.PermissionDenied, "denied"), nil))
+	assert.Equal(t, SubscriptionDropServerError, dropReason(status.Error(codes.Internal, "oops"), nil))
+
+	trailers := metadata.Pairs("exception", "stream-deleted")
+	assert.Equal(t, SubscriptionDropStreamDeleted, dropReason(status.Error(codes.FailedPrecondition, "deleted"), &trailers))
+	assert.Equal(t, "handler error", SubscriptionDropHandlerError.String())
+}
+
+func TestSubscriptionCheckpointCallback(t *testing.T) {
+	checkpoint := &api.ReadResp{Content: &api.ReadResp_Checkpoint_{Checkpoint: &api.ReadResp_Checkpoint{CommitPosition: 150, PreparePosition: 150}}}
+	responses := append(readEventResponses(1), checkpoint, checkpoint)
+
+	client := &Client{grpcClient: &grpcClient{logger: &logger{}}}
+	sub := NewSubscription(client, func() {}, &scriptedReadClient{responses: responses}, "id")
+	sub.ctx = context.Background()
+
+	var calls []Position
+	sub.onCheckpoint = func(_ context.Context, position Position) error {
+		calls = append(calls, position)
+		if len(calls) == 2 {
+			return errors.New("store unavailable")
+		}
+
+		return nil
+	}
+
+	require.NotNil(t, sub.Recv().EventAppeared)
+
+	event := sub.Recv()
+	require.NotNil(t, event.CheckPointReached)
+	assert.Equal(t, []Position{{Commit: 150, Prepare: 150}}, calls)
+
+	event = sub.Recv()
+	require.NotNil(t, event.SubscriptionDropped)
+	assert.Equal(t, SubscriptionDropHandlerError, event.SubscriptionDropped.Reason)
+}
+
+func TestSubscriptionEventsChan(t *testing.T) {
+	var events []*SubscriptionEvent
+	for event := range newScriptedSubscription().EventsChan(context.Background()) {
+		events = append(events, event)
+	}
+
+	require.Len(t, events, 3)
+	assert.NotNil(t, events[1].EventAppeared)
+	assert.NotNil(t, events[2].SubscriptionDropped)
+}
+
+func TestSubscriptionEventsChanStopsWhenCancelled(t *testing.T) {
+	client := &Client{grpcClient: &grpcClient{logger: &logger{}}}
+	inner := &blockingReadClient{scriptedReadClient{responses: readEventResponses(0)}, make(chan struct{})}
+	sub := NewSubscription(client, func() { close(inner.cancelled) }, inner, "id")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events := sub.EventsChan(ctx)
+	require.NotNil(t, (<-events).EventAppeared)
+
+	cancel()
+	for event := range events {
+		t.Fatalf("unexpected event after cancellation: %+v", event)
+	}
+}
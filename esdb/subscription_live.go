@@ -0,0 +1,187 @@
+package esdb
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+)
+
+// subscriptionLive tracks whether a subscription finished replaying past events.
+type subscriptionLive struct {
+	once    sync.Once
+	reached chan struct{}
+	// Last event of the stream or $all when subscribing, nil when only the caught-up notifications of the server
+	// are relied on.
+	headRevision *uint64
+	headPosition *Position
+}
+
+func newSubscriptionLive() *subscriptionLive {
+	return &subscriptionLive{reached: make(chan struct{})}
+}
+
+func (live *subscriptionLive) markReached() {
+	live.once.Do(func() {
+		close(live.reached)
+	})
+}
+
+// observe marks the subscription live when the event is the caught-up notification of the server, or reaches the
+// head of the stream read when subscribing.
+func (live *subscriptionLive) observe(event *SubscriptionEvent) {
+	switch {
+	case event.CaughtUp != nil:
+		live.markReached()
+	case event.CheckPointReached != nil && live.headPosition != nil:
+		if !live.headPosition.After(*event.CheckPointReached) {
+			live.markReached()
+		}
+	case event.EventAppeared != nil:
+		original := event.EventAppeared.OriginalEvent()
+		if original == nil {
+			return
+		}
+
+		if live.headRevision != nil && original.EventNumber >= *live.headRevision {
+			live.markReached()
+		}
+
+		if live.headPosition != nil && !live.headPosition.After(original.Position) {
+			live.markReached()
+		}
+	}
+}
+
+// LiveReached returns a channel closed once the subscription replayed the events written before it started and
+// delivers new events as they are written. It relies on the caught-up notifications of the server. With servers which
+// don't send them, or with DetectLive, the events are also compared with the head read when subscribing.
+// Subscriptions starting from End{} are live right away.
+func (sub *Subscription) LiveReached() <-chan struct{} {
+	return sub.live.reached
+}
+
+// IsLive tells whether LiveReached is closed.
+func (sub *Subscription) IsLive() bool {
+	select {
+	case <-sub.live.reached:
+		return true
+	default:
+		return false
+	}
+}
+
+// needsHead tells whether the live tracking of a subscription has to read the head when subscribing, which is the case
+// with DetectLive or when the server doesn't send caught-up notifications.
+func (client *Client) needsHead(detectLive bool) (bool, error) {
+	if detectLive {
+		return true, nil
+	}
+
+	handle, err := client.grpcClient.getConnectionHandle()
+	if err != nil {
+		return false, err
+	}
+
+	return !handle.SupportsFeature(FEATURE_SUBSCRIPTION_CAUGHT_UP), nil
+}
+
+// streamLive prepares the live tracking of a subscription to a stream, reading the head of the stream when needed.
+func (client *Client) streamLive(ctx context.Context, streamID string, opts *SubscribeToStreamOptions) (*subscriptionLive, error) {
+	live := newSubscriptionLive()
+	if _, ok := opts.From.(End); ok {
+		live.markReached()
+		return live, nil
+	}
+
+	needed, err := client.needsHead(opts.DetectLive)
+	if err != nil {
+		return nil, err
+	}
+
+	if !needed {
+		return live, nil
+	}
+
+	head, err := client.readStreamHeadRevision(ctx, streamID, opts.Authenticated, opts.Deadline)
+	if err != nil {
+		var esdbErr *Error
+		if errors.As(err, &esdbErr) && esdbErr.Code() == ErrorResourceNotFound {
+			live.markReached()
+			return live, nil
+		}
+
+		return nil, err
+	}
+
+	if revision, ok := opts.From.(StreamRevision); ok && revision.Value >= head {
+		live.markReached()
+	}
+
+	live.headRevision = &head
+	return live, nil
+}
+
+// allLive prepares the live tracking of a subscription to $all, reading the head of $all when needed.
+func (client *Client) allLive(ctx context.Context, opts *SubscribeToAllOptions) (*subscriptionLive, error) {
+	live := newSubscriptionLive()
+	if _, ok := opts.From.(End); ok {
+		live.markReached()
+		return live, nil
+	}
+
+	needed, err := client.needsHead(opts.DetectLive)
+	if err != nil {
+		return nil, err
+	}
+
+	if !needed {
+		return live, nil
+	}
+
+	head, err := client.readAllHeadPosition(ctx, opts.Authenticated, opts.Deadline)
+	if err != nil {
+		return nil, err
+	}
+
+	if head == nil {
+		live.markReached()
+		return live, nil
+	}
+
+	if position, ok := opts.From.(Position); ok && !head.After(position) {
+		live.markReached()
+	}
+
+	live.headPosition = head
+	return live, nil
+}
+
+// readAllHeadPosition returns the position of the last event of $all, or nil when $all is empty.
+func (client *Client) readAllHeadPosition(ctx context.Context, credentials *Credentials, deadline *time.Duration) (*Position, error) {
+	stream, err := client.ReadAll(ctx, ReadAllOptions{
+		Direction:     Backwards,
+		From:          End{},
+		Authenticated: credentials,
+		Deadline:      deadline,
+	}, 1)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer stream.Close()
+	event, err := stream.Recv()
+
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	head := event.OriginalEvent().Position
+	return &head, nil
+}
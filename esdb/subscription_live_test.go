@@ -0,0 +1,47 @@
+package esdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscriptionLiveFromCaughtUp(t *testing.T) {
+	client := &Client{grpcClient: &grpcClient{logger: &logger{}}}
+	sub := NewSubscription(client, func() {}, &scriptedReadClient{responses: liveTransitionResponses()}, "id")
+
+	sub.Recv()
+	assert.False(t, sub.IsLive())
+
+	sub.Recv()
+	assert.True(t, sub.IsLive())
+	assert.True(t, isClosed(sub.LiveReached()))
+}
+
+func TestSubscriptionLiveFromHead(t *testing.T) {
+	head := uint64(5)
+	live := newSubscriptionLive()
+	live.headRevision = &head
+
+	live.observe(&SubscriptionEvent{EventAppeared: &ResolvedEvent{Event: &RecordedEvent{EventNumber: 4}}})
+	assert.False(t, isClosed(live.reached))
+
+	live.observe(&SubscriptionEvent{EventAppeared: &ResolvedEvent{Event: &RecordedEvent{EventNumber: 5}}})
+	assert.True(t, isClosed(live.reached))
+
+	position := Position{Commit: 500, Prepare: 500}
+	live = newSubscriptionLive()
+	live.headPosition = &position
+
+	live.observe(&SubscriptionEvent{CheckPointReached: &Position{Commit: 600, Prepare: 600}})
+	assert.True(t, isClosed(live.reached))
+}
+
+func isClosed(ch <-chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
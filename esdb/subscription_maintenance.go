@@ -0,0 +1,108 @@
+package esdb
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	api "github.com/EventStore/EventStore-Client-Go/v2/protos/streams"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Attempts made to resubscribe after the node of a subscription went into maintenance, before dropping it.
+const maxResubscribeAttempts = 3
+
+// Delay between two attempts to resubscribe, multiplied by the number of failed attempts.
+var resubscribeBackoff = 500 * time.Millisecond
+
+// openedSubscription is a subscription call confirmed by the server.
+type openedSubscription struct {
+	handle   *connectionHandle
+	inner    api.Streams_ReadClient
+	cancel   context.CancelFunc
+	trailers *metadata.MD
+	id       string
+}
+
+// resumePoint is the last event or checkpoint received by a subscription, which a new call subscribes from.
+type resumePoint struct {
+	revision uint64
+	position Position
+}
+
+// serverMaintenance tells whether a subscription failed because its node is shutting down or is no longer the leader,
+// in which case another node can take over the subscription.
+func serverMaintenance(err error, trailers *metadata.MD) bool {
+	if trailers != nil {
+		if values := trailers.Get("exception"); len(values) > 0 && values[0] == "not-leader" {
+			return true
+		}
+	}
+
+	return status.Code(err) == codes.Unavailable
+}
+
+// track remembers where the subscription must resume from.
+func (sub *Subscription) track(event *SubscriptionEvent) {
+	if sub.reopen == nil {
+		return
+	}
+
+	if event.EventAppeared != nil {
+		if original := event.EventAppeared.OriginalEvent(); original != nil {
+			sub.resumeFrom = &resumePoint{revision: original.EventNumber, position: original.Position}
+		}
+	}
+
+	if event.CheckPointReached != nil {
+		if sub.resumeFrom == nil {
+			sub.resumeFrom = &resumePoint{}
+		}
+
+		sub.resumeFrom.position = *event.CheckPointReached
+	}
+}
+
+// resubscribe replaces the call of the subscription with one resuming after the last event it received. With
+// rediscover, it first asks the client to discover a node again, honoring the NodePreference of its configuration.
+func (sub *Subscription) resubscribe(rediscover bool) error {
+	var err error
+	for attempt := 1; attempt <= maxResubscribeAttempts; attempt++ {
+		if rediscover && sub.connection != nil && atomic.LoadInt32(sub.client.grpcClient.closeFlag) == 0 {
+			sub.client.grpcClient.channel <- reconnect{correlation: sub.connection.Id()}
+		}
+
+		var opened *openedSubscription
+		if opened, err = sub.reopen(sub.resumeFrom); err == nil {
+			sub.lock.Lock()
+			defer sub.lock.Unlock()
+
+			if atomic.LoadInt32(sub.closed) != 0 {
+				opened.cancel()
+				return fmt.Errorf("subscription has been closed")
+			}
+
+			sub.connection = opened.handle
+			sub.inner = opened.inner
+			if sub.waitingSince != nil {
+				sub.inner = sub.observeArrivals(opened.inner)
+			}
+			sub.cancel = opened.cancel
+			sub.trailers = opened.trailers
+			atomic.AddUint64(&sub.stats.reconnects, 1)
+			sub.client.grpcClient.logger.info("subscription %s resubscribed", sub.id)
+			return nil
+		}
+
+		select {
+		case <-sub.done:
+			return fmt.Errorf("subscription has been closed")
+		case <-time.After(time.Duration(attempt) * resubscribeBackoff):
+		}
+	}
+
+	return fmt.Errorf("failed to resubscribe after %d attempts: %w", maxResubscribeAttempts, err)
+}
@@ -0,0 +1,76 @@
+package esdb
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestSubscriptionResubscribesOnServerMaintenance(t *testing.T) {
+	shutdown := status.Error(codes.Unavailable, "server shutting down")
+	client := &Client{grpcClient: &grpcClient{logger: &logger{}}}
+	inner := &failingReadClient{scriptedReadClient{responses: readEventResponses(0, 1)}, shutdown}
+	sub := NewSubscription(client, func() {}, inner, "id")
+
+	var froms []*resumePoint
+	sub.reopen = func(from *resumePoint) (*openedSubscription, error) {
+		froms = append(froms, from)
+		return &openedSubscription{
+			inner:    &scriptedReadClient{responses: readEventResponses(2)},
+			cancel:   func() {},
+			trailers: &metadata.MD{},
+			id:       "id",
+		}, nil
+	}
+
+	var revisions []uint64
+	for {
+		event := sub.Recv()
+		if event.SubscriptionDropped != nil {
+			break
+		}
+
+		revisions = append(revisions, event.EventAppeared.OriginalEvent().EventNumber)
+	}
+
+	assert.Equal(t, []uint64{0, 1, 2}, revisions)
+	require.Len(t, froms, 1)
+	assert.Equal(t, uint64(1), froms[0].revision)
+}
+
+func TestSubscriptionDropsWhenResubscribingFails(t *testing.T) {
+	defer func(backoff time.Duration) { resubscribeBackoff = backoff }(resubscribeBackoff)
+	resubscribeBackoff = time.Millisecond
+
+	notLeader := status.Error(codes.NotFound, "leader info available")
+	client := &Client{grpcClient: &grpcClient{logger: &logger{}}}
+	sub := NewSubscription(client, func() {}, &failingReadClient{err: notLeader}, "id")
+	trailers := metadata.Pairs("exception", "not-leader")
+	sub.trailers = &trailers
+
+	var attempts int
+	sub.reopen = func(from *resumePoint) (*openedSubscription, error) {
+		assert.Nil(t, from)
+		attempts++
+		return nil, errors.New("no node available")
+	}
+
+	event := sub.Recv()
+	require.NotNil(t, event.SubscriptionDropped)
+	assert.ErrorIs(t, event.SubscriptionDropped.Error, notLeader)
+	assert.Contains(t, event.SubscriptionDropped.Error.Error(), "no node available")
+	assert.Equal(t, maxResubscribeAttempts, attempts)
+}
+
+func TestServerMaintenance(t *testing.T) {
+	notLeader := metadata.Pairs("exception", "not-leader")
+	assert.True(t, serverMaintenance(status.Error(codes.NotFound, "not leader"), &notLeader))
+	assert.True(t, serverMaintenance(status.Error(codes.Unavailable, "shutting down"), nil))
+	assert.False(t, serverMaintenance(status.Error(codes.Internal, "oops"), nil))
+}
@@ -0,0 +1,88 @@
+package esdb
+
+import (
+	"sync/atomic"
+)
+
+// Pause stops the subscription from delivering events until Resume is called, Recv blocking in the meantime. The
+// call to the server stays open unless the subscription was started with ReleaseOnPause, in which case it is
+// cancelled and reopened by Resume from the last event delivered, or from where the subscription started when none
+// was, the end of the stream or $all read when subscribing for End{}. Otherwise, the server stops sending events once
+// the gRPC flow-control window is full. A paused subscription receives no message, which OnStalled reports.
+func (sub *Subscription) Pause() {
+	sub.pauseLock.Lock()
+	defer sub.pauseLock.Unlock()
+
+	if sub.resumed != nil {
+		return
+	}
+
+	sub.resumed = make(chan struct{})
+
+	if !sub.releaseOnPause || sub.reopen == nil {
+		return
+	}
+
+	sub.lock.Lock()
+	defer sub.lock.Unlock()
+
+	if atomic.LoadInt32(sub.closed) == 0 {
+		sub.released = true
+		sub.cancel()
+	}
+}
+
+// Resume delivers events again after Pause.
+func (sub *Subscription) Resume() {
+	sub.pauseLock.Lock()
+	defer sub.pauseLock.Unlock()
+
+	if sub.resumed != nil {
+		close(sub.resumed)
+		sub.resumed = nil
+	}
+}
+
+// IsPaused tells whether Pause was called without Resume being called since.
+func (sub *Subscription) IsPaused() bool {
+	sub.pauseLock.Lock()
+	defer sub.pauseLock.Unlock()
+
+	return sub.resumed != nil
+}
+
+// waitResumed blocks while the subscription is paused, and returns false if it got closed in the meantime.
+func (sub *Subscription) waitResumed() bool {
+	sub.pauseLock.Lock()
+	resumed := sub.resumed
+	sub.pauseLock.Unlock()
+
+	if resumed == nil {
+		return true
+	}
+
+	select {
+	case <-resumed:
+		return true
+	case <-sub.done:
+		return false
+	}
+}
+
+// isReleased tells whether Pause cancelled the call to the server.
+func (sub *Subscription) isReleased() bool {
+	sub.pauseLock.Lock()
+	defer sub.pauseLock.Unlock()
+
+	return sub.released
+}
+
+// takeReleased tells whether Pause cancelled the call to the server, which must be reopened.
+func (sub *Subscription) takeReleased() bool {
+	sub.pauseLock.Lock()
+	defer sub.pauseLock.Unlock()
+
+	released := sub.released
+	sub.released = false
+	return released
+}
@@ -0,0 +1,90 @@
+package esdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestSubscriptionPauseBlocksDelivery(t *testing.T) {
+	sub := newScriptedSubscription()
+	sub.Pause()
+	assert.True(t, sub.IsPaused())
+
+	events := make(chan *SubscriptionEvent)
+	go func() {
+		events <- sub.Recv()
+	}()
+
+	select {
+	case <-events:
+		t.Fatal("a paused subscription delivered an event")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	sub.Resume()
+	assert.False(t, sub.IsPaused())
+
+	event := <-events
+	require.NotNil(t, event.EventAppeared)
+	assert.Equal(t, uint64(0), event.EventAppeared.OriginalEvent().EventNumber)
+}
+
+func TestSubscriptionClosedWhilePaused(t *testing.T) {
+	sub := newScriptedSubscription()
+	sub.Pause()
+
+	events := make(chan *SubscriptionEvent)
+	go func() {
+		events <- sub.Recv()
+	}()
+
+	require.NoError(t, sub.Close())
+
+	event := <-events
+	require.NotNil(t, event.SubscriptionDropped)
+	assert.Equal(t, SubscriptionDropUnsubscribed, event.SubscriptionDropped.Reason)
+}
+
+func TestSubscriptionReleasedOnPause(t *testing.T) {
+	client := &Client{grpcClient: &grpcClient{logger: &logger{}}}
+	inner := &blockingReadClient{scriptedReadClient{responses: readEventResponses(0, 1)}, make(chan struct{})}
+	sub := NewSubscription(client, func() { close(inner.cancelled) }, inner, "id")
+	sub.releaseOnPause = true
+
+	var froms []*resumePoint
+	sub.reopen = func(from *resumePoint) (*openedSubscription, error) {
+		froms = append(froms, from)
+		return &openedSubscription{
+			inner:    &scriptedReadClient{responses: readEventResponses(2)},
+			cancel:   func() {},
+			trailers: &metadata.MD{},
+			id:       "id",
+		}, nil
+	}
+
+	for expected := uint64(0); expected < 2; expected++ {
+		event := sub.Recv()
+		require.NotNil(t, event.EventAppeared)
+		assert.Equal(t, expected, event.EventAppeared.OriginalEvent().EventNumber)
+	}
+
+	events := make(chan *SubscriptionEvent)
+	go func() {
+		events <- sub.Recv()
+	}()
+
+	// Pausing cancels the call the pending Recv waits on.
+	sub.Pause()
+	assert.True(t, isClosed(inner.cancelled))
+	sub.Resume()
+
+	event := <-events
+	require.NotNil(t, event.EventAppeared)
+	assert.Equal(t, uint64(2), event.EventAppeared.OriginalEvent().EventNumber)
+	require.Len(t, froms, 1)
+	assert.Equal(t, uint64(1), froms[0].revision)
+}
@@ -0,0 +1,83 @@
+package esdb
+
+import (
+	"sync/atomic"
+	"time"
+
+	api "github.com/EventStore/EventStore-Client-Go/v2/protos/streams"
+)
+
+// LastMessageAt returns when the subscription last received a message from the server, be it an event, a checkpoint
+// or a notification, or when it got created if it received none yet. With OnStalled set, it is when the message
+// arrived from the server rather than when Recv returned it.
+func (sub *Subscription) LastMessageAt() time.Time {
+	return time.Unix(0, atomic.LoadInt64(sub.lastMessage))
+}
+
+func (sub *Subscription) messageReceived() {
+	if sub.waitingSince == nil {
+		atomic.StoreInt64(sub.lastMessage, time.Now().UnixNano())
+	}
+}
+
+// observeArrivals makes the call receive its messages in a background goroutine, one ahead of Recv, so the time
+// each message arrives is recorded even while the caller processes the previous one.
+func (sub *Subscription) observeArrivals(inner api.Streams_ReadClient) api.Streams_ReadClient {
+	return newPrefetchReadClient(inner, 1, func(waiting bool) {
+		now := time.Now().UnixNano()
+		if waiting {
+			atomic.StoreInt64(sub.waitingSince, now)
+			return
+		}
+
+		atomic.StoreInt64(sub.waitingSince, 0)
+		atomic.StoreInt64(sub.lastMessage, now)
+	})
+}
+
+// monitorStalls calls onStalled from a background goroutine whenever the subscription waits on the server for
+// threshold without receiving a message, once per silence, until the subscription is closed or drops. Time the
+// caller spends processing messages isn't a silence of the server, so it isn't counted.
+func (sub *Subscription) monitorStalls(threshold time.Duration, onStalled func(time.Duration)) {
+	sub.lock.Lock()
+	sub.waitingSince = new(int64)
+	sub.inner = sub.observeArrivals(sub.inner)
+	sub.lock.Unlock()
+
+	go func() {
+		timer := time.NewTimer(threshold)
+		defer timer.Stop()
+
+		var reported time.Time
+		for {
+			select {
+			case <-sub.done:
+				return
+			case <-timer.C:
+			}
+
+			if atomic.LoadInt32(sub.closed) != 0 {
+				return
+			}
+
+			waitingSince := atomic.LoadInt64(sub.waitingSince)
+			if waitingSince == 0 {
+				timer.Reset(threshold)
+				continue
+			}
+
+			since := time.Since(time.Unix(0, waitingSince))
+			if since < threshold {
+				timer.Reset(threshold - since)
+				continue
+			}
+
+			if last := sub.LastMessageAt(); !last.Equal(reported) {
+				reported = last
+				onStalled(since)
+			}
+
+			timer.Reset(threshold)
+		}
+	}()
+}
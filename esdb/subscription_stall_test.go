@@ -0,0 +1,58 @@
+package esdb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscriptionStallMonitor(t *testing.T) {
+	client := &Client{grpcClient: &grpcClient{logger: &logger{}}}
+	inner := &blockingReadClient{scriptedReadClient{responses: readEventResponses(0)}, make(chan struct{})}
+	sub := NewSubscription(client, func() { close(inner.cancelled) }, inner, "id")
+	defer sub.Close()
+
+	before := sub.LastMessageAt()
+	assert.NotNil(t, sub.Recv().EventAppeared)
+	assert.False(t, sub.LastMessageAt().Before(before))
+
+	stalls := make(chan time.Duration, 10)
+	sub.monitorStalls(10*time.Millisecond, func(since time.Duration) {
+		stalls <- since
+	})
+
+	select {
+	case since := <-stalls:
+		assert.True(t, since >= 10*time.Millisecond)
+	case <-time.After(time.Second):
+		t.Fatal("stall not reported")
+	}
+
+	// A silence is only reported once.
+	time.Sleep(50 * time.Millisecond)
+	assert.Len(t, stalls, 0)
+}
+
+func TestSubscriptionStallMonitorIgnoresBusyConsumers(t *testing.T) {
+	client := &Client{grpcClient: &grpcClient{logger: &logger{}}}
+	ctx, cancel := context.WithCancel(context.Background())
+	sub := NewSubscription(client, cancel, &fakeReadClient{ctx: ctx, remaining: 1000}, "id")
+	defer sub.Close()
+
+	stalls := make(chan time.Duration, 10)
+	sub.monitorStalls(10*time.Millisecond, func(since time.Duration) {
+		stalls <- since
+	})
+
+	assert.NotNil(t, sub.Recv().EventAppeared)
+
+	// The next messages arrive while the consumer is busy, which isn't a silence of the server.
+	busy := time.Now()
+	time.Sleep(50 * time.Millisecond)
+	assert.Len(t, stalls, 0)
+	assert.True(t, sub.LastMessageAt().Before(busy.Add(40*time.Millisecond)))
+
+	assert.NotNil(t, sub.Recv().EventAppeared)
+}
@@ -0,0 +1,173 @@
+package esdb
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	api "github.com/EventStore/EventStore-Client-Go/v2/protos/streams"
+	"google.golang.org/protobuf/proto"
+)
+
+// subscriptionStats counts the messages received by a subscription.
+type subscriptionStats struct {
+	// Kept first for the 64-bit alignment atomic operations require.
+	events      uint64
+	bytes       uint64
+	checkpoints uint64
+	reconnects  uint64
+
+	lock sync.Mutex
+	last *resumePoint
+}
+
+// SubscriptionStats is a snapshot of the messages received by a subscription. It encodes to JSON, so it can be
+// published with expvar.Func or turned into Prometheus metrics from a collector.
+type SubscriptionStats struct {
+	// Number of events received.
+	Events uint64 `json:"events"`
+	// Size of the messages received from the server, as encoded on the wire.
+	Bytes uint64 `json:"bytes"`
+	// Number of checkpoints received by a filtered subscription to $all.
+	Checkpoints uint64 `json:"checkpoints"`
+	// Number of times the subscription resubscribed, after server maintenance or a release by Pause.
+	Reconnects uint64 `json:"reconnects"`
+	// Revision of the last event received from the stream subscribed to, or where the subscription started. Nil
+	// before the first event of subscriptions started from the beginning, and for subscriptions to $all.
+	LastRevision *uint64 `json:"last_revision,omitempty"`
+	// Position of the last event or checkpoint received by a subscription to $all, or where it started. Nil before the
+	// first event of subscriptions started from the beginning, and for subscriptions to a stream.
+	LastPosition *Position `json:"last_position,omitempty"`
+}
+
+// SubscriptionLag is how far a subscription is behind the end of its stream or $all.
+type SubscriptionLag struct {
+	// Number of events of the stream after the last one received. Unused for subscriptions to $all.
+	Events uint64
+	// Difference of commit positions between the last event of $all and the last event or checkpoint received, which
+	// roughly is the number of bytes of the transaction log left to go through. Unused for subscriptions to a stream.
+	Commit uint64
+}
+
+// received counts a message received from the server.
+func (s *subscriptionStats) received(result *api.ReadResp, event *SubscriptionEvent) {
+	atomic.AddUint64(&s.bytes, uint64(proto.Size(result)))
+
+	switch {
+	case event.EventAppeared != nil:
+		atomic.AddUint64(&s.events, 1)
+
+		if original := event.EventAppeared.OriginalEvent(); original != nil {
+			s.lock.Lock()
+			s.last = &resumePoint{revision: original.EventNumber, position: original.Position}
+			s.lock.Unlock()
+		}
+	case event.CheckPointReached != nil:
+		atomic.AddUint64(&s.checkpoints, 1)
+
+		s.lock.Lock()
+		s.last = &resumePoint{position: *event.CheckPointReached}
+		s.lock.Unlock()
+	}
+}
+
+// Stats returns a snapshot of the messages received by the subscription. It is safe to call concurrently with Recv.
+func (sub *Subscription) Stats() SubscriptionStats {
+	stats := SubscriptionStats{
+		Events:      atomic.LoadUint64(&sub.stats.events),
+		Bytes:       atomic.LoadUint64(&sub.stats.bytes),
+		Checkpoints: atomic.LoadUint64(&sub.stats.checkpoints),
+		Reconnects:  atomic.LoadUint64(&sub.stats.reconnects),
+	}
+
+	sub.stats.lock.Lock()
+	last := sub.stats.last
+	sub.stats.lock.Unlock()
+
+	switch {
+	case last != nil && sub.toAll:
+		stats.LastPosition = &last.position
+	case last != nil:
+		stats.LastRevision = &last.revision
+	default:
+		stats.LastRevision = sub.confirmation.Revision
+		stats.LastPosition = sub.confirmation.Position
+	}
+
+	return stats
+}
+
+// Lag reads the end of the stream or $all subscribed to, and tells how far the subscription is behind it. Before the
+// first event, it is measured from where the subscription started, which for End{} is the end when subscribing. It is
+// only available for subscriptions created by the client.
+func (sub *Subscription) Lag(ctx context.Context) (SubscriptionLag, error) {
+	if sub.readHead == nil {
+		return SubscriptionLag{}, invalidArgumentError("the lag is only available for subscriptions created by the client")
+	}
+
+	head, err := sub.readHead(ctx)
+	if err != nil || head == nil {
+		return SubscriptionLag{}, err
+	}
+
+	sub.stats.lock.Lock()
+	from := sub.stats.last
+	sub.stats.lock.Unlock()
+
+	if from == nil {
+		from = sub.start
+	}
+
+	if sub.toAll {
+		var commit uint64
+		if from != nil {
+			commit = from.position.Commit
+		}
+
+		if head.position.Commit <= commit {
+			return SubscriptionLag{}, nil
+		}
+
+		return SubscriptionLag{Commit: head.position.Commit - commit}, nil
+	}
+
+	if from == nil {
+		return SubscriptionLag{Events: head.revision + 1}, nil
+	}
+
+	if head.revision <= from.revision {
+		return SubscriptionLag{}, nil
+	}
+
+	return SubscriptionLag{Events: head.revision - from.revision}, nil
+}
+
+// streamHead reads the last event of a stream, and returns nil when the stream doesn't exist.
+func (client *Client) streamHead(streamID string, opts *SubscribeToStreamOptions) func(context.Context) (*resumePoint, error) {
+	return func(ctx context.Context) (*resumePoint, error) {
+		revision, err := client.readStreamHeadRevision(ctx, streamID, opts.Authenticated, opts.Deadline)
+		if err != nil {
+			var esdbErr *Error
+			if errors.As(err, &esdbErr) && esdbErr.Code() == ErrorResourceNotFound {
+				return nil, nil
+			}
+
+			return nil, err
+		}
+
+		return &resumePoint{revision: revision}, nil
+	}
+}
+
+// allHead reads the last event of $all, and returns nil when $all is empty.
+func (client *Client) allHead(opts *SubscribeToAllOptions) func(context.Context) (*resumePoint, error) {
+	return func(ctx context.Context) (*resumePoint, error) {
+		position, err := client.readAllHeadPosition(ctx, opts.Authenticated, opts.Deadline)
+		if err != nil || position == nil {
+			return nil, err
+		}
+
+		return &resumePoint{position: *position}, nil
+	}
+}
@@ -0,0 +1,78 @@
+package esdb
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/EventStore/EventStore-Client-Go/v2/protos/streams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscriptionStats(t *testing.T) {
+	sub := newScriptedSubscription()
+	sub.readHead = func(context.Context) (*resumePoint, error) {
+		return &resumePoint{revision: 5}, nil
+	}
+
+	stats := sub.Stats()
+	assert.Equal(t, uint64(0), stats.Events)
+	assert.Nil(t, stats.LastRevision)
+
+	lag, err := sub.Lag(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(6), lag.Events)
+
+	for i := 0; i < 2; i++ {
+		require.NotNil(t, sub.Recv().EventAppeared)
+	}
+
+	stats = sub.Stats()
+	assert.Equal(t, uint64(2), stats.Events)
+	assert.Greater(t, stats.Bytes, uint64(0))
+	require.NotNil(t, stats.LastRevision)
+	assert.Equal(t, uint64(1), *stats.LastRevision)
+	assert.Nil(t, stats.LastPosition)
+
+	lag, err = sub.Lag(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, SubscriptionLag{Events: 4}, lag)
+}
+
+func TestSubscriptionToAllStats(t *testing.T) {
+	checkpoint := &api.ReadResp{Content: &api.ReadResp_Checkpoint_{Checkpoint: &api.ReadResp_Checkpoint{CommitPosition: 150, PreparePosition: 150}}}
+	client := &Client{grpcClient: &grpcClient{logger: &logger{}}}
+	sub := NewSubscription(client, func() {}, &scriptedReadClient{responses: []*api.ReadResp{checkpoint}}, "id")
+	sub.toAll = true
+	sub.readHead = func(context.Context) (*resumePoint, error) {
+		return &resumePoint{position: Position{Commit: 200, Prepare: 200}}, nil
+	}
+
+	require.NotNil(t, sub.Recv().CheckPointReached)
+
+	stats := sub.Stats()
+	assert.Equal(t, uint64(1), stats.Checkpoints)
+	require.NotNil(t, stats.LastPosition)
+	assert.Equal(t, Position{Commit: 150, Prepare: 150}, *stats.LastPosition)
+
+	lag, err := sub.Lag(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, SubscriptionLag{Commit: 50}, lag)
+}
+
+func TestSubscriptionLagRequiresClient(t *testing.T) {
+	_, err := newScriptedSubscription().Lag(context.Background())
+	assertInvalidArgument(t, err)
+}
+
+func TestSubscriptionLagIsMeasuredFromTheStart(t *testing.T) {
+	sub := newScriptedSubscription()
+	sub.start = &resumePoint{revision: 5}
+	sub.readHead = func(context.Context) (*resumePoint, error) {
+		return &resumePoint{revision: 7}, nil
+	}
+
+	lag, err := sub.Lag(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, SubscriptionLag{Events: 2}, lag)
+}
@@ -0,0 +1,192 @@
+package esdb
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sync"
+)
+
+// SubscriptionWorkerPool handles the events of a subscription with several goroutines. Events are partitioned by key,
+// each partition being handled in order by a single worker, while different partitions are handled in parallel. The
+// pool tracks the events in flight, so Checkpoint only moves past an event once it and every event before it got
+// handled.
+type SubscriptionWorkerPool struct {
+	sub     *Subscription
+	handler SubscriptionHandler
+	opts    SubscriptionWorkerPoolOptions
+
+	lock sync.Mutex
+	// Events dispatched but not all handled yet, in subscription order. The first one is the event with sequence
+	// number base.
+	inFlight   []workerPoolItem
+	base       uint64
+	checkpoint *ResolvedEvent
+}
+
+type workerPoolItem struct {
+	seq     uint64
+	event   *ResolvedEvent
+	handled bool
+}
+
+// NewSubscriptionWorkerPool prepares a pool handling the events of the subscription with handler. The subscription
+// must not be used directly afterwards.
+func NewSubscriptionWorkerPool(sub *Subscription, handler SubscriptionHandler, opts SubscriptionWorkerPoolOptions) (*SubscriptionWorkerPool, error) {
+	opts.setDefaults()
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	return &SubscriptionWorkerPool{
+		sub:     sub,
+		handler: handler,
+		opts:    opts,
+	}, nil
+}
+
+// Run handles events until the context gets cancelled, the subscription drops or the handler fails, and closes the
+// subscription. Events already dispatched to the workers are handled before it returns, unless the handler failed. It
+// returns nil when the context got cancelled.
+func (pool *SubscriptionWorkerPool) Run(ctx context.Context) error {
+	defer pool.sub.Close()
+
+	dispatchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stop := closeOnDone(dispatchCtx, func() {
+		_ = pool.sub.Close()
+	})
+	defer stop()
+
+	var failure error
+	var failureOnce sync.Once
+	failed := make(chan struct{})
+	fail := func(err error) {
+		failureOnce.Do(func() {
+			failure = err
+			close(failed)
+			cancel()
+		})
+	}
+
+	queues := make([]chan workerPoolItem, pool.opts.Workers)
+	var workers sync.WaitGroup
+	for i := range queues {
+		queues[i] = make(chan workerPoolItem, pool.opts.QueueSize)
+		workers.Add(1)
+
+		go func(queue chan workerPoolItem) {
+			defer workers.Done()
+			pool.work(ctx, queue, failed, fail)
+		}(queues[i])
+	}
+
+	dropped := pool.dispatch(dispatchCtx, queues)
+
+	for _, queue := range queues {
+		close(queue)
+	}
+
+	workers.Wait()
+
+	if failure != nil {
+		return failure
+	}
+
+	if dropped != nil && dropped.Reason != SubscriptionDropUnsubscribed {
+		return fmt.Errorf("subscription dropped: %w", dropped.Error)
+	}
+
+	return nil
+}
+
+// dispatch sends the events of the subscription to the queue of their partition, until the subscription drops or the
+// context gets cancelled.
+func (pool *SubscriptionWorkerPool) dispatch(ctx context.Context, queues []chan workerPoolItem) *SubscriptionDropped {
+	var seq uint64
+	for {
+		event := pool.sub.Recv()
+
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if event.SubscriptionDropped != nil {
+			return event.SubscriptionDropped
+		}
+
+		if event.EventAppeared == nil {
+			continue
+		}
+
+		item := workerPoolItem{seq: seq, event: event.EventAppeared}
+		seq++
+
+		pool.lock.Lock()
+		pool.inFlight = append(pool.inFlight, item)
+		pool.lock.Unlock()
+
+		hash := fnv.New32a()
+		_, _ = hash.Write([]byte(pool.opts.PartitionKey(event.EventAppeared)))
+
+		select {
+		case queues[hash.Sum32()%uint32(len(queues))] <- item:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (pool *SubscriptionWorkerPool) work(ctx context.Context, queue chan workerPoolItem, failed chan struct{}, fail func(error)) {
+	for item := range queue {
+		select {
+		case <-failed:
+			continue
+		default:
+		}
+
+		if err := pool.handler(ctx, item.event); err != nil {
+			original := item.event.OriginalEvent()
+			fail(fmt.Errorf("processing event %d of stream '%s' failed: %w", original.EventNumber, original.StreamID, err))
+			continue
+		}
+
+		pool.handled(item.seq)
+	}
+}
+
+// handled marks an event as handled, and moves the checkpoint past every event handled in a row since the previous
+// checkpoint.
+func (pool *SubscriptionWorkerPool) handled(seq uint64) {
+	pool.lock.Lock()
+	defer pool.lock.Unlock()
+
+	pool.inFlight[seq-pool.base].handled = true
+
+	done := 0
+	for done < len(pool.inFlight) && pool.inFlight[done].handled {
+		pool.checkpoint = pool.inFlight[done].event
+		done++
+	}
+
+	pool.inFlight = pool.inFlight[done:]
+	pool.base += uint64(done)
+}
+
+// Checkpoint returns the last event such that it and every event before it got handled, which is where a
+// subscription resuming the work must start from. It is false until the first event got handled.
+func (pool *SubscriptionWorkerPool) Checkpoint() (*ResolvedEvent, bool) {
+	pool.lock.Lock()
+	defer pool.lock.Unlock()
+
+	return pool.checkpoint, pool.checkpoint != nil
+}
+
+// InFlight returns the number of events received from the subscription but not part of the checkpoint yet.
+func (pool *SubscriptionWorkerPool) InFlight() int {
+	pool.lock.Lock()
+	defer pool.lock.Unlock()
+
+	return len(pool.inFlight)
+}
@@ -0,0 +1,40 @@
+package esdb
+
+// SubscriptionWorkerPoolOptions configures NewSubscriptionWorkerPool.
+type SubscriptionWorkerPoolOptions struct {
+	// Number of goroutines handling events. Defaults to 4.
+	Workers int
+	// Key of the partition an event belongs to. Events of a partition are handled in order by the same worker.
+	// Defaults to the stream ID of the event.
+	PartitionKey func(*ResolvedEvent) string
+	// Number of events queued per worker before the subscription stops being read. Defaults to 100.
+	QueueSize int
+}
+
+func (o *SubscriptionWorkerPoolOptions) setDefaults() {
+	if o.Workers == 0 {
+		o.Workers = 4
+	}
+
+	if o.PartitionKey == nil {
+		o.PartitionKey = func(event *ResolvedEvent) string {
+			return event.OriginalEvent().StreamID
+		}
+	}
+
+	if o.QueueSize == 0 {
+		o.QueueSize = 100
+	}
+}
+
+func (o *SubscriptionWorkerPoolOptions) validate() error {
+	if o.Workers < 1 {
+		return invalidArgumentError("Workers must be strictly positive, got %d", o.Workers)
+	}
+
+	if o.QueueSize < 1 {
+		return invalidArgumentError("QueueSize must be strictly positive, got %d", o.QueueSize)
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package esdb
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscriptionWorkerPoolPreservesPartitionOrder(t *testing.T) {
+	responses := streamEventResponses("orders", 0, 1)
+	responses = append(responses, streamEventResponses("invoices", 0)...)
+	responses = append(responses, streamEventResponses("orders", 2)...)
+	responses = append(responses, streamEventResponses("invoices", 1, 2)...)
+
+	client := &Client{grpcClient: &grpcClient{logger: &logger{}}}
+	sub := NewSubscription(client, func() {}, &scriptedReadClient{responses: responses}, "id")
+
+	var lock sync.Mutex
+	handled := map[string][]uint64{}
+	pool, err := NewSubscriptionWorkerPool(sub, func(_ context.Context, event *ResolvedEvent) error {
+		lock.Lock()
+		defer lock.Unlock()
+		handled[event.OriginalEvent().StreamID] = append(handled[event.OriginalEvent().StreamID], event.OriginalEvent().EventNumber)
+		return nil
+	}, SubscriptionWorkerPoolOptions{Workers: 3})
+	require.NoError(t, err)
+
+	// The scripted subscription ends with a server error once every event got received.
+	err = pool.Run(context.Background())
+	assert.Contains(t, err.Error(), "subscription dropped")
+
+	assert.Equal(t, map[string][]uint64{"orders": {0, 1, 2}, "invoices": {0, 1, 2}}, handled)
+
+	checkpoint, ok := pool.Checkpoint()
+	require.True(t, ok)
+	assert.Equal(t, "invoices", checkpoint.OriginalEvent().StreamID)
+	assert.Equal(t, uint64(2), checkpoint.OriginalEvent().EventNumber)
+	assert.Equal(t, 0, pool.InFlight())
+}
+
+func TestSubscriptionWorkerPoolHandlerFailure(t *testing.T) {
+	failure := errors.New("boom")
+	pool, err := NewSubscriptionWorkerPool(newScriptedSubscription(), func(context.Context, *ResolvedEvent) error {
+		return failure
+	}, SubscriptionWorkerPoolOptions{})
+	require.NoError(t, err)
+
+	err = pool.Run(context.Background())
+	assert.ErrorIs(t, err, failure)
+
+	_, ok := pool.Checkpoint()
+	assert.False(t, ok)
+}
+
+func TestSubscriptionWorkerPoolCheckpointWaitsForEarlierEvents(t *testing.T) {
+	pool := &SubscriptionWorkerPool{}
+	for seq, revision := range []uint64{0, 1, 2} {
+		pool.inFlight = append(pool.inFlight, workerPoolItem{seq: uint64(seq), event: &ResolvedEvent{Event: &RecordedEvent{EventNumber: revision}}})
+	}
+
+	pool.handled(1)
+	pool.handled(2)
+	_, ok := pool.Checkpoint()
+	assert.False(t, ok)
+	assert.Equal(t, 3, pool.InFlight())
+
+	pool.handled(0)
+	checkpoint, ok := pool.Checkpoint()
+	require.True(t, ok)
+	assert.Equal(t, uint64(2), checkpoint.OriginalEvent().EventNumber)
+	assert.Equal(t, 0, pool.InFlight())
+}
+
+func TestSubscriptionWorkerPoolOptionsValidation(t *testing.T) {
+	_, err := NewSubscriptionWorkerPool(newScriptedSubscription(), nil, SubscriptionWorkerPoolOptions{Workers: -1})
+	assertInvalidArgument(t, err)
+}
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	api "github.com/EventStore/EventStore-Client-Go/v2/protos/streams"
+	"google.golang.org/grpc/metadata"
 )
 
 type request struct {
@@ -20,6 +22,44 @@ type Subscription struct {
 	cancel context.CancelFunc
 	once   *sync.Once
 	closed *int32
+	// Closed along with the subscription, unblocking the goroutine started by EventsChan.
+	done chan struct{}
+	// Trailers of the gRPC call, nil when the subscription wasn't created by the client.
+	trailers *metadata.MD
+	// Only set for subscriptions to a stream filtering events on the client.
+	filter *eventFilter
+	// Only set for subscriptions to $all with a CheckpointReached callback, called with ctx.
+	ctx          context.Context
+	onCheckpoint func(context.Context, Position) error
+	// Tells when the subscription replayed past events.
+	live *subscriptionLive
+	// Unix time in nanoseconds of the last message received.
+	lastMessage *int64
+	// Unix time in nanoseconds since which the call waits on the server, or 0 while a message waits for Recv. Only
+	// set when stalls are monitored, which makes the call receive its messages in the background.
+	waitingSince *int64
+	// Reopens the call from a resume point, or from where the subscription started when nil, after the server went
+	// into maintenance. Nil when the subscription wasn't created by the client.
+	reopen       func(from *resumePoint) (*openedSubscription, error)
+	resumeFrom   *resumePoint
+	connection   *connectionHandle
+	confirmation SubscriptionConfirmation
+	stats        *subscriptionStats
+	// Set for subscriptions to $all, whose stats track positions rather than revisions.
+	toAll bool
+	// Reads the end of the stream or $all subscribed to, nil when the subscription wasn't created by the client.
+	readHead func(context.Context) (*resumePoint, error)
+	// Last event before the start of the subscription, nil when it starts from the beginning.
+	start *resumePoint
+	// Closed by Resume, nil unless paused. Guarded by pauseLock, along with released.
+	resumed        chan struct{}
+	released       bool
+	releaseOnPause bool
+	pauseLock      sync.Mutex
+	// Releases the resources dedicated to the subscription once closed, nil when it has none.
+	release func()
+	// Guards cancel, which changes when resubscribing.
+	lock sync.Mutex
 }
 
 func NewSubscription(client *Client, cancel context.CancelFunc, inner api.Streams_ReadClient, id string) *Subscription {
@@ -27,6 +67,7 @@ func NewSubscription(client *Client, cancel context.CancelFunc, inner api.Stream
 	closed := new(int32)
 
 	atomic.StoreInt32(closed, 0)
+	lastMessage := time.Now().UnixNano()
 
 	return &Subscription{
 		client: client,
@@ -35,6 +76,11 @@ func NewSubscription(client *Client, cancel context.CancelFunc, inner api.Stream
 		once:   once,
 		closed: closed,
 		cancel: cancel,
+		done:   make(chan struct{}),
+		live:   newSubscriptionLive(),
+		stats:  &subscriptionStats{},
+
+		lastMessage: &lastMessage,
 	}
 }
 
@@ -44,28 +90,101 @@ func (sub *Subscription) Id() string {
 
 func (sub *Subscription) Close() error {
 	sub.once.Do(func() {
+		sub.lock.Lock()
+		defer sub.lock.Unlock()
+
 		atomic.StoreInt32(sub.closed, 1)
+		close(sub.done)
 		sub.cancel()
+		if sub.release != nil {
+			sub.release()
+		}
 	})
 
 	return nil
 }
 
 func (sub *Subscription) Recv() *SubscriptionEvent {
+	for {
+		event := sub.recv()
+
+		sub.live.observe(event)
+		sub.track(event)
+
+		if sub.filter != nil && event.EventAppeared != nil && !sub.filter.matches(event.EventAppeared) {
+			continue
+		}
+
+		if sub.onCheckpoint != nil && event.CheckPointReached != nil {
+			if err := sub.onCheckpoint(sub.ctx, *event.CheckPointReached); err != nil {
+				_ = sub.Close()
+
+				return &SubscriptionEvent{
+					SubscriptionDropped: &SubscriptionDropped{
+						Error:  fmt.Errorf("checkpoint callback failed at position %v: %w", *event.CheckPointReached, err),
+						Reason: SubscriptionDropHandlerError,
+					},
+				}
+			}
+		}
+
+		return event
+	}
+}
+
+func (sub *Subscription) recv() *SubscriptionEvent {
 	if atomic.LoadInt32(sub.closed) != 0 {
 		return &SubscriptionEvent{
 			SubscriptionDropped: &SubscriptionDropped{
-				Error: fmt.Errorf("subscription has been dropped"),
+				Error:  fmt.Errorf("subscription has been dropped"),
+				Reason: SubscriptionDropUnsubscribed,
 			},
 		}
 	}
 
-	result, err := sub.inner.Recv()
+	var result *api.ReadResp
+	var err, resubscribeErr error
+	for {
+		if !sub.waitResumed() {
+			return sub.recv()
+		}
+
+		if sub.takeReleased() {
+			if resubscribeErr = sub.resubscribe(false); resubscribeErr != nil {
+				err = fmt.Errorf("failed to resume the subscription")
+				break
+			}
+		}
+
+		result, err = sub.inner.Recv()
+		if err == nil || sub.reopen == nil || atomic.LoadInt32(sub.closed) != 0 {
+			break
+		}
+
+		// The call got cancelled by Pause, it is reopened once resumed.
+		if sub.isReleased() {
+			continue
+		}
+
+		if !serverMaintenance(err, sub.trailers) {
+			break
+		}
+
+		sub.client.grpcClient.logger.info("subscription %s interrupted by server maintenance, resubscribing. Reason: %v", sub.id, err)
+		if resubscribeErr = sub.resubscribe(true); resubscribeErr != nil {
+			break
+		}
+	}
+
 	if err != nil {
 		sub.client.grpcClient.logger.error("subscription has dropped. Reason: %v", err)
 
 		dropped := SubscriptionDropped{
-			Error: err,
+			Error:  err,
+			Reason: dropReason(err, sub.trailers),
+		}
+		if resubscribeErr != nil {
+			dropped.Error = fmt.Errorf("%w, resubscribing failed: %v", err, resubscribeErr)
 		}
 
 		atomic.StoreInt32(sub.closed, 1)
@@ -74,6 +193,14 @@ func (sub *Subscription) Recv() *SubscriptionEvent {
 		}
 	}
 
+	sub.messageReceived()
+
+	event := toSubscriptionEvent(result)
+	sub.stats.received(result, event)
+	return event
+}
+
+func toSubscriptionEvent(result *api.ReadResp) *SubscriptionEvent {
 	switch result.Content.(type) {
 	case *api.ReadResp_Checkpoint_:
 		{
@@ -94,7 +221,81 @@ func (sub *Subscription) Recv() *SubscriptionEvent {
 				EventAppeared: &resolvedEvent,
 			}
 		}
+	case *api.ReadResp_CaughtUp_:
+		return &SubscriptionEvent{CaughtUp: &SubscriptionCaughtUp{}}
+	case *api.ReadResp_FellBehind_:
+		return &SubscriptionEvent{FellBehind: &SubscriptionFellBehind{}}
 	}
 
 	panic("unreachable code")
 }
+
+// EventsChan receives the messages of the subscription in a background goroutine and returns a channel delivering
+// them, so they can be selected along with other channels. When the subscription drops, its SubscriptionDropped is
+// delivered before the channel is closed. Cancelling the context closes the subscription, and the channel is closed
+// without delivering anything else once the context is cancelled or the subscription closed.
+func (sub *Subscription) EventsChan(ctx context.Context) <-chan *SubscriptionEvent {
+	events := make(chan *SubscriptionEvent)
+	stop := closeOnDone(ctx, func() {
+		_ = sub.Close()
+	})
+
+	go func() {
+		defer close(events)
+		defer stop()
+
+		for {
+			event := sub.Recv()
+
+			if atomic.LoadInt32(sub.closed) != 0 && event.SubscriptionDropped != nil && event.SubscriptionDropped.Reason == SubscriptionDropUnsubscribed {
+				return
+			}
+
+			select {
+			case events <- event:
+			case <-sub.done:
+				return
+			}
+
+			if event.SubscriptionDropped != nil {
+				return
+			}
+		}
+	}()
+
+	return events
+}
+
+// ForEach calls fn for every event delivered by the subscription until the subscription drops, fn returns an error
+// or the context gets cancelled. Checkpoints and caught-up or fell-behind notifications are skipped. The subscription
+// is closed if the context gets cancelled, otherwise closing it is left to the caller.
+func (sub *Subscription) ForEach(ctx context.Context, fn func(*ResolvedEvent) error) error {
+	stop := closeOnDone(ctx, func() {
+		_ = sub.Close()
+	})
+	defer stop()
+
+	var count int
+	for {
+		event := sub.Recv()
+
+		if ctx.Err() != nil {
+			return fmt.Errorf("subscription stopped after %d events: %w", count, ctx.Err())
+		}
+
+		if event.SubscriptionDropped != nil {
+			return fmt.Errorf("subscription dropped after %d events: %w", count, event.SubscriptionDropped.Error)
+		}
+
+		if event.EventAppeared == nil {
+			continue
+		}
+
+		if err := fn(event.EventAppeared); err != nil {
+			original := event.EventAppeared.OriginalEvent()
+			return fmt.Errorf("processing event %d of stream '%s' failed: %w", original.EventNumber, original.StreamID, err)
+		}
+
+		count++
+	}
+}
@@ -20,6 +20,12 @@ func SubscriptionTests(t *testing.T, emptyDBClient *esdb.Client, populatedDBClie
 		t.Run("allSubscriptionWithFilterDeliversCorrectEvents", allSubscriptionWithFilterDeliversCorrectEvents(populatedDBClient))
 		t.Run("subscriptionAllFilter", subscriptionAllFilter(emptyDBClient))
 		t.Run("connectionClosing", connectionClosing(populatedDBClient))
+		t.Run("waitForStreamToExist", waitForStreamToExist(emptyDBClient))
+		t.Run("waitForEventTimesOut", waitForEventTimesOut(emptyDBClient))
+		t.Run("subscriptionForEachStopsOnCancel", subscriptionForEachStopsOnCancel(emptyDBClient))
+		t.Run("readAndSubscribeDeliversEveryEventOnce", readAndSubscribeDeliversEveryEventOnce(emptyDBClient))
+		t.Run("subscriptionFromEndConfirmsItsStart", subscriptionFromEndConfirmsItsStart(emptyDBClient))
+		t.Run("subscriptionFromEndPausedBeforeItsFirstEvent", subscriptionFromEndPausedBeforeItsFirstEvent(emptyDBClient))
 	})
 }
 
@@ -226,3 +232,144 @@ func waitWithTimeout(wg *sync.WaitGroup, duration time.Duration) bool {
 		return true
 	}
 }
+
+func waitForStreamToExist(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		streamID := NAME_GENERATOR.Generate()
+
+		go func() {
+			time.Sleep(500 * time.Millisecond)
+			_, _ = db.AppendToStream(context.Background(), streamID, esdb.AppendToStreamOptions{}, createTestEvent())
+		}()
+
+		err := db.WaitForStreamToExist(context.Background(), streamID, esdb.WaitForStreamToExistOptions{
+			Timeout: 5 * time.Second,
+		})
+
+		require.NoError(t, err)
+	}
+}
+
+func waitForEventTimesOut(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		streamID := NAME_GENERATOR.Generate()
+
+		_, err := db.AppendToStream(context.Background(), streamID, esdb.AppendToStreamOptions{}, createTestEvent())
+		require.NoError(t, err)
+
+		_, err = db.WaitForEvent(context.Background(), streamID, esdb.WaitForEventOptions{
+			Timeout: time.Second,
+		}, func(event *esdb.ResolvedEvent) bool {
+			return event.OriginalEvent().EventType == "NeverAppended"
+		})
+
+		esdbErr, ok := esdb.FromError(err)
+		require.False(t, ok)
+		require.Equal(t, esdb.ErrorDeadlineExceeded, esdbErr.Code())
+	}
+}
+
+func subscriptionForEachStopsOnCancel(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		streamID := NAME_GENERATOR.Generate()
+
+		_, err := db.AppendToStream(context.Background(), streamID, esdb.AppendToStreamOptions{}, testCreateEvents(2)...)
+		require.NoError(t, err)
+
+		subscription, err := db.SubscribeToStream(context.Background(), streamID, esdb.SubscribeToStreamOptions{
+			From: esdb.Start{},
+		})
+		require.NoError(t, err)
+		defer subscription.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		count := 0
+		err = subscription.ForEach(ctx, func(*esdb.ResolvedEvent) error {
+			count++
+			return nil
+		})
+
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.Equal(t, 2, count)
+	}
+}
+
+func readAndSubscribeDeliversEveryEventOnce(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		streamID := NAME_GENERATOR.Generate()
+
+		_, err := db.AppendToStream(context.Background(), streamID, esdb.AppendToStreamOptions{}, createTestEvent(), createTestEvent(), createTestEvent())
+		require.NoError(t, err)
+
+		sub, err := db.ReadAndSubscribe(context.Background(), streamID, esdb.ReadAndSubscribeOptions{From: esdb.Revision(1)})
+		require.NoError(t, err)
+		defer sub.Close()
+
+		for expected := uint64(1); expected < 5; expected++ {
+			if expected == 3 {
+				_, err = db.AppendToStream(context.Background(), streamID, esdb.AppendToStreamOptions{}, createTestEvent(), createTestEvent())
+				require.NoError(t, err)
+			}
+
+			event := sub.Recv()
+			for event.EventAppeared == nil {
+				require.Nil(t, event.SubscriptionDropped)
+				event = sub.Recv()
+			}
+
+			require.Equal(t, expected, event.EventAppeared.OriginalEvent().EventNumber)
+		}
+	}
+}
+
+func subscriptionFromEndConfirmsItsStart(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		streamID := NAME_GENERATOR.Generate()
+
+		_, err := db.AppendToStream(context.Background(), streamID, esdb.AppendToStreamOptions{}, createTestEvent(), createTestEvent())
+		require.NoError(t, err)
+
+		var confirmed esdb.SubscriptionConfirmation
+		sub, err := db.SubscribeToStream(context.Background(), streamID, esdb.SubscribeToStreamOptions{
+			From:       esdb.End{},
+			ResolveEnd: true,
+			OnConfirmed: func(confirmation esdb.SubscriptionConfirmation) {
+				confirmed = confirmation
+			},
+		})
+		require.NoError(t, err)
+		defer sub.Close()
+
+		require.NotNil(t, confirmed.Revision)
+		require.Equal(t, uint64(1), *confirmed.Revision)
+		require.Equal(t, sub.Id(), confirmed.SubscriptionID)
+		require.Equal(t, confirmed, sub.Confirmation())
+	}
+}
+
+func subscriptionFromEndPausedBeforeItsFirstEvent(db *esdb.Client) TestCall {
+	return func(t *testing.T) {
+		streamID := NAME_GENERATOR.Generate()
+
+		_, err := db.AppendToStream(context.Background(), streamID, esdb.AppendToStreamOptions{}, createTestEvent())
+		require.NoError(t, err)
+
+		sub, err := db.SubscribeToStream(context.Background(), streamID, esdb.SubscribeToStreamOptions{
+			From:           esdb.End{},
+			ReleaseOnPause: true,
+		})
+		require.NoError(t, err)
+		defer sub.Close()
+
+		sub.Pause()
+		_, err = db.AppendToStream(context.Background(), streamID, esdb.AppendToStreamOptions{}, createTestEvent())
+		require.NoError(t, err)
+		sub.Resume()
+
+		event := sub.Recv()
+		require.NotNil(t, event.EventAppeared)
+		require.Equal(t, uint64(1), event.EventAppeared.OriginalEvent().EventNumber)
+	}
+}
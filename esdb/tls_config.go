@@ -0,0 +1,122 @@
+package esdb
+
+import (
+	"crypto/tls"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FIPSCipherSuites are the TLS 1.2 cipher suites approved by FIPS 140-2: ECDHE key exchange with AES-GCM. Under a
+// boringcrypto build importing crypto/tls/fipsonly, crypto/tls enforces that set and the allowed TLS versions on its
+// own, restricting TLSCipherSuites further rather than overriding it.
+var FIPSCipherSuites = []uint16{
+	tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+	tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+	tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+	tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+}
+
+// tlsConfig returns the TLS configuration used to connect, after validating the TLS related settings.
+func (conf *Configuration) tlsConfig() (*tls.Config, error) {
+	if conf.TLSMinVersion != 0 && !isSupportedTLSVersion(conf.TLSMinVersion) {
+		return nil, invalidArgumentError("unsupported minimum TLS version %#04x", conf.TLSMinVersion)
+	}
+
+	if conf.TLSMaxVersion != 0 && !isSupportedTLSVersion(conf.TLSMaxVersion) {
+		return nil, invalidArgumentError("unsupported maximum TLS version %#04x", conf.TLSMaxVersion)
+	}
+
+	if conf.TLSMinVersion != 0 && conf.TLSMaxVersion != 0 && conf.TLSMinVersion > conf.TLSMaxVersion {
+		return nil, invalidArgumentError("minimum TLS version is greater than the maximum TLS version")
+	}
+
+	if len(conf.TLSCipherSuites) > 0 && conf.TLSMinVersion == tls.VersionTLS13 {
+		return nil, invalidArgumentError("cipher suites can't be restricted when only TLS 1.3 is allowed")
+	}
+
+	for _, id := range conf.TLSCipherSuites {
+		if !isSecureCipherSuite(id) {
+			return nil, invalidArgumentError("cipher suite %s is not supported or insecure", tls.CipherSuiteName(id))
+		}
+	}
+
+	return &tls.Config{
+		InsecureSkipVerify: conf.SkipCertificateVerification,
+		RootCAs:            conf.RootCAs,
+		MinVersion:         conf.TLSMinVersion,
+		MaxVersion:         conf.TLSMaxVersion,
+		CipherSuites:       conf.TLSCipherSuites,
+	}, nil
+}
+
+func isSupportedTLSVersion(version uint16) bool {
+	return version == tls.VersionTLS12 || version == tls.VersionTLS13
+}
+
+func isSecureCipherSuite(id uint16) bool {
+	for _, suite := range tls.CipherSuites() {
+		if suite.ID == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parseTLSVersion(k, v string, version *uint16) error {
+	switch v {
+	case "1.2":
+		*version = tls.VersionTLS12
+	case "1.3":
+		*version = tls.VersionTLS13
+	default:
+		return fmt.Errorf("Setting '%s' must be either 1.2 or 1.3", k)
+	}
+
+	return nil
+}
+
+// parseCipherSuites parses a comma separated list of cipher suite names, as named by crypto/tls, or "fips".
+func parseCipherSuites(k, v string, config *Configuration) error {
+	if strings.EqualFold(v, "fips") {
+		config.TLSCipherSuites = append([]uint16(nil), FIPSCipherSuites...)
+		return nil
+	}
+
+	var suites []uint16
+	for _, name := range strings.Split(v, ",") {
+		found := false
+
+		for _, suite := range tls.CipherSuites() {
+			if suite.Name == name {
+				suites = append(suites, suite.ID)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("Setting '%s' contains an unknown or insecure cipher suite: '%s'", k, name)
+		}
+	}
+
+	config.TLSCipherSuites = suites
+	return nil
+}
+
+// isTLSHandshakeError tells if the gRPC call failed because no TLS connection could be established.
+func isTLSHandshakeError(err error) bool {
+	st, ok := status.FromError(err)
+	return ok && st.Code() == codes.Unavailable && strings.Contains(st.Message(), "handshake")
+}
+
+func tlsHandshakeError(err error) error {
+	return &Error{
+		code: ErrorTLSHandshake,
+		err: fmt.Errorf("%s (check the server certificate, the root CAs and that the server supports the allowed TLS "+
+			"versions and cipher suites)", status.Convert(err).Message()),
+	}
+}
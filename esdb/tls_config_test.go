@@ -0,0 +1,24 @@
+package esdb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTLSHandshakeErrorDetection(t *testing.T) {
+	handshake := status.Error(codes.Unavailable, `connection error: desc = "transport: authentication handshake failed: tls: no cipher suite supported by both client and server"`)
+	assert.True(t, isTLSHandshakeError(handshake))
+	assert.Equal(t, ErrorTLSHandshake, errToCode(handshake))
+
+	var esdbErr *Error
+	assert.True(t, errors.As(tlsHandshakeError(handshake), &esdbErr))
+	assert.Equal(t, ErrorTLSHandshake, esdbErr.Code())
+	assert.Contains(t, esdbErr.Error(), "no cipher suite supported")
+
+	assert.False(t, isTLSHandshakeError(status.Error(codes.Unavailable, "connection refused")))
+	assert.False(t, isTLSHandshakeError(errors.New("handshake")))
+}
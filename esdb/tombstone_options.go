@@ -6,6 +6,8 @@ type TombstoneStreamOptions struct {
 	ExpectedRevision ExpectedRevision
 	Authenticated    *Credentials
 	Deadline         *time.Duration
+	// Attaches the gRPC headers and trailers of the response to the result.
+	ReturnResponseMetadata bool
 }
 
 func (o *TombstoneStreamOptions) kind() operationKind {
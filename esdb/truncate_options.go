@@ -0,0 +1,20 @@
+package esdb
+
+import "time"
+
+type TruncateStreamOptions struct {
+	Authenticated *Credentials
+	Deadline      *time.Duration
+}
+
+func (o *TruncateStreamOptions) kind() operationKind {
+	return RegularOperation
+}
+
+func (o *TruncateStreamOptions) credentials() *Credentials {
+	return o.Authenticated
+}
+
+func (o *TruncateStreamOptions) deadline() *time.Duration {
+	return o.Deadline
+}
@@ -0,0 +1,42 @@
+package esdb
+
+import (
+	"context"
+)
+
+// TypedAppendOptions configures Append.
+type TypedAppendOptions struct {
+	AppendToStreamOptions
+	// Serializes the values. Defaults to JSONCodec.
+	Codec Codec
+}
+
+func (o *TypedAppendOptions) setDefaults() {
+	if o.Codec == nil {
+		o.Codec = JSONCodec{}
+	}
+}
+
+// Append serializes values with the codec of the options and appends them to a stream. The event type of every
+// value is given by EventTypeOf.
+func Append[T any](
+	ctx context.Context,
+	client *Client,
+	streamID string,
+	opts TypedAppendOptions,
+	values ...T,
+) (*WriteResult, error) {
+	opts.setDefaults()
+
+	events := make([]EventData, 0, len(values))
+	for _, value := range values {
+		event, err := encodeValue(opts.Codec, value)
+		if err != nil {
+			return nil, &Error{code: ErrorParsing, err: err}
+		}
+
+		events = append(events, event)
+	}
+
+	return client.AppendToStream(ctx, streamID, opts.AppendToStreamOptions, events...)
+}
@@ -17,6 +17,52 @@ const (
 	ConsumerStrategy_PinnedByCorrelation ConsumerStrategy = "PinnedByCorrelation"
 )
 
+var consumerStrategies = []ConsumerStrategy{
+	ConsumerStrategy_RoundRobin,
+	ConsumerStrategy_DispatchToSingle,
+	ConsumerStrategy_Pinned,
+	ConsumerStrategy_PinnedByCorrelation,
+}
+
+// ParseConsumerStrategy returns the consumer strategy with the given name, ignoring case.
+func ParseConsumerStrategy(name string) (ConsumerStrategy, error) {
+	for _, strategy := range consumerStrategies {
+		if strings.EqualFold(name, string(strategy)) {
+			return strategy, nil
+		}
+	}
+
+	return "", invalidArgumentError("unknown consumer strategy '%s'", name)
+}
+
+func (s ConsumerStrategy) validate() error {
+	for _, strategy := range consumerStrategies {
+		if s == strategy {
+			return nil
+		}
+	}
+
+	return invalidArgumentError("unknown consumer strategy '%s', expected one of %v", s, consumerStrategies)
+}
+
+// checkSupported fails when the server doesn't support the strategy. PinnedByCorrelation is only known by servers
+// supporting persistent subscriptions to $all, which introduced it, and can only be set on creation.
+func (s ConsumerStrategy) checkSupported(handle *connectionHandle, update bool) error {
+	if s != ConsumerStrategy_PinnedByCorrelation {
+		return nil
+	}
+
+	if update {
+		return &Error{code: ErrorUnsupportedFeature, err: fmt.Errorf("the %s consumer strategy can only be set when creating a persistent subscription", s)}
+	}
+
+	if !handle.SupportsFeature(FEATURE_PERSISTENT_SUBSCRIPTION_TO_ALL) {
+		return &Error{code: ErrorUnsupportedFeature, err: fmt.Errorf("the %s consumer strategy isn't supported by the server", s)}
+	}
+
+	return nil
+}
+
 type SubscriptionSettings struct {
 	StartFrom            interface{}
 	ResolveLinkTos       bool
@@ -50,6 +96,51 @@ func SubscriptionSettingsDefault() SubscriptionSettings {
 	}
 }
 
+// withDefaultStrategy returns a copy of the settings using RoundRobin when no consumer strategy is set, like the
+// server does.
+func (s *SubscriptionSettings) withDefaultStrategy() *SubscriptionSettings {
+	if s == nil || s.ConsumerStrategyName != "" {
+		return s
+	}
+
+	settings := *s
+	settings.ConsumerStrategyName = ConsumerStrategy_RoundRobin
+	return &settings
+}
+
+func (s SubscriptionSettings) validate() error {
+	nonNegative := []struct {
+		name  string
+		value int32
+	}{
+		{"MaxRetryCount", s.MaxRetryCount},
+		{"CheckpointLowerBound", s.CheckpointLowerBound},
+		{"CheckpointUpperBound", s.CheckpointUpperBound},
+		{"MaxSubscriberCount", s.MaxSubscriberCount},
+		{"LiveBufferSize", s.LiveBufferSize},
+		{"ReadBatchSize", s.ReadBatchSize},
+		{"HistoryBufferSize", s.HistoryBufferSize},
+		{"MessageTimeout", s.MessageTimeout},
+		{"CheckpointAfter", s.CheckpointAfter},
+	}
+
+	for _, setting := range nonNegative {
+		if setting.value < 0 {
+			return invalidArgumentError("persistent subscription setting %s must not be negative, got %d", setting.name, setting.value)
+		}
+	}
+
+	if s.CheckpointLowerBound > s.CheckpointUpperBound {
+		return invalidArgumentError("persistent subscription setting CheckpointLowerBound (%d) must not exceed CheckpointUpperBound (%d)", s.CheckpointLowerBound, s.CheckpointUpperBound)
+	}
+
+	if s.ConsumerStrategyName == "" {
+		return nil
+	}
+
+	return s.ConsumerStrategyName.validate()
+}
+
 // Position ...
 type Position struct {
 	Commit  uint64
@@ -80,12 +171,21 @@ const (
 	SystemStreamAcl = "$systemStreamAcl"
 )
 
+// System roles that can be granted in an Acl.
+const (
+	RoleAll        = "$all"
+	RoleAdmins     = "$admins"
+	RoleOperations = "$ops"
+)
+
 type Acl struct {
 	readRoles      []string
 	writeRoles     []string
 	deleteRoles    []string
 	metaReadRoles  []string
 	metaWriteRoles []string
+	// Keys the client doesn't know about, kept so they are written back as-is.
+	unknownProperties map[string]interface{}
 }
 
 func (a *Acl) AddReadRoles(roles ...string) {
@@ -135,6 +235,8 @@ type StreamMetadata struct {
 	cacheControl     []time.Duration
 	acl              []interface{}
 	customProperties map[string]interface{}
+	// Reserved ($-prefixed) keys the client doesn't know about, kept so they are written back as-is.
+	unknownProperties map[string]interface{}
 }
 
 func (m *StreamMetadata) SetMaxCount(value uint64) {
@@ -149,6 +251,10 @@ func (m *StreamMetadata) SetTruncateBefore(value uint64) {
 	m.truncateBefore = []uint64{value}
 }
 
+func (m *StreamMetadata) ClearTruncateBefore() {
+	m.truncateBefore = nil
+}
+
 func (m *StreamMetadata) SetCacheControl(value time.Duration) {
 	m.cacheControl = []time.Duration{value}
 }
@@ -165,6 +271,25 @@ func (m *StreamMetadata) AddCustomProperty(name string, value interface{}) {
 	m.customProperties[name] = value
 }
 
+func (m *StreamMetadata) CustomProperty(name string) (interface{}, bool) {
+	value, ok := m.customProperties[name]
+	return value, ok
+}
+
+func (m *StreamMetadata) CustomProperties() map[string]interface{} {
+	props := make(map[string]interface{}, len(m.customProperties))
+
+	for key, value := range m.customProperties {
+		props[key] = value
+	}
+
+	return props
+}
+
+func (m *StreamMetadata) RemoveCustomProperty(name string) {
+	delete(m.customProperties, name)
+}
+
 func (m *StreamMetadata) MaxCount() *uint64 {
 	if len(m.maxCount) == 0 {
 		return nil
@@ -263,6 +388,20 @@ func collectRoles(value interface{}) ([]string, error) {
 		return []string{roleValue}, nil
 	case []string:
 		return roleValue, nil
+	case []interface{}:
+		roles := make([]string, 0, len(roleValue))
+
+		for _, role := range roleValue {
+			str, ok := role.(string)
+
+			if !ok {
+				return nil, fmt.Errorf("invalid acl role value: %v", role)
+			}
+
+			roles = append(roles, str)
+		}
+
+		return roles, nil
 	default:
 		return nil, fmt.Errorf("invalid acl role value: %v", roleValue)
 	}
@@ -277,6 +416,10 @@ func (a Acl) ToMap() map[string]interface{} {
 	flattenRoles(props, "$mr", a.metaReadRoles)
 	flattenRoles(props, "$mw", a.metaWriteRoles)
 
+	for key, value := range a.unknownProperties {
+		props[key] = value
+	}
+
 	return props
 }
 
@@ -326,7 +469,11 @@ func AclFromMap(props map[string]interface{}) (Acl, error) {
 
 			acl.metaWriteRoles = roles
 		default:
-			return acl, fmt.Errorf("unknown acl key: %v", key)
+			if acl.unknownProperties == nil {
+				acl.unknownProperties = make(map[string]interface{})
+			}
+
+			acl.unknownProperties[key] = value
 		}
 	}
 
@@ -375,6 +522,10 @@ func (m StreamMetadata) ToMap() (map[string]interface{}, error) {
 		props[key] = value
 	}
 
+	for key, value := range m.unknownProperties {
+		props[key] = value
+	}
+
 	return props, nil
 }
 
@@ -448,6 +599,15 @@ func StreamMetadataFromMap(props map[string]interface{}) (StreamMetadata, error)
 			}
 
 		default:
+			if strings.HasPrefix(key, "$") {
+				if meta.unknownProperties == nil {
+					meta.unknownProperties = make(map[string]interface{})
+				}
+
+				meta.unknownProperties[key] = value
+				continue
+			}
+
 			meta.AddCustomProperty(key, value)
 		}
 	}
@@ -563,6 +723,18 @@ type PersistentSubscriptionConnectionInfo struct {
 	ExtraStatistics           []PersistentSubscriptionMeasurement `json:"extraStatistics"`
 }
 
+// ExtraStatistic returns the value of an extra statistic of the connection, only collected when the subscription
+// settings enable ExtraStatistics.
+func (info PersistentSubscriptionConnectionInfo) ExtraStatistic(key string) (int64, bool) {
+	for _, measurement := range info.ExtraStatistics {
+		if measurement.Key == key {
+			return measurement.Value, true
+		}
+	}
+
+	return 0, false
+}
+
 type PersistentSubscriptionMeasurement struct {
 	Key   string `json:"key"`
 	Value int64  `json:"value"`
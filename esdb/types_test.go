@@ -105,3 +105,47 @@ func TestConsistentMetadataSerializationSystemStreamAcl(t *testing.T) {
 
 	assert.Equal(t, expected, meta, "consistency serialization failure")
 }
+
+func TestMetadataPreservesUnknownProperties(t *testing.T) {
+	payload := []byte(`{
+		"$maxCount": 3,
+		"$futureSetting": 12,
+		"$acl": {
+			"$r": ["$admins", "$ops"],
+			"$w": "$admins",
+			"$future": "$all"
+		},
+		"team": "billing"
+	}`)
+
+	var props map[string]interface{}
+
+	err := json.Unmarshal(payload, &props)
+
+	assert.NoError(t, err, "failed to deserializing props")
+
+	meta, err := esdb.StreamMetadataFromMap(props)
+
+	assert.NoError(t, err, "failed to parse Metadata from props")
+
+	acl := meta.StreamAcl()
+
+	assert.NotNil(t, acl)
+	assert.Equal(t, []string{esdb.RoleAdmins, esdb.RoleOperations}, acl.ReadRoles())
+	assert.Equal(t, []string{esdb.RoleAdmins}, acl.WriteRoles())
+
+	team, ok := meta.CustomProperty("team")
+
+	assert.True(t, ok)
+	assert.Equal(t, "billing", team)
+
+	meta.SetMaxAge(time.Hour)
+
+	outProps, err := meta.ToMap()
+
+	assert.NoError(t, err, "failed to generate a map")
+	assert.Equal(t, float64(12), outProps["$futureSetting"])
+	assert.Equal(t, "billing", outProps["team"])
+	assert.Equal(t, int64(3600), outProps["$maxAge"])
+	assert.Equal(t, "$all", outProps["$acl"].(map[string]interface{})["$future"])
+}
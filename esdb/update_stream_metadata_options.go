@@ -0,0 +1,36 @@
+package esdb
+
+import "time"
+
+type UpdateStreamMetadataOptions struct {
+	// The maximum number of times the update is attempted when the metadata stream got written to concurrently.
+	MaxAttempts   int // Defaults to 10.
+	Authenticated *Credentials
+	Deadline      *time.Duration
+}
+
+func (o *UpdateStreamMetadataOptions) kind() operationKind {
+	return RegularOperation
+}
+
+func (o *UpdateStreamMetadataOptions) credentials() *Credentials {
+	return o.Authenticated
+}
+
+func (o *UpdateStreamMetadataOptions) deadline() *time.Duration {
+	return o.Deadline
+}
+
+func (o *UpdateStreamMetadataOptions) setDefaults() {
+	if o.MaxAttempts == 0 {
+		o.MaxAttempts = 10
+	}
+}
+
+func (o *UpdateStreamMetadataOptions) validate() error {
+	if o.MaxAttempts < 1 {
+		return invalidArgumentError("MaxAttempts must be strictly positive, got %d", o.MaxAttempts)
+	}
+
+	return nil
+}
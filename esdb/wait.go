@@ -0,0 +1,75 @@
+package esdb
+
+import (
+	"context"
+	"fmt"
+)
+
+// WaitForStreamToExist blocks until the stream holds at least one event, the timeout elapses or the context gets
+// cancelled. On timeout, an ErrorDeadlineExceeded error is returned.
+func (client *Client) WaitForStreamToExist(
+	ctx context.Context,
+	streamID string,
+	opts WaitForStreamToExistOptions,
+) error {
+	_, err := client.WaitForEvent(ctx, streamID, WaitForEventOptions{
+		Timeout:       opts.Timeout,
+		Authenticated: opts.Authenticated,
+	}, func(*ResolvedEvent) bool {
+		return true
+	})
+
+	return err
+}
+
+// WaitForEvent subscribes to the stream and blocks until an event satisfying the predicate shows up, the timeout
+// elapses or the context gets cancelled. Events already in the stream are considered too, starting at From. On
+// timeout, an ErrorDeadlineExceeded error is returned.
+func (client *Client) WaitForEvent(
+	ctx context.Context,
+	streamID string,
+	opts WaitForEventOptions,
+	predicate func(*ResolvedEvent) bool,
+) (*ResolvedEvent, error) {
+	opts.setDefaults()
+
+	var cancel context.CancelFunc
+	if opts.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	subscription, err := client.SubscribeToStream(ctx, streamID, SubscribeToStreamOptions{
+		From:           opts.From,
+		ResolveLinkTos: opts.ResolveLinkTos,
+		Authenticated:  opts.Authenticated,
+	})
+
+	if err != nil {
+		return nil, waitError(ctx, streamID, err)
+	}
+
+	defer subscription.Close()
+
+	for {
+		event := subscription.Recv()
+
+		if event.SubscriptionDropped != nil {
+			return nil, waitError(ctx, streamID, event.SubscriptionDropped.Error)
+		}
+
+		if event.EventAppeared != nil && predicate(event.EventAppeared) {
+			return event.EventAppeared, nil
+		}
+	}
+}
+
+func waitError(ctx context.Context, streamID string, err error) error {
+	if ctx.Err() == context.DeadlineExceeded {
+		return &Error{code: ErrorDeadlineExceeded, err: fmt.Errorf("timed out waiting on stream '%s'", streamID)}
+	}
+
+	return err
+}
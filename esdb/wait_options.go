@@ -0,0 +1,24 @@
+package esdb
+
+import "time"
+
+type WaitForStreamToExistOptions struct {
+	// How long to wait before giving up. Zero means waiting until the context gets cancelled.
+	Timeout       time.Duration
+	Authenticated *Credentials
+}
+
+type WaitForEventOptions struct {
+	// Where to start looking for a matching event.
+	From           StreamPosition // Defaults to Start.
+	ResolveLinkTos bool
+	// How long to wait before giving up. Zero means waiting until the context gets cancelled.
+	Timeout       time.Duration
+	Authenticated *Credentials
+}
+
+func (o *WaitForEventOptions) setDefaults() {
+	if o.From == nil {
+		o.From = Start{}
+	}
+}
@@ -1,8 +1,69 @@
 package esdb
 
+import (
+	api "github.com/EventStore/EventStore-Client-Go/v2/protos/streams"
+)
+
 // WriteResult ...
 type WriteResult struct {
-	CommitPosition      uint64
-	PreparePosition     uint64
+	// Deprecated: use LogPosition, which tells a missing position apart from position 0.
+	CommitPosition uint64
+	// Deprecated: use LogPosition, which tells a missing position apart from position 0.
+	PreparePosition uint64
+	// Deprecated: use NextExpectedRevision. Set to 1 when the stream doesn't exist.
 	NextExpectedVersion uint64
+	// Position of the write in the transaction log. Nil when the server didn't report it.
+	LogPosition *Position
+	// Revision of the stream after the write, NoStream when the stream still doesn't exist, which happens when
+	// appending no events to a new stream. Can be passed as the ExpectedRevision of the next append.
+	NextExpectedRevision StreamState
+	// Only set when AppendToStreamOptions.ReturnResponseMetadata is.
+	ResponseMetadata *ResponseMetadata
+}
+
+func newWriteResult(revision StreamState, position *Position) *WriteResult {
+	result := &WriteResult{
+		NextExpectedRevision: revision,
+		LogPosition:          position,
+		NextExpectedVersion:  1,
+	}
+
+	if value, ok := revision.(StreamRevision); ok {
+		result.NextExpectedVersion = value.Value
+	}
+
+	if position != nil {
+		result.CommitPosition = position.Commit
+		result.PreparePosition = position.Prepare
+	}
+
+	return result
+}
+
+func writeResultFromAppendProto(success *api.AppendResp_Success) *WriteResult {
+	var revision StreamState = Revision(success.GetCurrentRevision())
+	if success.GetNoStream() != nil {
+		revision = NoStream{}
+	}
+
+	var position *Position
+	if value := success.GetPosition(); value != nil {
+		position = &Position{Commit: value.CommitPosition, Prepare: value.PreparePosition}
+	}
+
+	return newWriteResult(revision, position)
+}
+
+func writeResultFromBatchAppendProto(success *api.BatchAppendResp_Success) *WriteResult {
+	var revision StreamState = Revision(success.GetCurrentRevision())
+	if success.GetNoStream() != nil {
+		revision = NoStream{}
+	}
+
+	var position *Position
+	if value := success.GetPosition(); value != nil {
+		position = &Position{Commit: value.CommitPosition, Prepare: value.PreparePosition}
+	}
+
+	return newWriteResult(revision, position)
 }
@@ -0,0 +1,53 @@
+package esdb
+
+import (
+	"testing"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/protos/shared"
+	api "github.com/EventStore/EventStore-Client-Go/v2/protos/streams"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestWriteResultFromAppendProto(t *testing.T) {
+	position := &api.AppendResp_Success_Position{Position: &api.AppendResp_Position{CommitPosition: 20, PreparePosition: 10}}
+	noPosition := &api.AppendResp_Success_NoPosition{NoPosition: &shared.Empty{}}
+	revision := &api.AppendResp_Success_CurrentRevision{CurrentRevision: 4}
+	noStream := &api.AppendResp_Success_NoStream{NoStream: &shared.Empty{}}
+
+	result := writeResultFromAppendProto(&api.AppendResp_Success{CurrentRevisionOption: revision, PositionOption: position})
+	assert.Equal(t, Revision(4), result.NextExpectedRevision)
+	assert.Equal(t, &Position{Commit: 20, Prepare: 10}, result.LogPosition)
+	assert.Equal(t, uint64(4), result.NextExpectedVersion)
+	assert.Equal(t, uint64(20), result.CommitPosition)
+
+	result = writeResultFromAppendProto(&api.AppendResp_Success{CurrentRevisionOption: revision, PositionOption: noPosition})
+	assert.Equal(t, Revision(4), result.NextExpectedRevision)
+	assert.Nil(t, result.LogPosition)
+	assert.Equal(t, uint64(4), result.NextExpectedVersion)
+
+	result = writeResultFromAppendProto(&api.AppendResp_Success{CurrentRevisionOption: noStream, PositionOption: position})
+	assert.Equal(t, NoStream{}, result.NextExpectedRevision)
+	assert.Equal(t, &Position{Commit: 20, Prepare: 10}, result.LogPosition)
+
+	result = writeResultFromAppendProto(&api.AppendResp_Success{CurrentRevisionOption: noStream, PositionOption: noPosition})
+	assert.Equal(t, NoStream{}, result.NextExpectedRevision)
+	assert.Nil(t, result.LogPosition)
+	assert.Equal(t, uint64(1), result.NextExpectedVersion)
+}
+
+func TestWriteResultFromBatchAppendProto(t *testing.T) {
+	result := writeResultFromBatchAppendProto(&api.BatchAppendResp_Success{
+		CurrentRevisionOption: &api.BatchAppendResp_Success_CurrentRevision{CurrentRevision: 0},
+		PositionOption:        &api.BatchAppendResp_Success_Position{Position: &shared.AllStreamPosition{CommitPosition: 5, PreparePosition: 5}},
+	})
+	assert.Equal(t, Revision(0), result.NextExpectedRevision)
+	assert.Equal(t, &Position{Commit: 5, Prepare: 5}, result.LogPosition)
+
+	result = writeResultFromBatchAppendProto(&api.BatchAppendResp_Success{
+		CurrentRevisionOption: &api.BatchAppendResp_Success_NoStream{NoStream: &emptypb.Empty{}},
+		PositionOption:        &api.BatchAppendResp_Success_NoPosition{NoPosition: &emptypb.Empty{}},
+	})
+	assert.Equal(t, NoStream{}, result.NextExpectedRevision)
+	assert.Nil(t, result.LogPosition)
+}
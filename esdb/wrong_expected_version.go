@@ -0,0 +1,102 @@
+package esdb
+
+import (
+	"fmt"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/protos/shared"
+	api "github.com/EventStore/EventStore-Client-Go/v2/protos/streams"
+)
+
+// StreamState is the actual state of a stream reported by a failed expected revision check. It is either NoStream or
+// a StreamRevision, both of which can be passed back as an ExpectedRevision.
+type StreamState interface {
+	ExpectedRevision
+	isStreamState()
+}
+
+func (r NoStream) isStreamState() {
+}
+
+func (r StreamRevision) isStreamState() {
+}
+
+// WrongExpectedVersionError is the cause of an ErrorWrongExpectedVersion error. Retrieve it with errors.As.
+type WrongExpectedVersionError struct {
+	expected ExpectedRevision
+	actual   StreamState
+}
+
+// Expected returns the revision the operation expected the stream to be at.
+func (e *WrongExpectedVersionError) Expected() ExpectedRevision {
+	return e.expected
+}
+
+// Actual returns the state the stream was in when the operation was rejected.
+func (e *WrongExpectedVersionError) Actual() StreamState {
+	return e.actual
+}
+
+func (e *WrongExpectedVersionError) Error() string {
+	return fmt.Sprintf("wrong expected version: expecting '%s' but got '%s'", revisionString(e.expected), revisionString(e.actual))
+}
+
+func wrongExpectedVersionError(expected ExpectedRevision, actual StreamState) error {
+	return &Error{code: ErrorWrongExpectedVersion, err: &WrongExpectedVersionError{expected: expected, actual: actual}}
+}
+
+func revisionString(revision ExpectedRevision) string {
+	switch value := revision.(type) {
+	case Any:
+		return "any"
+	case NoStream:
+		return "no_stream"
+	case StreamExists:
+		return "stream_exists"
+	case StreamRevision:
+		return fmt.Sprintf("%d", value.Value)
+	}
+
+	return "unknown"
+}
+
+func wrongExpectedVersionFromAppendProto(wrong *api.AppendResp_WrongExpectedVersion) error {
+	var expected ExpectedRevision
+	switch {
+	case wrong.GetExpectedAny() != nil:
+		expected = Any{}
+	case wrong.GetExpectedNoStream() != nil:
+		expected = NoStream{}
+	case wrong.GetExpectedStreamExists() != nil:
+		expected = StreamExists{}
+	default:
+		expected = Revision(wrong.GetExpectedRevision())
+	}
+
+	var actual StreamState = Revision(wrong.GetCurrentRevision())
+	if wrong.GetCurrentNoStream() != nil {
+		actual = NoStream{}
+	}
+
+	return wrongExpectedVersionError(expected, actual)
+}
+
+func wrongExpectedVersionFromProto(wrong *shared.WrongExpectedVersion) error {
+	var expected ExpectedRevision
+	switch {
+	case wrong.GetExpectedAny() != nil:
+		expected = Any{}
+	case wrong.GetExpectedNoStream() != nil:
+		expected = NoStream{}
+	case wrong.GetExpectedStreamExists() != nil:
+		expected = StreamExists{}
+	default:
+		expected = Revision(wrong.GetExpectedStreamPosition())
+	}
+
+	var actual StreamState = Revision(wrong.GetCurrentStreamRevision())
+	if wrong.GetCurrentNoStream() != nil {
+		actual = NoStream{}
+	}
+
+	return wrongExpectedVersionError(expected, actual)
+}
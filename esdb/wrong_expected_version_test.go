@@ -0,0 +1,40 @@
+package esdb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/protos/shared"
+	api "github.com/EventStore/EventStore-Client-Go/v2/protos/streams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestWrongExpectedVersionFromAppendProto(t *testing.T) {
+	err := wrongExpectedVersionFromAppendProto(&api.AppendResp_WrongExpectedVersion{
+		CurrentRevisionOption:  &api.AppendResp_WrongExpectedVersion_CurrentRevision{CurrentRevision: 7},
+		ExpectedRevisionOption: &api.AppendResp_WrongExpectedVersion_ExpectedRevision{ExpectedRevision: 5},
+	})
+
+	esdbErr, _ := FromError(err)
+	assert.Equal(t, ErrorWrongExpectedVersion, esdbErr.Code())
+	assert.Contains(t, err.Error(), "expecting '5' but got '7'")
+
+	var wrongErr *WrongExpectedVersionError
+	require.True(t, errors.As(err, &wrongErr))
+	assert.Equal(t, Revision(5), wrongErr.Expected())
+	assert.Equal(t, Revision(7), wrongErr.Actual())
+}
+
+func TestWrongExpectedVersionFromProto(t *testing.T) {
+	err := wrongExpectedVersionFromProto(&shared.WrongExpectedVersion{
+		CurrentStreamRevisionOption:  &shared.WrongExpectedVersion_CurrentNoStream{CurrentNoStream: &emptypb.Empty{}},
+		ExpectedStreamPositionOption: &shared.WrongExpectedVersion_ExpectedStreamExists{ExpectedStreamExists: &emptypb.Empty{}},
+	})
+
+	var wrongErr *WrongExpectedVersionError
+	require.True(t, errors.As(err, &wrongErr))
+	assert.Equal(t, StreamExists{}, wrongErr.Expected())
+	assert.Equal(t, NoStream{}, wrongErr.Actual())
+}
@@ -0,0 +1,55 @@
+package esdbadmin
+
+import (
+	"testing"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParkedStreamName(t *testing.T) {
+	name := ParkedStreamName("orders", "billing")
+	assert.Equal(t, "$persistentsubscription-orders::billing-parked", name)
+
+	stream, group, ok := parseParkedStreamName(name)
+	assert.True(t, ok)
+	assert.Equal(t, "orders", stream)
+	assert.Equal(t, "billing", group)
+
+	stream, group, ok = parseParkedStreamName(ParkedStreamName("$all", "audit"))
+	assert.True(t, ok)
+	assert.Equal(t, "$all", stream)
+	assert.Equal(t, "audit", group)
+
+	_, _, ok = parseParkedStreamName("$persistentsubscription-orders::billing-checkpoint")
+	assert.False(t, ok)
+}
+
+func TestAuditFindings(t *testing.T) {
+	revision := func(value uint64) *uint64 { return &value }
+
+	healthy := esdb.PersistentSubscriptionInfo{
+		Status:      "Live",
+		Connections: []esdb.PersistentSubscriptionConnectionInfo{{}},
+		Stats: &esdb.PersistentSubscriptionStats{
+			LastCheckpointedEventRevision: revision(90),
+			LastKnownEventRevision:        revision(100),
+		},
+	}
+	assert.Empty(t, audit(healthy, 50))
+
+	unhealthy := esdb.PersistentSubscriptionInfo{
+		Status: "Paused",
+		Stats: &esdb.PersistentSubscriptionStats{
+			LastKnownEventRevision: revision(100),
+			ParkedMessagesCount:    3,
+		},
+	}
+
+	var kinds []FindingKind
+	for _, finding := range audit(unhealthy, 50) {
+		kinds = append(kinds, finding.Kind)
+	}
+
+	assert.Equal(t, []FindingKind{NotLive, NoConsumers, Lagging, HasParkedMessages}, kinds)
+}
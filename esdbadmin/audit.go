@@ -0,0 +1,123 @@
+// Package esdbadmin bundles operational workflows built on the client, for Go-based ops tooling.
+package esdbadmin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+)
+
+type FindingKind int
+
+const (
+	// NotLive means the subscription isn't in the Live status.
+	NotLive FindingKind = iota
+	// NoConsumers means no consumer is connected to the subscription.
+	NoConsumers
+	// Lagging means the last checkpoint is further behind the last known event than AuditOptions.MaxLag.
+	Lagging
+	// HasParkedMessages means the subscription has parked messages.
+	HasParkedMessages
+)
+
+func (k FindingKind) String() string {
+	switch k {
+	case NotLive:
+		return "not live"
+	case NoConsumers:
+		return "no consumers"
+	case Lagging:
+		return "lagging"
+	case HasParkedMessages:
+		return "parked messages"
+	default:
+		return "unknown"
+	}
+}
+
+type Finding struct {
+	Kind    FindingKind
+	Message string
+}
+
+type SubscriptionReport struct {
+	Info     esdb.PersistentSubscriptionInfo
+	Findings []Finding
+}
+
+// Healthy tells if the audit found nothing wrong with the subscription.
+func (r *SubscriptionReport) Healthy() bool {
+	return len(r.Findings) == 0
+}
+
+type AuditOptions struct {
+	// Largest accepted distance between the last checkpointed and the last known event revision of subscriptions to
+	// streams. Subscriptions to $all are not checked since positions aren't event counts.
+	MaxLag        uint64 // Defaults to 1000.
+	Authenticated *esdb.Credentials
+}
+
+func (o *AuditOptions) setDefaults() {
+	if o.MaxLag == 0 {
+		o.MaxLag = 1000
+	}
+}
+
+// AuditSubscriptions checks every persistent subscription of the cluster and reports its findings.
+func AuditSubscriptions(ctx context.Context, client *esdb.Client, opts AuditOptions) ([]SubscriptionReport, error) {
+	opts.setDefaults()
+
+	infos, err := client.ListAllPersistentSubscriptions(ctx, esdb.ListPersistentSubscriptionsOptions{
+		Authenticated: opts.Authenticated,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list persistent subscriptions: %w", err)
+	}
+
+	reports := make([]SubscriptionReport, 0, len(infos))
+	for _, info := range infos {
+		reports = append(reports, SubscriptionReport{
+			Info:     info,
+			Findings: audit(info, opts.MaxLag),
+		})
+	}
+
+	return reports, nil
+}
+
+func audit(info esdb.PersistentSubscriptionInfo, maxLag uint64) []Finding {
+	var findings []Finding
+
+	if info.Status != "" && info.Status != "Live" {
+		findings = append(findings, Finding{Kind: NotLive, Message: fmt.Sprintf("status is %s", info.Status)})
+	}
+
+	if len(info.Connections) == 0 {
+		findings = append(findings, Finding{Kind: NoConsumers, Message: "no connected consumer"})
+	}
+
+	stats := info.Stats
+	if stats == nil {
+		return findings
+	}
+
+	if stats.LastKnownEventRevision != nil {
+		var checkpointed uint64
+		if stats.LastCheckpointedEventRevision != nil {
+			checkpointed = *stats.LastCheckpointedEventRevision + 1
+		}
+
+		known := *stats.LastKnownEventRevision + 1
+		if known > checkpointed && known-checkpointed > maxLag {
+			findings = append(findings, Finding{Kind: Lagging, Message: fmt.Sprintf("%d events behind", known-checkpointed)})
+		}
+	}
+
+	if stats.ParkedMessagesCount > 0 {
+		findings = append(findings, Finding{Kind: HasParkedMessages, Message: fmt.Sprintf("%d parked messages", stats.ParkedMessagesCount)})
+	}
+
+	return findings
+}
@@ -0,0 +1,177 @@
+package esdbadmin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+)
+
+const (
+	parkedStreamPrefix = "$persistentsubscription-"
+	parkedStreamSuffix = "-parked"
+)
+
+// ParkedStreamName returns the stream holding the parked messages of a persistent subscription. Use "$all" as
+// stream for subscriptions to $all.
+func ParkedStreamName(stream, group string) string {
+	return parkedStreamPrefix + stream + "::" + group + parkedStreamSuffix
+}
+
+func parseParkedStreamName(name string) (string, string, bool) {
+	if !strings.HasPrefix(name, parkedStreamPrefix) || !strings.HasSuffix(name, parkedStreamSuffix) {
+		return "", "", false
+	}
+
+	name = strings.TrimSuffix(strings.TrimPrefix(name, parkedStreamPrefix), parkedStreamSuffix)
+	separator := strings.LastIndex(name, "::")
+	if separator < 0 {
+		return "", "", false
+	}
+
+	return name[:separator], name[separator+2:], true
+}
+
+type ParkedStream struct {
+	Stream string
+	Group  string
+	// Name of the parked messages stream.
+	ParkedStream string
+	// Number of parked messages which weren't replayed.
+	Count uint64
+	// When the oldest parked message got parked.
+	Oldest time.Time
+	// Orphaned means the persistent subscription the messages were parked by doesn't exist anymore.
+	Orphaned bool
+}
+
+type ParkedReportOptions struct {
+	Authenticated *esdb.Credentials
+}
+
+// ParkedMessagesReport finds every parked messages stream holding messages, including the ones left behind by deleted
+// persistent subscriptions. It scans $all with a server-side stream filter, up to the position $all is at when the
+// report starts.
+func ParkedMessagesReport(ctx context.Context, client *esdb.Client, opts ParkedReportOptions) ([]ParkedStream, error) {
+	infos, err := client.ListAllPersistentSubscriptions(ctx, esdb.ListPersistentSubscriptionsOptions{
+		Authenticated: opts.Authenticated,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list persistent subscriptions: %w", err)
+	}
+
+	existing := make(map[string]bool, len(infos))
+	for _, info := range infos {
+		existing[ParkedStreamName(info.EventSource, info.GroupName)] = true
+	}
+
+	names, err := findParkedStreams(ctx, client, opts.Authenticated)
+	if err != nil {
+		return nil, err
+	}
+
+	var report []ParkedStream
+	for _, name := range names {
+		stream, group, _ := parseParkedStreamName(name)
+		parked := ParkedStream{
+			Stream:       stream,
+			Group:        group,
+			ParkedStream: name,
+			Orphaned:     !existing[name],
+		}
+
+		if err := countParkedMessages(ctx, client, &parked, opts.Authenticated); err != nil {
+			return nil, err
+		}
+
+		if parked.Count > 0 {
+			report = append(report, parked)
+		}
+	}
+
+	return report, nil
+}
+
+// findParkedStreams returns the sorted names of the parked messages streams which got written to. The filtered read
+// ends at the position $all is at when it starts.
+func findParkedStreams(ctx context.Context, client *esdb.Client, creds *esdb.Credentials) ([]string, error) {
+	stream, err := client.ReadAll(ctx, esdb.ReadAllOptions{
+		From: esdb.Start{},
+		Filter: &esdb.SubscriptionFilter{
+			Type:     esdb.StreamFilterType,
+			Prefixes: []string{parkedStreamPrefix},
+		},
+		Authenticated: creds,
+	}, ^uint64(0))
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer stream.Close()
+
+	found := make(map[string]struct{})
+	for {
+		event, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan $all: %w", err)
+		}
+
+		recorded := event.OriginalEvent()
+		if _, _, ok := parseParkedStreamName(recorded.StreamID); ok {
+			found[recorded.StreamID] = struct{}{}
+		}
+	}
+
+	names := make([]string, 0, len(found))
+	for name := range found {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names, nil
+}
+
+func countParkedMessages(ctx context.Context, client *esdb.Client, parked *ParkedStream, creds *esdb.Credentials) error {
+	stream, err := client.ReadStream(ctx, parked.ParkedStream, esdb.ReadStreamOptions{
+		Authenticated: creds,
+	}, ^uint64(0))
+
+	if err != nil {
+		return err
+	}
+
+	defer stream.Close()
+
+	for {
+		event, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		var esdbErr *esdb.Error
+		if errors.As(err, &esdbErr) && esdbErr.Code() == esdb.ErrorResourceNotFound {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to read parked messages stream '%s': %w", parked.ParkedStream, err)
+		}
+
+		if parked.Count == 0 {
+			parked.Oldest = event.OriginalEvent().CreatedDate
+		}
+
+		parked.Count++
+	}
+}
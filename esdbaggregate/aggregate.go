@@ -0,0 +1,60 @@
+// Package esdbaggregate is an event sourcing convenience layer: aggregates are rebuilt from their stream and their
+// new events are appended with optimistic concurrency control. Events are encoded with esdb.Encode and decoded with
+// esdb.Decode, so every event type must be registered with esdb.RegisterEventType.
+package esdbaggregate
+
+// Aggregate is implemented by types embedding Root.
+type Aggregate interface {
+	// Apply mutates the aggregate state with an event, either read from its stream or newly raised.
+	Apply(event interface{})
+	root() *Root
+}
+
+// Root holds the identity, version and uncommitted events of an aggregate. Embed it in aggregate types.
+type Root struct {
+	id         string
+	version    uint64
+	hasVersion bool
+	changes    []interface{}
+}
+
+func (r *Root) root() *Root {
+	return r
+}
+
+func (r *Root) ID() string {
+	return r.id
+}
+
+func (r *Root) SetID(id string) {
+	r.id = id
+}
+
+// Version returns the revision of the last event of the aggregate stream the aggregate is aware of. The boolean is
+// false when the aggregate was never saved.
+func (r *Root) Version() (uint64, bool) {
+	return r.version, r.hasVersion
+}
+
+// Changes returns the events raised since the aggregate got loaded or saved.
+func (r *Root) Changes() []interface{} {
+	return r.changes
+}
+
+func (r *Root) setVersion(version uint64) {
+	r.version = version
+	r.hasVersion = true
+}
+
+func (r *Root) commit(version uint64) {
+	r.setVersion(version)
+	r.changes = nil
+}
+
+// Raise applies the event to the aggregate and records it as uncommitted.
+func Raise(aggregate Aggregate, event interface{}) {
+	aggregate.Apply(event)
+
+	root := aggregate.root()
+	root.changes = append(root.changes, event)
+}
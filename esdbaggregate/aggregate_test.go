@@ -0,0 +1,108 @@
+package esdbaggregate
+
+import (
+	"testing"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type accountOpened struct {
+	Owner string `json:"owner"`
+}
+
+type deposited struct {
+	Amount int `json:"amount"`
+}
+
+type account struct {
+	Root
+	owner   string
+	balance int
+}
+
+func (a *account) Apply(event interface{}) {
+	switch e := event.(type) {
+	case accountOpened:
+		a.owner = e.Owner
+	case deposited:
+		a.balance += e.Amount
+	}
+}
+
+func registerTestEvents(t *testing.T) {
+	require.NoError(t, esdb.RegisterEventType[accountOpened]("AccountOpened"))
+	require.NoError(t, esdb.RegisterEventType[deposited]("Deposited"))
+	require.NoError(t, esdb.RegisterEventType[accountSnapshot]("AccountSnapshot"))
+}
+
+func TestEventsRoundTrip(t *testing.T) {
+	registerTestEvents(t)
+
+	data, err := esdb.Encode[interface{}](nil, deposited{Amount: 42})
+	require.NoError(t, err)
+	assert.Equal(t, "Deposited", data.EventType)
+	assert.Equal(t, esdb.JsonContentType, data.ContentType)
+
+	event, err := esdb.Decode[interface{}](&esdb.ResolvedEvent{Event: &esdb.RecordedEvent{
+		EventType:   data.EventType,
+		ContentType: "application/json",
+		Data:        data.Data,
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, deposited{Amount: 42}, event)
+
+	_, err = esdb.Decode[interface{}](&esdb.ResolvedEvent{Event: &esdb.RecordedEvent{EventType: "Unknown"}})
+	assert.Error(t, err)
+}
+
+func TestRaiseRecordsChanges(t *testing.T) {
+	acc := &account{}
+	Raise(acc, accountOpened{Owner: "ada"})
+	Raise(acc, deposited{Amount: 10})
+
+	assert.Equal(t, "ada", acc.owner)
+	assert.Equal(t, 10, acc.balance)
+	assert.Len(t, acc.Changes(), 2)
+
+	_, ok := acc.Version()
+	assert.False(t, ok)
+
+	acc.commit(1)
+	version, ok := acc.Version()
+	assert.True(t, ok)
+	assert.Equal(t, uint64(1), version)
+	assert.Empty(t, acc.Changes())
+}
+
+func TestShouldSnapshot(t *testing.T) {
+	repo := NewRepository(nil, func() *account { return &account{} }, RepositoryOptions{SnapshotEvery: 10})
+	assert.False(t, repo.shouldSnapshot(&account{}, 0, false, 20), "account isn't snapshottable")
+
+	snapRepo := NewRepository(nil, func() *snapshotAccount { return &snapshotAccount{} }, RepositoryOptions{SnapshotEvery: 10})
+	assert.False(t, snapRepo.shouldSnapshot(&snapshotAccount{}, 0, false, 8))
+	assert.True(t, snapRepo.shouldSnapshot(&snapshotAccount{}, 0, false, 9))
+	assert.False(t, snapRepo.shouldSnapshot(&snapshotAccount{}, 9, true, 18))
+	assert.True(t, snapRepo.shouldSnapshot(&snapshotAccount{}, 18, true, 25))
+}
+
+type accountSnapshot struct {
+	Owner   string `json:"owner"`
+	Balance int    `json:"balance"`
+}
+
+type snapshotAccount struct {
+	account
+}
+
+func (a *snapshotAccount) Snapshot() (interface{}, error) {
+	return accountSnapshot{Owner: a.owner, Balance: a.balance}, nil
+}
+
+func (a *snapshotAccount) RestoreSnapshot(snapshot interface{}) error {
+	s := snapshot.(accountSnapshot)
+	a.owner = s.Owner
+	a.balance = s.Balance
+	return nil
+}
@@ -0,0 +1,161 @@
+package esdbaggregate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+)
+
+type RepositoryOptions struct {
+	// Stream category of the aggregates. The stream of an aggregate is "<Category>-<id>".
+	Category string
+	// When non-zero and the aggregate implements Snapshottable, Save writes a snapshot every time the aggregate
+	// version crosses a multiple of SnapshotEvery.
+	SnapshotEvery uint64
+	Authenticated *esdb.Credentials
+}
+
+// Repository loads and saves aggregates of type T.
+type Repository[T Aggregate] struct {
+	client  *esdb.Client
+	factory func() T
+	opts    RepositoryOptions
+}
+
+// NewRepository returns a repository creating empty aggregates with factory.
+func NewRepository[T Aggregate](client *esdb.Client, factory func() T, opts RepositoryOptions) *Repository[T] {
+	return &Repository[T]{
+		client:  client,
+		factory: factory,
+		opts:    opts,
+	}
+}
+
+// StreamName returns the stream of the aggregate with the given id.
+func (r *Repository[T]) StreamName(id string) string {
+	return r.opts.Category + "-" + id
+}
+
+// Load rebuilds the aggregate by applying all the events of its stream. When the stream doesn't exist, the error is an
+// *esdb.Error with the esdb.ErrorResourceNotFound code.
+func (r *Repository[T]) Load(ctx context.Context, id string) (T, error) {
+	aggregate := r.factory()
+	aggregate.root().SetID(id)
+
+	if err := r.apply(ctx, aggregate, esdb.Start{}); err != nil {
+		var zero T
+		return zero, err
+	}
+
+	return aggregate, nil
+}
+
+// apply applies the events of the aggregate stream, starting at from.
+func (r *Repository[T]) apply(ctx context.Context, aggregate T, from esdb.StreamPosition) error {
+	root := aggregate.root()
+	stream, err := r.client.ReadStream(ctx, r.StreamName(root.ID()), esdb.ReadStreamOptions{
+		From:          from,
+		Authenticated: r.opts.Authenticated,
+	}, ^uint64(0))
+
+	if err != nil {
+		return err
+	}
+
+	defer stream.Close()
+
+	for {
+		resolved, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		event, err := esdb.Decode[interface{}](resolved)
+		if err != nil {
+			return err
+		}
+
+		aggregate.Apply(event)
+		root.setVersion(resolved.Event.EventNumber)
+	}
+}
+
+// Save appends the uncommitted events of the aggregate to its stream. The append expects the stream to still be at the
+// version the aggregate was loaded at, so concurrent modifications fail with esdb.ErrorWrongExpectedVersion. Saving
+// an aggregate without changes is a no-op and returns a nil result. When writing a snapshot fails after the events
+// got appended, both the result and the error are returned.
+func (r *Repository[T]) Save(ctx context.Context, aggregate T) (*esdb.WriteResult, error) {
+	root := aggregate.root()
+	if len(root.changes) == 0 {
+		return nil, nil
+	}
+
+	if root.ID() == "" {
+		return nil, fmt.Errorf("aggregate has no id")
+	}
+
+	events := make([]esdb.EventData, 0, len(root.changes))
+	for _, change := range root.changes {
+		event, err := esdb.Encode[interface{}](nil, change)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, event)
+	}
+
+	var expected esdb.ExpectedRevision = esdb.NoStream{}
+	previous, saved := root.Version()
+	if saved {
+		expected = esdb.Revision(previous)
+	}
+
+	result, err := r.client.AppendToStream(ctx, r.StreamName(root.ID()), esdb.AppendToStreamOptions{
+		ExpectedRevision: expected,
+		Authenticated:    r.opts.Authenticated,
+	}, events...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	current, ok := result.NextExpectedRevision.(esdb.StreamRevision)
+	if !ok {
+		return nil, fmt.Errorf("append to stream %s reported no revision", r.StreamName(root.ID()))
+	}
+
+	root.commit(current.Value)
+
+	if r.shouldSnapshot(aggregate, previous, saved, current.Value) {
+		if err := r.SaveSnapshot(ctx, aggregate); err != nil {
+			return result, fmt.Errorf("failed to save snapshot: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Repository[T]) shouldSnapshot(aggregate T, previous uint64, saved bool, current uint64) bool {
+	if r.opts.SnapshotEvery == 0 {
+		return false
+	}
+
+	if _, ok := Aggregate(aggregate).(Snapshottable); !ok {
+		return false
+	}
+
+	// Versions are 0-based revisions, so the Nth event has revision N-1.
+	before := uint64(0)
+	if saved {
+		before = (previous + 1) / r.opts.SnapshotEvery
+	}
+
+	return (current+1)/r.opts.SnapshotEvery > before
+}
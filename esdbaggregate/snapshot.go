@@ -0,0 +1,152 @@
+package esdbaggregate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+)
+
+// Snapshottable is implemented by aggregates whose state can be snapshotted. The snapshot value must be of a type
+// registered with esdb.RegisterEventType.
+type Snapshottable interface {
+	Aggregate
+	// Snapshot returns the current state of the aggregate.
+	Snapshot() (interface{}, error)
+	// RestoreSnapshot replaces the state of the aggregate with the snapshot.
+	RestoreSnapshot(snapshot interface{}) error
+}
+
+type snapshotMetadata struct {
+	Version uint64 `json:"aggregateVersion"`
+}
+
+// SnapshotStreamName returns the stream holding the snapshots of the aggregate with the given id.
+func (r *Repository[T]) SnapshotStreamName(id string) string {
+	return r.StreamName(id) + "-snapshots"
+}
+
+// SaveSnapshot appends the state of the aggregate at its current version to its snapshot stream. Only the latest
+// snapshot is kept. The aggregate must not have uncommitted changes.
+func (r *Repository[T]) SaveSnapshot(ctx context.Context, aggregate T) error {
+	snapshottable, ok := Aggregate(aggregate).(Snapshottable)
+	if !ok {
+		return fmt.Errorf("%T doesn't support snapshots", aggregate)
+	}
+
+	root := aggregate.root()
+	version, ok := root.Version()
+	if !ok || len(root.changes) > 0 {
+		return errors.New("only saved aggregates without uncommitted changes can be snapshotted")
+	}
+
+	state, err := snapshottable.Snapshot()
+	if err != nil {
+		return err
+	}
+
+	event, err := esdb.Encode[interface{}](nil, state)
+	if err != nil {
+		return err
+	}
+
+	event.Metadata, err = json.Marshal(snapshotMetadata{Version: version})
+	if err != nil {
+		return err
+	}
+
+	streamID := r.SnapshotStreamName(root.ID())
+	result, err := r.client.AppendToStream(ctx, streamID, esdb.AppendToStreamOptions{
+		Authenticated: r.opts.Authenticated,
+	}, event)
+
+	if err != nil {
+		return err
+	}
+
+	if result.NextExpectedRevision == esdb.Revision(0) {
+		metadata := esdb.StreamMetadata{}
+		metadata.SetMaxCount(1)
+
+		_, err = r.client.SetStreamMetadata(ctx, streamID, esdb.AppendToStreamOptions{
+			Authenticated: r.opts.Authenticated,
+		}, metadata)
+	}
+
+	return err
+}
+
+// LoadWithSnapshot rebuilds the aggregate from its latest snapshot, then applies the events appended after it. When
+// there is no snapshot, or T doesn't implement Snapshottable, it behaves like Load.
+func (r *Repository[T]) LoadWithSnapshot(ctx context.Context, id string) (T, error) {
+	var zero T
+	aggregate := r.factory()
+	aggregate.root().SetID(id)
+
+	snapshottable, ok := Aggregate(aggregate).(Snapshottable)
+	if !ok {
+		return r.Load(ctx, id)
+	}
+
+	version, found, err := r.restoreSnapshot(ctx, snapshottable)
+	if err != nil {
+		return zero, err
+	}
+
+	if !found {
+		return r.Load(ctx, id)
+	}
+
+	aggregate.root().setVersion(version)
+	if err := r.apply(ctx, aggregate, esdb.Revision(version+1)); err != nil {
+		return zero, err
+	}
+
+	return aggregate, nil
+}
+
+func (r *Repository[T]) restoreSnapshot(ctx context.Context, aggregate Snapshottable) (uint64, bool, error) {
+	stream, err := r.client.ReadStream(ctx, r.SnapshotStreamName(aggregate.root().ID()), esdb.ReadStreamOptions{
+		Direction:     esdb.Backwards,
+		From:          esdb.End{},
+		Authenticated: r.opts.Authenticated,
+	}, 1)
+
+	if err != nil {
+		return 0, false, err
+	}
+
+	defer stream.Close()
+
+	resolved, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return 0, false, nil
+	}
+
+	if esdbErr, ok := esdb.FromError(err); !ok {
+		if esdbErr.Code() == esdb.ErrorResourceNotFound {
+			return 0, false, nil
+		}
+
+		return 0, false, err
+	}
+
+	var metadata snapshotMetadata
+	if err := json.Unmarshal(resolved.Event.UserMetadata, &metadata); err != nil {
+		return 0, false, fmt.Errorf("invalid snapshot metadata: %w", err)
+	}
+
+	state, err := esdb.Decode[interface{}](resolved)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if err := aggregate.RestoreSnapshot(state); err != nil {
+		return 0, false, err
+	}
+
+	return metadata.Version, true, nil
+}
@@ -0,0 +1,149 @@
+package esdbbackup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+)
+
+type ExportOptions struct {
+	// Where to start reading $all from. To resume an interrupted export, use the position returned by Export.
+	From esdb.AllPosition // Defaults to esdb.Start{}.
+	// Number of events read from $all at a time.
+	BatchSize uint64 // Defaults to 1000.
+	// Called after each batch got written with the position of the last exported record.
+	Progress      func(position esdb.Position)
+	Authenticated *esdb.Credentials
+}
+
+func (o *ExportOptions) setDefaults() {
+	if o.From == nil {
+		o.From = esdb.Start{}
+	}
+
+	if o.BatchSize == 0 {
+		o.BatchSize = 1000
+	}
+}
+
+// Export reads $all and writes every user stream event, metadata stream event and tombstone to the archive, in
+// transaction log order. System streams are skipped. The returned position is the position of the last event read,
+// which can be used as From to resume the export into an archive opened with NewAppendWriter.
+func Export(ctx context.Context, client *esdb.Client, w *Writer, opts ExportOptions) (esdb.Position, error) {
+	opts.setDefaults()
+
+	from := opts.From
+	var last *esdb.Position
+
+	// Resuming from the position of the last exported record, which the first read returns again.
+	if position, ok := from.(esdb.Position); ok {
+		last = &position
+	}
+
+	for {
+		stream, err := client.ReadAll(ctx, esdb.ReadAllOptions{
+			From:          from,
+			Authenticated: opts.Authenticated,
+		}, opts.BatchSize)
+
+		if err != nil {
+			return lastOrZero(last), err
+		}
+
+		var read uint64
+		for {
+			event, err := stream.Recv()
+
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			if err != nil {
+				stream.Close()
+				return lastOrZero(last), fmt.Errorf("failed to read $all: %w", err)
+			}
+
+			read++
+			recorded := event.Event
+
+			// Reading from a position includes the event at that position, which was already exported.
+			if last != nil && recorded.Position == *last {
+				continue
+			}
+
+			position := recorded.Position
+			last = &position
+
+			record, ok := recordFromEvent(recorded)
+
+			if !ok {
+				continue
+			}
+
+			if err := w.Write(record); err != nil {
+				stream.Close()
+				return lastOrZero(last), fmt.Errorf("failed to write backup record: %w", err)
+			}
+		}
+
+		stream.Close()
+
+		if err := w.Flush(); err != nil {
+			return lastOrZero(last), fmt.Errorf("failed to write backup records: %w", err)
+		}
+
+		if last != nil && opts.Progress != nil {
+			opts.Progress(*last)
+		}
+
+		if read < opts.BatchSize {
+			return lastOrZero(last), nil
+		}
+
+		from = *last
+	}
+}
+
+func recordFromEvent(event *esdb.RecordedEvent) (Record, bool) {
+	isMetadataStream := esdb.IsMetadataStream(event.StreamID)
+
+	if esdb.IsSystemStream(event.StreamID) && !isMetadataStream {
+		return Record{}, false
+	}
+
+	// Metadata of system streams.
+	if isMetadataStream && esdb.IsSystemStream(event.StreamID[2:]) {
+		return Record{}, false
+	}
+
+	record := Record{
+		Kind:     EventRecord,
+		StreamID: event.StreamID,
+		Revision: event.EventNumber,
+		Position: event.Position.String(),
+	}
+
+	if event.EventType == esdb.StreamDeletedEventType {
+		record.Kind = TombstoneRecord
+		return record, true
+	}
+
+	record.EventID = event.EventID
+	record.EventType = event.EventType
+	record.ContentType = event.ContentType
+	record.Data = event.Data
+	record.Metadata = event.UserMetadata
+
+	return record, true
+}
+
+func lastOrZero(last *esdb.Position) esdb.Position {
+	if last == nil {
+		return esdb.StartPosition
+	}
+
+	return *last
+}
@@ -0,0 +1,132 @@
+// Package esdbbackup exports the content of an EventStoreDB database to a portable archive and restores it into
+// another database.
+package esdbbackup
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/gofrs/uuid"
+)
+
+const (
+	formatName    = "esdb-backup"
+	formatVersion = 1
+)
+
+type RecordKind string
+
+const (
+	// EventRecord holds an event, including the events of metadata streams.
+	EventRecord RecordKind = "event"
+	// TombstoneRecord marks a stream that got tombstoned.
+	TombstoneRecord RecordKind = "tombstone"
+)
+
+// Record is a single entry of a backup archive.
+type Record struct {
+	Kind        RecordKind `json:"kind"`
+	StreamID    string     `json:"streamId"`
+	EventID     uuid.UUID  `json:"eventId"`
+	EventType   string     `json:"eventType,omitempty"`
+	ContentType string     `json:"contentType,omitempty"`
+	Data        []byte     `json:"data,omitempty"`
+	Metadata    []byte     `json:"metadata,omitempty"`
+	Revision    uint64     `json:"revision"`
+	// The $all position of the event in the source database, in the esdb.Position string format.
+	Position string `json:"position"`
+}
+
+type header struct {
+	Format  string `json:"format"`
+	Version int    `json:"version"`
+}
+
+// Writer writes a backup archive, made of a header line followed by one JSON encoded record per line.
+type Writer struct {
+	inner         *bufio.Writer
+	encoder       *json.Encoder
+	headerWritten bool
+}
+
+func NewWriter(w io.Writer) *Writer {
+	inner := bufio.NewWriter(w)
+
+	return &Writer{
+		inner:   inner,
+		encoder: json.NewEncoder(inner),
+	}
+}
+
+// Write appends a record to the archive. The archive header is written before the first record, unless the writer
+// was created with NewAppendWriter.
+func (w *Writer) Write(record Record) error {
+	if !w.headerWritten {
+		if err := w.encoder.Encode(header{Format: formatName, Version: formatVersion}); err != nil {
+			return err
+		}
+
+		w.headerWritten = true
+	}
+
+	return w.encoder.Encode(record)
+}
+
+// Flush writes any buffered data to the underlying writer.
+func (w *Writer) Flush() error {
+	return w.inner.Flush()
+}
+
+// NewAppendWriter creates a writer adding records to an existing archive, used to resume an interrupted export.
+func NewAppendWriter(w io.Writer) *Writer {
+	writer := NewWriter(w)
+	writer.headerWritten = true
+	return writer
+}
+
+// Reader reads a backup archive written by a Writer.
+type Reader struct {
+	decoder    *json.Decoder
+	headerRead bool
+}
+
+func NewReader(r io.Reader) *Reader {
+	return &Reader{
+		decoder: json.NewDecoder(bufio.NewReader(r)),
+	}
+}
+
+// Read returns the next record of the archive, or io.EOF once all records have been read.
+func (r *Reader) Read() (*Record, error) {
+	if !r.headerRead {
+		var h header
+
+		if err := r.decoder.Decode(&h); err != nil {
+			if err == io.EOF {
+				return nil, fmt.Errorf("empty backup archive")
+			}
+
+			return nil, fmt.Errorf("invalid backup archive header: %w", err)
+		}
+
+		if h.Format != formatName || h.Version != formatVersion {
+			return nil, fmt.Errorf("unsupported backup archive format '%s' version %d", h.Format, h.Version)
+		}
+
+		r.headerRead = true
+	}
+
+	var record Record
+
+	if err := r.decoder.Decode(&record); err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+
+		return nil, fmt.Errorf("invalid backup archive record: %w", err)
+	}
+
+	return &record, nil
+}
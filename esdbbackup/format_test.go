@@ -0,0 +1,77 @@
+package esdbbackup
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveRoundTrip(t *testing.T) {
+	records := []Record{
+		{
+			Kind:        EventRecord,
+			StreamID:    "account-1",
+			EventID:     uuid.Must(uuid.NewV4()),
+			EventType:   "AccountOpened",
+			ContentType: "application/json",
+			Data:        []byte(`{"owner":"ada"}`),
+			Metadata:    []byte{0xd, 0xe, 0xa, 0xd},
+			Revision:    0,
+			Position:    esdb.Position{Commit: 12, Prepare: 12}.String(),
+		},
+		{
+			Kind:     TombstoneRecord,
+			StreamID: "account-2",
+			Revision: 1,
+			Position: esdb.Position{Commit: 42, Prepare: 42}.String(),
+		},
+	}
+
+	var buffer bytes.Buffer
+	writer := NewWriter(&buffer)
+
+	for _, record := range records {
+		require.NoError(t, writer.Write(record))
+	}
+
+	require.NoError(t, writer.Flush())
+
+	reader := NewReader(&buffer)
+
+	for _, expected := range records {
+		record, err := reader.Read()
+		require.NoError(t, err)
+		assert.Equal(t, expected, *record)
+	}
+
+	_, err := reader.Read()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestArchiveRejectsUnknownFormat(t *testing.T) {
+	reader := NewReader(bytes.NewBufferString(`{"format":"something-else","version":1}`))
+
+	_, err := reader.Read()
+	assert.Error(t, err)
+}
+
+func TestRecordFromEventSkipsSystemStreams(t *testing.T) {
+	_, ok := recordFromEvent(&esdb.RecordedEvent{StreamID: "$stats-127.0.0.1:2113"})
+	assert.False(t, ok)
+
+	_, ok = recordFromEvent(&esdb.RecordedEvent{StreamID: "$$$stats-127.0.0.1:2113"})
+	assert.False(t, ok)
+
+	record, ok := recordFromEvent(&esdb.RecordedEvent{StreamID: "$$account-1", EventType: esdb.MetadataEventType})
+	assert.True(t, ok)
+	assert.Equal(t, EventRecord, record.Kind)
+
+	record, ok = recordFromEvent(&esdb.RecordedEvent{StreamID: "account-1", EventType: esdb.StreamDeletedEventType})
+	assert.True(t, ok)
+	assert.Equal(t, TombstoneRecord, record.Kind)
+}
@@ -0,0 +1,123 @@
+package esdbbackup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+)
+
+type ImportOptions struct {
+	// Maximum number of consecutive events of the same stream appended at once.
+	BatchSize int // Defaults to 500.
+	// Called after each append or tombstone with the number of records restored so far.
+	Progress      func(restored uint64)
+	Authenticated *esdb.Credentials
+}
+
+func (o *ImportOptions) setDefaults() {
+	if o.BatchSize == 0 {
+		o.BatchSize = 500
+	}
+}
+
+// Import restores the records of an archive into the database the client is connected to, in archive order and
+// keeping the original event ids. Events are appended without revision checks and rely on the server's event id
+// based idempotency, which makes restarting an interrupted import from the beginning of the archive safe.
+func Import(ctx context.Context, client *esdb.Client, r *Reader, opts ImportOptions) (uint64, error) {
+	opts.setDefaults()
+
+	var restored uint64
+	var batchStream string
+	var batch []esdb.EventData
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+
+		_, err := client.AppendToStream(ctx, batchStream, esdb.AppendToStreamOptions{
+			Authenticated: opts.Authenticated,
+		}, batch...)
+
+		if err != nil {
+			return fmt.Errorf("failed to restore events of stream '%s': %w", batchStream, err)
+		}
+
+		restored += uint64(len(batch))
+		batch = nil
+
+		if opts.Progress != nil {
+			opts.Progress(restored)
+		}
+
+		return nil
+	}
+
+	for {
+		record, err := r.Read()
+
+		if err == io.EOF {
+			return restored, flush()
+		}
+
+		if err != nil {
+			return restored, err
+		}
+
+		if record.StreamID != batchStream || len(batch) >= opts.BatchSize {
+			if err := flush(); err != nil {
+				return restored, err
+			}
+
+			batchStream = record.StreamID
+		}
+
+		switch record.Kind {
+		case EventRecord:
+			batch = append(batch, eventDataFromRecord(record))
+		case TombstoneRecord:
+			if err := flush(); err != nil {
+				return restored, err
+			}
+
+			_, err := client.TombstoneStream(ctx, record.StreamID, esdb.TombstoneStreamOptions{
+				Authenticated: opts.Authenticated,
+			})
+
+			var esdbErr *esdb.Error
+			if errors.As(err, &esdbErr) && esdbErr.Code() == esdb.ErrorStreamDeleted {
+				err = nil
+			}
+
+			if err != nil {
+				return restored, fmt.Errorf("failed to restore tombstone of stream '%s': %w", record.StreamID, err)
+			}
+
+			restored++
+
+			if opts.Progress != nil {
+				opts.Progress(restored)
+			}
+		default:
+			return restored, fmt.Errorf("unknown backup record kind '%s'", record.Kind)
+		}
+	}
+}
+
+func eventDataFromRecord(record *Record) esdb.EventData {
+	contentType := esdb.BinaryContentType
+	if record.ContentType == "application/json" {
+		contentType = esdb.JsonContentType
+	}
+
+	return esdb.EventData{
+		EventID:     record.EventID,
+		EventType:   record.EventType,
+		ContentType: contentType,
+		Data:        record.Data,
+		Metadata:    record.Metadata,
+	}
+}
@@ -0,0 +1,214 @@
+package esdbcatchup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+)
+
+// Checkpoint is the last event handled by a subscription.
+type Checkpoint struct {
+	// Revision of the event in the subscribed stream. Unused by subscriptions to $all.
+	Revision uint64
+	// Position of the event in $all.
+	Position esdb.Position
+}
+
+// CheckpointStore persists the progress of a subscription. Implement it to keep checkpoints in an external database,
+// for example in the same transaction as the read models the subscription updates.
+type CheckpointStore interface {
+	// Load returns the last stored checkpoint, or nil if nothing got stored yet.
+	Load(ctx context.Context) (*Checkpoint, error)
+	Store(ctx context.Context, checkpoint Checkpoint) error
+}
+
+// MemoryCheckpointStore keeps the checkpoint in memory. It survives resubscriptions but not process restarts, after
+// which the subscription starts over.
+type MemoryCheckpointStore struct {
+	lock       sync.Mutex
+	checkpoint *Checkpoint
+}
+
+func (s *MemoryCheckpointStore) Load(context.Context) (*Checkpoint, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.checkpoint, nil
+}
+
+func (s *MemoryCheckpointStore) Store(_ context.Context, checkpoint Checkpoint) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.checkpoint = &checkpoint
+	return nil
+}
+
+const checkpointEventType = "SubscriptionCheckpoint"
+
+type checkpointData struct {
+	Revision uint64 `json:"revision"`
+	Position string `json:"position"`
+}
+
+// FileCheckpointStore keeps the checkpoint as JSON in a local file, replaced atomically on every store.
+type FileCheckpointStore struct {
+	path string
+}
+
+func NewFileCheckpointStore(path string) *FileCheckpointStore {
+	return &FileCheckpointStore{path: path}
+}
+
+func (s *FileCheckpointStore) Load(context.Context) (*Checkpoint, error) {
+	content, err := os.ReadFile(s.path)
+
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read checkpoint file '%s': %w", s.path, err)
+	}
+
+	var data checkpointData
+
+	if err := json.Unmarshal(content, &data); err != nil {
+		return nil, fmt.Errorf("invalid checkpoint in file '%s': %w", s.path, err)
+	}
+
+	position, err := esdb.ParsePosition(data.Position)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Checkpoint{Revision: data.Revision, Position: position}, nil
+}
+
+func (s *FileCheckpointStore) Store(_ context.Context, checkpoint Checkpoint) error {
+	data, err := json.Marshal(checkpointData{Revision: checkpoint.Revision, Position: checkpoint.Position.String()})
+
+	if err != nil {
+		return err
+	}
+
+	// Writing next to the file and renaming it never leaves a truncated checkpoint behind after a crash.
+	file, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+
+	if err != nil {
+		return fmt.Errorf("failed to store checkpoint file '%s': %w", s.path, err)
+	}
+
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to store checkpoint file '%s': %w", s.path, err)
+	}
+
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to store checkpoint file '%s': %w", s.path, err)
+	}
+
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to store checkpoint file '%s': %w", s.path, err)
+	}
+
+	if err := os.Rename(file.Name(), s.path); err != nil {
+		return fmt.Errorf("failed to store checkpoint file '%s': %w", s.path, err)
+	}
+
+	return nil
+}
+
+// StreamCheckpointStore keeps the checkpoint as the last event of a stream.
+type StreamCheckpointStore struct {
+	client   *esdb.Client
+	streamID string
+}
+
+func NewStreamCheckpointStore(client *esdb.Client, streamID string) *StreamCheckpointStore {
+	return &StreamCheckpointStore{
+		client:   client,
+		streamID: streamID,
+	}
+}
+
+func (s *StreamCheckpointStore) Load(ctx context.Context) (*Checkpoint, error) {
+	stream, err := s.client.ReadStream(ctx, s.streamID, esdb.ReadStreamOptions{
+		Direction: esdb.Backwards,
+		From:      esdb.End{},
+	}, 1)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer stream.Close()
+
+	event, err := stream.Recv()
+
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+
+	var esdbErr *esdb.Error
+	if errors.As(err, &esdbErr) && esdbErr.Code() == esdb.ErrorResourceNotFound {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read checkpoint stream '%s': %w", s.streamID, err)
+	}
+
+	var data checkpointData
+
+	if err := json.Unmarshal(event.Event.Data, &data); err != nil {
+		return nil, fmt.Errorf("invalid checkpoint in stream '%s': %w", s.streamID, err)
+	}
+
+	position, err := esdb.ParsePosition(data.Position)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Checkpoint{Revision: data.Revision, Position: position}, nil
+}
+
+func (s *StreamCheckpointStore) Store(ctx context.Context, checkpoint Checkpoint) error {
+	data, err := json.Marshal(checkpointData{Revision: checkpoint.Revision, Position: checkpoint.Position.String()})
+
+	if err != nil {
+		return err
+	}
+
+	result, err := s.client.AppendToStream(ctx, s.streamID, esdb.AppendToStreamOptions{}, esdb.EventData{
+		EventType:   checkpointEventType,
+		ContentType: esdb.JsonContentType,
+		Data:        data,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	// Only the last checkpoint matters, older ones can be scavenged.
+	if result.NextExpectedRevision == esdb.Revision(0) {
+		meta := esdb.StreamMetadata{}
+		meta.SetMaxCount(10)
+
+		_, err = s.client.SetStreamMetadata(ctx, s.streamID, esdb.AppendToStreamOptions{}, meta)
+	}
+
+	return err
+}
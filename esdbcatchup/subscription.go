@@ -0,0 +1,309 @@
+// Package esdbcatchup runs catch-up subscriptions which survive node failovers, resubscribing from the last handled
+// event and keeping their progress in a CheckpointStore.
+package esdbcatchup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Handler handles an event of the subscription. Returning an error stops the subscription.
+type Handler func(ctx context.Context, event *esdb.ResolvedEvent) error
+
+type Options struct {
+	// Stream subscribed to. Subscribes to $all when empty.
+	StreamID string
+	// Server-side filter of a subscription to $all.
+	Filter         *esdb.SubscriptionFilter
+	ResolveLinkTos bool
+	Authenticated  *esdb.Credentials
+	// Where the subscription progress is kept.
+	Checkpoints CheckpointStore // Defaults to a MemoryCheckpointStore.
+	// Number of handled events after which a checkpoint is stored.
+	CheckpointEvery int // Defaults to 100.
+	// Delay before the first resubscription. It doubles after every failed attempt.
+	InitialBackoff time.Duration // Defaults to 100 milliseconds.
+	// Upper bound of the delay between resubscriptions.
+	MaxBackoff time.Duration // Defaults to 5 seconds.
+	// Number of consecutive failed subscriptions after which Run gives up. Defaults to 0, which retries until the
+	// context gets cancelled.
+	MaxAttempts int
+	// Resubscribes when the server sends no message for that long, which catches connections silently dead. A
+	// subscription to a quiet stream receives nothing once caught up, so the timeout must exceed the expected gap
+	// between events. Defaults to 0, which disables it.
+	StallTimeout time.Duration
+}
+
+func (o *Options) setDefaults() {
+	if o.Checkpoints == nil {
+		o.Checkpoints = &MemoryCheckpointStore{}
+	}
+
+	if o.CheckpointEvery == 0 {
+		o.CheckpointEvery = 100
+	}
+
+	if o.InitialBackoff == 0 {
+		o.InitialBackoff = 100 * time.Millisecond
+	}
+
+	if o.MaxBackoff == 0 {
+		o.MaxBackoff = 5 * time.Second
+	}
+}
+
+// Subscription is a catch-up subscription resubscribing with backoff whenever it drops, from the last event its
+// handler handled. Events are handled one at a time and in order. An event handled right before the process stops
+// may be handled again after a restart, as checkpoints are only stored every CheckpointEvery events.
+type Subscription struct {
+	client  *esdb.Client
+	handler Handler
+	opts    Options
+
+	// Last handled event, and number of events handled since the last stored checkpoint.
+	last    *Checkpoint
+	pending int
+}
+
+func New(client *esdb.Client, handler Handler, opts Options) *Subscription {
+	opts.setDefaults()
+
+	return &Subscription{
+		client:  client,
+		handler: handler,
+		opts:    opts,
+	}
+}
+
+// handlerError marks the failures of the handler, which stop the subscription instead of making it resubscribe.
+type handlerError struct {
+	err error
+}
+
+func (e *handlerError) Error() string {
+	return e.err.Error()
+}
+
+func (e *handlerError) Unwrap() error {
+	return e.err
+}
+
+// Run handles events until the context gets cancelled, the handler fails or the subscription can't be restored. It
+// resumes from the stored checkpoint, if any. It returns nil when the context got cancelled.
+func (s *Subscription) Run(ctx context.Context) error {
+	checkpoint, err := s.opts.Checkpoints.Load(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to load subscription checkpoint: %w", err)
+	}
+
+	s.last = checkpoint
+	s.pending = 0
+
+	failures := 0
+	for {
+		progressed, err := s.subscribe(ctx)
+
+		if ctx.Err() != nil {
+			// The run context is done, so the final checkpoint is stored with a fresh context.
+			_ = s.flush(context.Background())
+			return nil
+		}
+
+		var handlerErr *handlerError
+		if errors.As(err, &handlerErr) {
+			_ = s.flush(ctx)
+			return handlerErr.err
+		}
+
+		if permanent(err) {
+			_ = s.flush(ctx)
+			return fmt.Errorf("subscription dropped: %w", err)
+		}
+
+		if progressed {
+			failures = 0
+		}
+
+		failures++
+		if s.opts.MaxAttempts > 0 && failures >= s.opts.MaxAttempts {
+			_ = s.flush(ctx)
+			return fmt.Errorf("subscription dropped after %d attempts: %w", failures, err)
+		}
+
+		if err := s.flush(ctx); err != nil {
+			return err
+		}
+
+		if wait(ctx, s.backoff(failures)) != nil {
+			return nil
+		}
+	}
+}
+
+// subscribe runs a subscription from the last handled event until it drops. It tells whether any event got handled.
+func (s *Subscription) subscribe(ctx context.Context) (bool, error) {
+	subscription, err := s.open(ctx)
+
+	if err != nil {
+		return false, err
+	}
+
+	defer subscription.Close()
+
+	from := s.last
+	progressed := false
+
+	for {
+		event := subscription.Recv()
+
+		if ctx.Err() != nil {
+			return progressed, ctx.Err()
+		}
+
+		if event.SubscriptionDropped != nil {
+			return progressed, event.SubscriptionDropped.Error
+		}
+
+		if event.CheckPointReached != nil {
+			// Filtered subscriptions to $all report how far they scanned, which saves scanning again.
+			if s.opts.StreamID == "" && (s.last == nil || event.CheckPointReached.After(s.last.Position)) {
+				s.last = &Checkpoint{Position: *event.CheckPointReached}
+				s.pending++
+			}
+
+			continue
+		}
+
+		if event.EventAppeared == nil {
+			continue
+		}
+
+		original := event.EventAppeared.OriginalEvent()
+
+		// Subscribing from a position includes the event at that position, which was already handled.
+		if from != nil && !s.after(original, *from) {
+			continue
+		}
+
+		if err := s.handler(ctx, event.EventAppeared); err != nil {
+			return progressed, &handlerError{err: fmt.Errorf("failed to handle event %d of stream '%s': %w", original.EventNumber, original.StreamID, err)}
+		}
+
+		s.last = &Checkpoint{Revision: original.EventNumber, Position: original.Position}
+		s.pending++
+		progressed = true
+
+		if s.pending >= s.opts.CheckpointEvery {
+			if err := s.flush(ctx); err != nil {
+				return progressed, &handlerError{err: err}
+			}
+		}
+	}
+}
+
+func (s *Subscription) open(ctx context.Context) (*esdb.Subscription, error) {
+	if s.opts.StreamID == "" {
+		var from esdb.AllPosition = esdb.Start{}
+		if s.last != nil {
+			from = s.last.Position
+		}
+
+		return s.client.SubscribeToAll(ctx, esdb.SubscribeToAllOptions{
+			From:           from,
+			Filter:         s.opts.Filter,
+			ResolveLinkTos: s.opts.ResolveLinkTos,
+			Authenticated:  s.opts.Authenticated,
+			IdleTimeout:    s.opts.StallTimeout,
+		})
+	}
+
+	var from esdb.StreamPosition = esdb.Start{}
+	if s.last != nil {
+		from = esdb.Revision(s.last.Revision)
+	}
+
+	return s.client.SubscribeToStream(ctx, s.opts.StreamID, esdb.SubscribeToStreamOptions{
+		From:           from,
+		ResolveLinkTos: s.opts.ResolveLinkTos,
+		Authenticated:  s.opts.Authenticated,
+		IdleTimeout:    s.opts.StallTimeout,
+	})
+}
+
+// after tells whether the event comes after the checkpoint in the subscribed stream.
+func (s *Subscription) after(event *esdb.RecordedEvent, checkpoint Checkpoint) bool {
+	if s.opts.StreamID == "" {
+		return event.Position.After(checkpoint.Position)
+	}
+
+	return event.EventNumber > checkpoint.Revision
+}
+
+// flush stores the last handled event if it wasn't already.
+func (s *Subscription) flush(ctx context.Context) error {
+	if s.last == nil || s.pending == 0 {
+		return nil
+	}
+
+	if err := s.opts.Checkpoints.Store(ctx, *s.last); err != nil {
+		return fmt.Errorf("failed to store subscription checkpoint: %w", err)
+	}
+
+	s.pending = 0
+	return nil
+}
+
+// backoff returns the delay to wait after the given number of consecutive failures, starting at 1.
+func (s *Subscription) backoff(failures int) time.Duration {
+	delay := s.opts.InitialBackoff
+	for i := 1; i < failures && delay < s.opts.MaxBackoff; i++ {
+		delay *= 2
+	}
+
+	if delay > s.opts.MaxBackoff {
+		delay = s.opts.MaxBackoff
+	}
+
+	return delay
+}
+
+// permanent tells whether resubscribing after err is pointless.
+func permanent(err error) bool {
+	var esdbErr *esdb.Error
+	if errors.As(err, &esdbErr) {
+		switch esdbErr.Code() {
+		case esdb.ErrorAccessDenied, esdb.ErrorUnauthenticated, esdb.ErrorStreamDeleted, esdb.ErrorInvalidArgument:
+			return true
+		}
+	}
+
+	// Drops carry the gRPC error as is, possibly wrapped.
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &grpcErr) {
+		switch grpcErr.GRPCStatus().Code() {
+		case codes.PermissionDenied, codes.Unauthenticated:
+			return true
+		}
+	}
+
+	return false
+}
+
+func wait(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,85 @@
+package esdbcatchup
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMemoryCheckpointStore(t *testing.T) {
+	store := &MemoryCheckpointStore{}
+
+	checkpoint, err := store.Load(context.Background())
+	require.NoError(t, err)
+	assert.Nil(t, checkpoint)
+
+	require.NoError(t, store.Store(context.Background(), Checkpoint{Revision: 3, Position: esdb.Position{Commit: 10, Prepare: 10}}))
+
+	checkpoint, err = store.Load(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Checkpoint{Revision: 3, Position: esdb.Position{Commit: 10, Prepare: 10}}, *checkpoint)
+}
+
+func TestFileCheckpointStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+	store := NewFileCheckpointStore(path)
+
+	checkpoint, err := store.Load(context.Background())
+	require.NoError(t, err)
+	assert.Nil(t, checkpoint)
+
+	require.NoError(t, store.Store(context.Background(), Checkpoint{Revision: 3, Position: esdb.Position{Commit: 10, Prepare: 10}}))
+	require.NoError(t, store.Store(context.Background(), Checkpoint{Revision: 4, Position: esdb.Position{Commit: 20, Prepare: 20}}))
+
+	checkpoint, err = NewFileCheckpointStore(path).Load(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Checkpoint{Revision: 4, Position: esdb.Position{Commit: 20, Prepare: 20}}, *checkpoint)
+
+	// Temporary files are cleaned up once renamed.
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0o600))
+	_, err = store.Load(context.Background())
+	assert.Error(t, err)
+}
+
+func TestBackoff(t *testing.T) {
+	sub := New(nil, nil, Options{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second})
+
+	assert.Equal(t, time.Second, sub.backoff(1))
+	assert.Equal(t, 2*time.Second, sub.backoff(2))
+	assert.Equal(t, 4*time.Second, sub.backoff(3))
+	assert.Equal(t, 5*time.Second, sub.backoff(4))
+	assert.Equal(t, 5*time.Second, sub.backoff(100))
+}
+
+func TestAfterCheckpoint(t *testing.T) {
+	checkpoint := Checkpoint{Revision: 5, Position: esdb.Position{Commit: 100, Prepare: 100}}
+	event := &esdb.RecordedEvent{EventNumber: 5, Position: esdb.Position{Commit: 200, Prepare: 200}}
+
+	all := New(nil, nil, Options{})
+	assert.True(t, all.after(event, checkpoint))
+
+	stream := New(nil, nil, Options{StreamID: "orders"})
+	assert.False(t, stream.after(event, checkpoint))
+
+	event.EventNumber = 6
+	assert.True(t, stream.after(event, checkpoint))
+}
+
+func TestPermanentErrors(t *testing.T) {
+	assert.True(t, permanent(status.Error(codes.PermissionDenied, "denied")))
+	assert.True(t, permanent(fmt.Errorf("failed to construct subscription: %w", status.Error(codes.Unauthenticated, "who"))))
+	assert.False(t, permanent(status.Error(codes.Unavailable, "node down")))
+}
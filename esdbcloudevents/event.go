@@ -0,0 +1,253 @@
+// Package esdbcloudevents provides an http.Handler accepting CloudEvents (HTTP protocol binding, binary, structured
+// and batched content modes) and appending them to EventStoreDB streams.
+package esdbcloudevents
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+const (
+	structuredContentType = "application/cloudevents+json"
+	batchContentType      = "application/cloudevents-batch+json"
+	headerPrefix          = "Ce-"
+)
+
+// Event is a CloudEvent as received by the handler. Attributes which aren't part of the CloudEvents core context are
+// kept in Extensions.
+type Event struct {
+	ID              string
+	Source          string
+	SpecVersion     string
+	Type            string
+	Subject         string
+	Time            string
+	DataContentType string
+	DataSchema      string
+	Extensions      map[string]string
+	Data            []byte
+}
+
+func (e *Event) validate() error {
+	if e.ID == "" {
+		return errors.New("missing id attribute")
+	}
+
+	if e.Source == "" {
+		return errors.New("missing source attribute")
+	}
+
+	if e.Type == "" {
+		return errors.New("missing type attribute")
+	}
+
+	if e.SpecVersion == "" {
+		return errors.New("missing specversion attribute")
+	}
+
+	if !strings.HasPrefix(e.SpecVersion, "1.") {
+		return fmt.Errorf("unsupported specversion %q", e.SpecVersion)
+	}
+
+	return nil
+}
+
+// attributes returns the context attributes of the event, as they are stored in the event metadata.
+func (e *Event) attributes() map[string]string {
+	attrs := make(map[string]string, len(e.Extensions)+8)
+	for key, value := range e.Extensions {
+		attrs[key] = value
+	}
+
+	set := func(key, value string) {
+		if value != "" {
+			attrs[key] = value
+		}
+	}
+
+	set("id", e.ID)
+	set("source", e.Source)
+	set("specversion", e.SpecVersion)
+	set("type", e.Type)
+	set("subject", e.Subject)
+	set("time", e.Time)
+	set("datacontenttype", e.DataContentType)
+	set("dataschema", e.DataSchema)
+
+	return attrs
+}
+
+func (e *Event) setAttribute(name, value string) {
+	switch name {
+	case "id":
+		e.ID = value
+	case "source":
+		e.Source = value
+	case "specversion":
+		e.SpecVersion = value
+	case "type":
+		e.Type = value
+	case "subject":
+		e.Subject = value
+	case "time":
+		e.Time = value
+	case "datacontenttype":
+		e.DataContentType = value
+	case "dataschema":
+		e.DataSchema = value
+	default:
+		if e.Extensions == nil {
+			e.Extensions = make(map[string]string)
+		}
+
+		e.Extensions[name] = value
+	}
+}
+
+// isJSON tells if the event data is JSON, which is the default when no content type is given.
+func (e *Event) isJSON() bool {
+	if e.DataContentType == "" {
+		return true
+	}
+
+	mediaType, _, err := mime.ParseMediaType(e.DataContentType)
+	if err != nil {
+		return false
+	}
+
+	return mediaType == "application/json" || mediaType == "text/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// parseRequest reads the CloudEvents carried by the request, whatever the content mode.
+func parseRequest(r *http.Request, maxBodySize int64) ([]*Event, error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+
+	if int64(len(body)) > maxBodySize {
+		return nil, errBodyTooLarge
+	}
+
+	contentType := r.Header.Get("Content-Type")
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+
+	switch mediaType {
+	case structuredContentType:
+		event, err := parseStructured(body)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*Event{event}, nil
+	case batchContentType:
+		return parseBatch(body)
+	}
+
+	if r.Header.Get(headerPrefix+"Specversion") == "" {
+		return nil, errUnsupportedContentType
+	}
+
+	event := &Event{
+		DataContentType: contentType,
+		Data:            body,
+	}
+
+	for name, values := range r.Header {
+		if len(values) == 0 || !strings.HasPrefix(name, headerPrefix) {
+			continue
+		}
+
+		event.setAttribute(strings.ToLower(strings.TrimPrefix(name, headerPrefix)), values[0])
+	}
+
+	if err := event.validate(); err != nil {
+		return nil, err
+	}
+
+	return []*Event{event}, nil
+}
+
+func parseBatch(body []byte) ([]*Event, error) {
+	var raws []json.RawMessage
+	if err := json.Unmarshal(body, &raws); err != nil {
+		return nil, fmt.Errorf("invalid batch: %w", err)
+	}
+
+	events := make([]*Event, 0, len(raws))
+	for i, raw := range raws {
+		event, err := parseStructured(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid event at index %d: %w", i, err)
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func parseStructured(body []byte) (*Event, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, fmt.Errorf("invalid structured event: %w", err)
+	}
+
+	event := &Event{}
+	for name, raw := range fields {
+		switch name {
+		case "data", "data_base64":
+			continue
+		}
+
+		var value interface{}
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return nil, fmt.Errorf("invalid %s attribute: %w", name, err)
+		}
+
+		switch v := value.(type) {
+		case string:
+			event.setAttribute(name, v)
+		case nil:
+		default:
+			event.setAttribute(name, string(raw))
+		}
+	}
+
+	if err := event.validate(); err != nil {
+		return nil, err
+	}
+
+	if encoded, ok := fields["data_base64"]; ok {
+		var text string
+		if err := json.Unmarshal(encoded, &text); err != nil {
+			return nil, fmt.Errorf("invalid data_base64: %w", err)
+		}
+
+		data, err := base64.StdEncoding.DecodeString(text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid data_base64: %w", err)
+		}
+
+		event.Data = data
+	} else if raw, ok := fields["data"]; ok && !bytes.Equal(raw, []byte("null")) {
+		event.Data = raw
+
+		// Non JSON payloads are carried as JSON strings in structured mode.
+		if !event.isJSON() {
+			var text string
+			if err := json.Unmarshal(raw, &text); err == nil {
+				event.Data = []byte(text)
+			}
+		}
+	}
+
+	return event, nil
+}
@@ -0,0 +1,208 @@
+package esdbcloudevents
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+	"github.com/gofrs/uuid"
+)
+
+var (
+	errBodyTooLarge           = errors.New("request body too large")
+	errUnsupportedContentType = errors.New("request is not a CloudEvent")
+)
+
+// eventIDNamespace is used to derive event ids from CloudEvents source and id attributes.
+var eventIDNamespace = uuid.Must(uuid.FromString("6f9b3c0e-51d4-4a8a-9d8b-2b8a5f1e7c43"))
+
+// Rule maps the CloudEvents matching Source and Type to a stream.
+type Rule struct {
+	// Prefix the source attribute must start with. Empty matches any source.
+	Source string
+	// Exact value of the type attribute. Empty matches any type.
+	Type string
+	// Name of the stream, where the {source}, {subject}, {type} and {id} placeholders are replaced by the event
+	// attributes.
+	Stream string
+}
+
+func (r Rule) matches(event *Event) bool {
+	return strings.HasPrefix(event.Source, r.Source) && (r.Type == "" || r.Type == event.Type)
+}
+
+type HandlerOptions struct {
+	// Rules are evaluated in order and the first matching one gives the stream of the event. When none matches, the
+	// event goes to the "{source}-{subject}" stream, or "{source}" when the event has no subject.
+	Rules []Rule
+	// Largest request body accepted, in bytes.
+	MaxBodySize   int64 // Defaults to 4MiB.
+	Authenticated *esdb.Credentials
+}
+
+func (o *HandlerOptions) setDefaults() {
+	if o.MaxBodySize == 0 {
+		o.MaxBodySize = 4 * 1024 * 1024
+	}
+}
+
+// Handler is an http.Handler appending CloudEvents to EventStoreDB.
+type Handler struct {
+	client *esdb.Client
+	opts   HandlerOptions
+}
+
+// NewHandler returns a handler appending the CloudEvents POSTed to it. The event id is derived from the source and id
+// attributes, which are unique per the CloudEvents specification, so redelivered events are deduplicated by the
+// server idempotency checks. Attributes are stored as the JSON event metadata.
+func NewHandler(client *esdb.Client, opts HandlerOptions) *Handler {
+	opts.setDefaults()
+
+	return &Handler{
+		client: client,
+		opts:   opts,
+	}
+}
+
+// StreamName returns the stream the event is appended to.
+func (h *Handler) StreamName(event *Event) string {
+	for _, rule := range h.opts.Rules {
+		if rule.matches(event) {
+			return expandStreamTemplate(rule.Stream, event)
+		}
+	}
+
+	if event.Subject == "" {
+		return event.Source
+	}
+
+	return event.Source + "-" + event.Subject
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	events, err := parseRequest(r, h.opts.MaxBodySize)
+	if err != nil {
+		switch {
+		case errors.Is(err, errBodyTooLarge):
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		case errors.Is(err, errUnsupportedContentType):
+			http.Error(w, err.Error(), http.StatusUnsupportedMediaType)
+		default:
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+
+		return
+	}
+
+	batches, err := h.groupByStream(events)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	for _, batch := range batches {
+		_, err := h.client.AppendToStream(r.Context(), batch.stream, esdb.AppendToStreamOptions{
+			Authenticated: h.opts.Authenticated,
+		}, batch.events...)
+
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to append to stream %s: %v", batch.stream, err), appendErrorStatus(err))
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type streamBatch struct {
+	stream string
+	events []esdb.EventData
+}
+
+// groupByStream converts the events and groups them per stream, preserving the order in which streams and events
+// came in.
+func (h *Handler) groupByStream(events []*Event) ([]*streamBatch, error) {
+	var batches []*streamBatch
+	byStream := make(map[string]*streamBatch)
+
+	for _, event := range events {
+		data, err := toEventData(event)
+		if err != nil {
+			return nil, err
+		}
+
+		stream := h.StreamName(event)
+		if stream == "" {
+			return nil, fmt.Errorf("no stream for event %s", event.ID)
+		}
+
+		batch, ok := byStream[stream]
+		if !ok {
+			batch = &streamBatch{stream: stream}
+			byStream[stream] = batch
+			batches = append(batches, batch)
+		}
+
+		batch.events = append(batch.events, data)
+	}
+
+	return batches, nil
+}
+
+func toEventData(event *Event) (esdb.EventData, error) {
+	metadata, err := json.Marshal(event.attributes())
+	if err != nil {
+		return esdb.EventData{}, fmt.Errorf("failed to encode attributes of event %s: %w", event.ID, err)
+	}
+
+	contentType := esdb.BinaryContentType
+	if event.isJSON() {
+		contentType = esdb.JsonContentType
+	}
+
+	return esdb.EventData{
+		EventID:     uuid.NewV5(eventIDNamespace, event.Source+"\x00"+event.ID),
+		EventType:   event.Type,
+		ContentType: contentType,
+		Data:        event.Data,
+		Metadata:    metadata,
+	}, nil
+}
+
+func expandStreamTemplate(template string, event *Event) string {
+	return strings.NewReplacer(
+		"{source}", event.Source,
+		"{subject}", event.Subject,
+		"{type}", event.Type,
+		"{id}", event.ID,
+	).Replace(template)
+}
+
+func appendErrorStatus(err error) int {
+	esdbErr, _ := esdb.FromError(err)
+	if esdbErr == nil {
+		return http.StatusBadGateway
+	}
+
+	switch esdbErr.Code() {
+	case esdb.ErrorAccessDenied, esdb.ErrorUnauthenticated:
+		return http.StatusForbidden
+	case esdb.ErrorStreamDeleted:
+		return http.StatusGone
+	case esdb.ErrorWrongExpectedVersion:
+		return http.StatusConflict
+	case esdb.ErrorNotLeader, esdb.ErrorConnectionClosed, esdb.ErrorDeadlineExceeded:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusBadGateway
+	}
+}
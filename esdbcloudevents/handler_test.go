@@ -0,0 +1,122 @@
+package esdbcloudevents
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBinaryMode(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"amount":42}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Ce-Specversion", "1.0")
+	req.Header.Set("Ce-Id", "A234-1234-1234")
+	req.Header.Set("Ce-Source", "/orders")
+	req.Header.Set("Ce-Type", "com.example.order.placed")
+	req.Header.Set("Ce-Subject", "order-1")
+	req.Header.Set("Ce-Tenant", "acme")
+
+	events, err := parseRequest(req, 1024)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	event := events[0]
+	require.Equal(t, "A234-1234-1234", event.ID)
+	require.Equal(t, "/orders", event.Source)
+	require.Equal(t, "order-1", event.Subject)
+	require.Equal(t, "acme", event.Extensions["tenant"])
+	require.Equal(t, `{"amount":42}`, string(event.Data))
+	require.True(t, event.isJSON())
+}
+
+func TestParseStructuredMode(t *testing.T) {
+	body := `{"specversion":"1.0","id":"1","source":"/files","type":"file.uploaded","datacontenttype":"text/plain","data":"hello"}`
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/cloudevents+json; charset=utf-8")
+
+	events, err := parseRequest(req, 1024)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, "hello", string(events[0].Data))
+	require.False(t, events[0].isJSON())
+}
+
+func TestParseBatchMode(t *testing.T) {
+	body := `[
+		{"specversion":"1.0","id":"1","source":"/a","type":"t","data":{"x":1}},
+		{"specversion":"1.0","id":"2","source":"/a","type":"t","data_base64":"AQID"}
+	]`
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/cloudevents-batch+json")
+
+	events, err := parseRequest(req, 1024)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.JSONEq(t, `{"x":1}`, string(events[0].Data))
+	require.Equal(t, []byte{1, 2, 3}, events[1].Data)
+}
+
+func TestRejectsInvalidRequests(t *testing.T) {
+	handler := NewHandler(nil, HandlerOptions{MaxBodySize: 16})
+
+	cases := []struct {
+		name        string
+		method      string
+		contentType string
+		body        string
+		status      int
+	}{
+		{"method", http.MethodGet, "application/cloudevents+json", "", http.StatusMethodNotAllowed},
+		{"not a cloudevent", http.MethodPost, "application/json", "{}", http.StatusUnsupportedMediaType},
+		{"missing attributes", http.MethodPost, "application/cloudevents+json", `{"id":"1"}`, http.StatusBadRequest},
+		{"too large", http.MethodPost, "application/cloudevents+json", strings.Repeat(" ", 17), http.StatusRequestEntityTooLarge},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			req := httptest.NewRequest(c.method, "/", strings.NewReader(c.body))
+			req.Header.Set("Content-Type", c.contentType)
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+			require.Equal(t, c.status, rec.Code)
+		})
+	}
+}
+
+func TestStreamMapping(t *testing.T) {
+	handler := NewHandler(nil, HandlerOptions{
+		Rules: []Rule{
+			{Source: "/orders", Type: "order.placed", Stream: "order-{subject}"},
+			{Source: "/orders", Stream: "orders-{type}"},
+		},
+	})
+
+	require.Equal(t, "order-1", handler.StreamName(&Event{Source: "/orders/eu", Type: "order.placed", Subject: "1"}))
+	require.Equal(t, "orders-order.shipped", handler.StreamName(&Event{Source: "/orders", Type: "order.shipped"}))
+	require.Equal(t, "/billing-inv-1", handler.StreamName(&Event{Source: "/billing", Subject: "inv-1"}))
+	require.Equal(t, "/billing", handler.StreamName(&Event{Source: "/billing"}))
+}
+
+func TestEventDataIsDeterministic(t *testing.T) {
+	handler := NewHandler(nil, HandlerOptions{})
+	event := &Event{ID: "1", Source: "/a", SpecVersion: "1.0", Type: "t", Data: []byte(`{}`)}
+
+	first, err := handler.groupByStream([]*Event{event, {ID: "2", Source: "/b", SpecVersion: "1.0", Type: "t"}, event})
+	require.NoError(t, err)
+	require.Len(t, first, 2)
+	require.Equal(t, "/a", first[0].stream)
+	require.Len(t, first[0].events, 2)
+	require.Equal(t, first[0].events[0].EventID, first[0].events[1].EventID)
+	require.Equal(t, esdb.JsonContentType, first[0].events[0].ContentType)
+
+	var attrs map[string]string
+	require.NoError(t, json.Unmarshal(first[0].events[0].Metadata, &attrs))
+	require.Equal(t, "/a", attrs["source"])
+	require.Equal(t, "1", attrs["id"])
+}
@@ -0,0 +1,97 @@
+package esdbinbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+)
+
+// Handler handles an event at most once, as far as the store can tell.
+type Handler func(ctx context.Context, event *esdb.ResolvedEvent) error
+
+type InboxOptions struct {
+	// What the server does with events whose handler failed.
+	NackAction esdb.Nack_Action // Defaults to esdb.Nack_Retry.
+}
+
+func (o *InboxOptions) setDefaults() {
+	if o.NackAction == esdb.Nack_Unknown {
+		o.NackAction = esdb.Nack_Retry
+	}
+}
+
+// Inbox skips the events whose id is recorded in its store. Events are identified by the id of the resolved event,
+// so the same event delivered through different links is only handled once.
+type Inbox struct {
+	store Store
+	opts  InboxOptions
+}
+
+func New(store Store, opts InboxOptions) *Inbox {
+	opts.setDefaults()
+
+	return &Inbox{
+		store: store,
+		opts:  opts,
+	}
+}
+
+// Handle calls the handler unless the event was already handled, and records the event once the handler succeeded.
+// It reports whether the handler got called.
+func (i *Inbox) Handle(ctx context.Context, event *esdb.ResolvedEvent, handler Handler) (bool, error) {
+	eventID := event.Event.EventID
+
+	seen, err := i.store.Seen(ctx, eventID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check inbox for event %s: %w", eventID, err)
+	}
+
+	if seen {
+		return false, nil
+	}
+
+	if err := handler(ctx, event); err != nil {
+		return true, err
+	}
+
+	if err := i.store.Mark(ctx, eventID); err != nil {
+		return true, fmt.Errorf("failed to record event %s in inbox: %w", eventID, err)
+	}
+
+	return true, nil
+}
+
+// Consume handles the events of the persistent subscription until the context gets cancelled or the subscription
+// drops. Events are acked once handled, or right away if already handled, and nacked when the handler fails. It
+// returns nil when the context got cancelled.
+func (i *Inbox) Consume(ctx context.Context, subscription *esdb.PersistentSubscription, handler Handler) error {
+	for {
+		event := subscription.Recv()
+
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if event.SubscriptionDropped != nil {
+			return event.SubscriptionDropped.Error
+		}
+
+		if event.EventAppeared == nil {
+			continue
+		}
+
+		resolved := event.EventAppeared.Event
+		if _, err := i.Handle(ctx, resolved, handler); err != nil {
+			if err := subscription.NackContext(ctx, err.Error(), i.opts.NackAction, resolved); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := subscription.AckContext(ctx, resolved); err != nil {
+			return err
+		}
+	}
+}
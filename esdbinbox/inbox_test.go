@@ -0,0 +1,59 @@
+package esdbinbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInboxHandlesEventsOnce(t *testing.T) {
+	inbox := New(&MemoryStore{}, InboxOptions{})
+	ctx := context.Background()
+	event := &esdb.ResolvedEvent{Event: &esdb.RecordedEvent{EventID: uuid.Must(uuid.NewV4())}}
+
+	calls := 0
+	handler := func(context.Context, *esdb.ResolvedEvent) error {
+		calls++
+		return nil
+	}
+
+	handled, err := inbox.Handle(ctx, event, handler)
+	require.NoError(t, err)
+	assert.True(t, handled)
+
+	handled, err = inbox.Handle(ctx, event, handler)
+	require.NoError(t, err)
+	assert.False(t, handled)
+	assert.Equal(t, 1, calls)
+}
+
+func TestInboxDoesNotRecordFailedEvents(t *testing.T) {
+	store := &MemoryStore{}
+	inbox := New(store, InboxOptions{})
+	ctx := context.Background()
+	event := &esdb.ResolvedEvent{Event: &esdb.RecordedEvent{EventID: uuid.Must(uuid.NewV4())}}
+
+	handled, err := inbox.Handle(ctx, event, func(context.Context, *esdb.ResolvedEvent) error {
+		return errors.New("boom")
+	})
+	assert.Error(t, err)
+	assert.True(t, handled)
+
+	seen, err := store.Seen(ctx, event.Event.EventID)
+	require.NoError(t, err)
+	assert.False(t, seen)
+}
+
+func TestSQLStoreNames(t *testing.T) {
+	table, column, placeholder := (&SQLStore{}).names()
+	assert.Equal(t, []string{"inbox", "event_id", "?"}, []string{table, column, placeholder})
+
+	store := &SQLStore{Table: "handled", Column: "id", Placeholder: func(n int) string { return "$1" }}
+	table, column, placeholder = store.names()
+	assert.Equal(t, []string{"handled", "id", "$1"}, []string{table, column, placeholder})
+}
@@ -0,0 +1,228 @@
+// Package esdbinbox guards event handlers against redelivery by recording the ids of the events they handled.
+package esdbinbox
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+	"github.com/gofrs/uuid"
+)
+
+// Store records the ids of handled events.
+type Store interface {
+	Seen(ctx context.Context, eventID uuid.UUID) (bool, error)
+	Mark(ctx context.Context, eventID uuid.UUID) error
+}
+
+// MemoryStore keeps handled event ids in memory, which only protects against redeliveries within the process
+// lifetime.
+type MemoryStore struct {
+	lock sync.Mutex
+	ids  map[uuid.UUID]struct{}
+}
+
+func (s *MemoryStore) Seen(_ context.Context, eventID uuid.UUID) (bool, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	_, ok := s.ids[eventID]
+	return ok, nil
+}
+
+func (s *MemoryStore) Mark(_ context.Context, eventID uuid.UUID) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.ids == nil {
+		s.ids = make(map[uuid.UUID]struct{})
+	}
+
+	s.ids[eventID] = struct{}{}
+	return nil
+}
+
+const handledEventType = "EventHandled"
+
+type handledData struct {
+	EventID string `json:"eventId"`
+}
+
+// StreamStore records handled event ids as events of an EventStoreDB stream. The stream is read once, on first use,
+// and then kept in memory, so a stream must only be used by a single consumer process at a time.
+type StreamStore struct {
+	client   *esdb.Client
+	streamID string
+	maxCount uint64
+
+	lock   sync.Mutex
+	loaded bool
+	cache  MemoryStore
+}
+
+// NewStreamStore returns a store backed by the given stream. When maxCount is not zero, it is set as the stream
+// $maxCount so only the most recent ids are kept, which must cover the redelivery window of the subscription.
+func NewStreamStore(client *esdb.Client, streamID string, maxCount uint64) *StreamStore {
+	return &StreamStore{
+		client:   client,
+		streamID: streamID,
+		maxCount: maxCount,
+	}
+}
+
+func (s *StreamStore) load(ctx context.Context) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.loaded {
+		return nil
+	}
+
+	stream, err := s.client.ReadStream(ctx, s.streamID, esdb.ReadStreamOptions{}, ^uint64(0))
+	if err != nil {
+		return err
+	}
+
+	defer stream.Close()
+
+	for {
+		event, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		var esdbErr *esdb.Error
+		if errors.As(err, &esdbErr) && esdbErr.Code() == esdb.ErrorResourceNotFound {
+			break
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to read inbox stream '%s': %w", s.streamID, err)
+		}
+
+		var data handledData
+		if err := json.Unmarshal(event.Event.Data, &data); err != nil {
+			return fmt.Errorf("invalid event in inbox stream '%s': %w", s.streamID, err)
+		}
+
+		id, err := uuid.FromString(data.EventID)
+		if err != nil {
+			return fmt.Errorf("invalid event in inbox stream '%s': %w", s.streamID, err)
+		}
+
+		_ = s.cache.Mark(ctx, id)
+	}
+
+	s.loaded = true
+	return nil
+}
+
+func (s *StreamStore) Seen(ctx context.Context, eventID uuid.UUID) (bool, error) {
+	if err := s.load(ctx); err != nil {
+		return false, err
+	}
+
+	return s.cache.Seen(ctx, eventID)
+}
+
+func (s *StreamStore) Mark(ctx context.Context, eventID uuid.UUID) error {
+	if err := s.load(ctx); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(handledData{EventID: eventID.String()})
+	if err != nil {
+		return err
+	}
+
+	result, err := s.client.AppendToStream(ctx, s.streamID, esdb.AppendToStreamOptions{}, esdb.EventData{
+		EventType:   handledEventType,
+		ContentType: esdb.JsonContentType,
+		Data:        data,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if result.NextExpectedRevision == esdb.Revision(0) && s.maxCount > 0 {
+		meta := esdb.StreamMetadata{}
+		meta.SetMaxCount(s.maxCount)
+
+		if _, err := s.client.SetStreamMetadata(ctx, s.streamID, esdb.AppendToStreamOptions{}, meta); err != nil {
+			return err
+		}
+	}
+
+	return s.cache.Mark(ctx, eventID)
+}
+
+// SQLStore records handled event ids in a SQL table with an event id column holding the ids as strings, such as:
+//
+//	CREATE TABLE inbox (event_id VARCHAR(36) PRIMARY KEY)
+type SQLStore struct {
+	DB *sql.DB
+	// Name of the table.
+	Table string // Defaults to "inbox".
+	// Name of the event id column.
+	Column string // Defaults to "event_id".
+	// Returns the placeholder of the nth (1-based) query parameter, "$1" for PostgreSQL for instance.
+	Placeholder func(n int) string // Defaults to "?".
+}
+
+func (s *SQLStore) names() (string, string, string) {
+	table, column, placeholder := s.Table, s.Column, "?"
+
+	if table == "" {
+		table = "inbox"
+	}
+
+	if column == "" {
+		column = "event_id"
+	}
+
+	if s.Placeholder != nil {
+		placeholder = s.Placeholder(1)
+	}
+
+	return table, column, placeholder
+}
+
+func (s *SQLStore) Seen(ctx context.Context, eventID uuid.UUID) (bool, error) {
+	table, column, placeholder := s.names()
+
+	var count int
+	query := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s = %s", table, column, placeholder)
+	if err := s.DB.QueryRowContext(ctx, query, eventID.String()).Scan(&count); err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (s *SQLStore) Mark(ctx context.Context, eventID uuid.UUID) error {
+	return s.mark(ctx, s.DB, eventID)
+}
+
+// MarkTx records the event id within the transaction. Marking the event in the transaction applying its effects
+// makes handling and recording atomic.
+func (s *SQLStore) MarkTx(ctx context.Context, tx *sql.Tx, eventID uuid.UUID) error {
+	return s.mark(ctx, tx, eventID)
+}
+
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+func (s *SQLStore) mark(ctx context.Context, db execer, eventID uuid.UUID) error {
+	table, column, placeholder := s.names()
+
+	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", table, column, placeholder)
+	_, err := db.ExecContext(ctx, query, eventID.String())
+	return err
+}
@@ -0,0 +1,276 @@
+package esdbmigrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+)
+
+type Options struct {
+	// Streams to migrate.
+	Streams []string
+	// Category whose streams are migrated too, found through the $ce-<Category> system projection stream.
+	Category string
+	// Maps a source stream to its target stream. It must not return the source stream when migrating within the
+	// same cluster.
+	Target func(sourceStream string) string
+	// Events of types without an upcaster are copied as-is.
+	Upcasters *Upcasters
+	// Cluster the target streams are written to. Defaults to the source cluster.
+	Destination *esdb.Client
+	// Number of source events read and appended at a time.
+	BatchSize uint64 // Defaults to 500.
+	// Reads back every target stream and checks it holds as many events as got written.
+	Verify bool
+	// Tombstones each source stream once migrated, and verified when Verify is set. A source stream written to during
+	// its migration isn't tombstoned, and the migration fails with ErrSourceChanged.
+	TombstoneSources bool
+	// Called once each stream got migrated.
+	Progress               func(StreamReport)
+	Authenticated          *esdb.Credentials
+	DestinationCredentials *esdb.Credentials
+}
+
+func (o *Options) setDefaults() {
+	if o.BatchSize == 0 {
+		o.BatchSize = 500
+	}
+
+	if o.Upcasters == nil {
+		o.Upcasters = NewUpcasters()
+	}
+}
+
+// ErrSourceChanged is returned when a source stream got new events after they were copied, which tombstoning it would
+// destroy.
+var ErrSourceChanged = errors.New("source stream changed during the migration")
+
+type StreamReport struct {
+	Source string
+	Target string
+	// Number of events read from the source stream.
+	Read uint64
+	// Number of events appended to the target stream.
+	Written    uint64
+	Tombstoned bool
+}
+
+// Migrate rewrites every source stream into its target stream. Target streams must not exist: writes expect the
+// target to be exactly where the migration left it, so a concurrent writer or a previous partial run makes the
+// migration fail with esdb.ErrorWrongExpectedVersion rather than interleaving events. Reports of the streams migrated
+// before a failure are returned along with the error.
+func Migrate(ctx context.Context, client *esdb.Client, opts Options) ([]StreamReport, error) {
+	opts.setDefaults()
+
+	if opts.Target == nil {
+		return nil, errors.New("a target stream mapping is required")
+	}
+
+	if opts.Destination == nil {
+		opts.Destination = client
+	}
+
+	streams, err := sourceStreams(ctx, client, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var reports []StreamReport
+	for _, source := range streams {
+		report, err := migrateStream(ctx, client, source, opts)
+		if err != nil {
+			return reports, err
+		}
+
+		reports = append(reports, report)
+
+		if opts.Progress != nil {
+			opts.Progress(report)
+		}
+	}
+
+	return reports, nil
+}
+
+func sourceStreams(ctx context.Context, client *esdb.Client, opts Options) ([]string, error) {
+	seen := make(map[string]struct{})
+	var streams []string
+
+	add := func(stream string) {
+		if _, ok := seen[stream]; !ok {
+			seen[stream] = struct{}{}
+			streams = append(streams, stream)
+		}
+	}
+
+	for _, stream := range opts.Streams {
+		add(stream)
+	}
+
+	if opts.Category == "" {
+		return streams, nil
+	}
+
+	var categoryStreams []string
+	// The $ce stream holds links, which resolve to the events of the category streams.
+	err := readAll(ctx, client, esdb.CategoryStream(opts.Category), true, opts.Authenticated, func(event *esdb.ResolvedEvent) error {
+		if _, ok := seen[event.Event.StreamID]; !ok {
+			seen[event.Event.StreamID] = struct{}{}
+			categoryStreams = append(categoryStreams, event.Event.StreamID)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the streams of category %s: %w", opts.Category, err)
+	}
+
+	sort.Strings(categoryStreams)
+	return append(streams, categoryStreams...), nil
+}
+
+func migrateStream(ctx context.Context, client *esdb.Client, source string, opts Options) (StreamReport, error) {
+	report := StreamReport{Source: source, Target: opts.Target(source)}
+
+	if opts.Destination == client && report.Target == source {
+		return report, fmt.Errorf("stream %s can't be migrated onto itself", source)
+	}
+
+	var expected esdb.ExpectedRevision = esdb.NoStream{}
+	var batch []esdb.EventData
+	// Revision of the last source event copied, NoStream when the source had none.
+	var copied esdb.ExpectedRevision = esdb.NoStream{}
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+
+		result, err := opts.Destination.AppendToStream(ctx, report.Target, esdb.AppendToStreamOptions{
+			ExpectedRevision: expected,
+			Authenticated:    opts.DestinationCredentials,
+		}, batch...)
+
+		if err != nil {
+			return fmt.Errorf("failed to append to target stream %s: %w", report.Target, err)
+		}
+
+		expected = result.NextExpectedRevision
+		report.Written += uint64(len(batch))
+		batch = batch[:0]
+		return nil
+	}
+
+	err := readAll(ctx, client, source, false, opts.Authenticated, func(event *esdb.ResolvedEvent) error {
+		report.Read++
+		copied = esdb.Revision(event.OriginalEvent().EventNumber)
+
+		upcasted, err := opts.Upcasters.Apply(esdb.EventDataFromRecordedEvent(event.Event))
+		if err != nil {
+			return err
+		}
+
+		batch = append(batch, upcasted...)
+		if uint64(len(batch)) >= opts.BatchSize {
+			return flush()
+		}
+
+		return nil
+	})
+
+	if err == nil {
+		err = flush()
+	}
+
+	if err != nil {
+		return report, fmt.Errorf("failed to migrate stream %s: %w", source, err)
+	}
+
+	if opts.Verify {
+		if err := verify(ctx, opts, report); err != nil {
+			return report, err
+		}
+	}
+
+	if opts.TombstoneSources {
+		_, err := client.TombstoneStream(ctx, source, esdb.TombstoneStreamOptions{
+			ExpectedRevision: copied,
+			Authenticated:    opts.Authenticated,
+		})
+
+		var wrongErr *esdb.WrongExpectedVersionError
+		if errors.As(err, &wrongErr) {
+			return report, fmt.Errorf("%w: stream %s wasn't tombstoned: %v", ErrSourceChanged, source, err)
+		}
+
+		if err != nil {
+			return report, fmt.Errorf("failed to tombstone stream %s: %w", source, err)
+		}
+
+		report.Tombstoned = true
+	}
+
+	return report, nil
+}
+
+func verify(ctx context.Context, opts Options, report StreamReport) error {
+	var count uint64
+	err := readAll(ctx, opts.Destination, report.Target, false, opts.DestinationCredentials, func(*esdb.ResolvedEvent) error {
+		count++
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to verify target stream %s: %w", report.Target, err)
+	}
+
+	if count != report.Written {
+		return fmt.Errorf("target stream %s holds %d events, %d were written", report.Target, count, report.Written)
+	}
+
+	return nil
+}
+
+// readAll reads the whole stream forwards. A stream which doesn't exist is empty.
+func readAll(ctx context.Context, client *esdb.Client, streamID string, resolveLinkTos bool, creds *esdb.Credentials, fn func(*esdb.ResolvedEvent) error) error {
+	stream, err := client.ReadStream(ctx, streamID, esdb.ReadStreamOptions{
+		ResolveLinkTos: resolveLinkTos,
+		Authenticated:  creds,
+	}, ^uint64(0))
+
+	if err != nil {
+		return err
+	}
+
+	defer stream.Close()
+
+	for {
+		event, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		var esdbErr *esdb.Error
+		if errors.As(err, &esdbErr) && esdbErr.Code() == esdb.ErrorResourceNotFound {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		// Links to deleted events don't resolve.
+		if event.Event == nil {
+			continue
+		}
+
+		if err := fn(event); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Package esdbmigrate rewrites streams into new streams, upcasting their events on the way, for schema changes
+// which can't be done in place.
+package esdbmigrate
+
+import (
+	"fmt"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+	"github.com/gofrs/uuid"
+)
+
+// Upcaster rewrites an event into zero, one or several events. Returning no event drops it. Events returned with a
+// zero EventID get an id derived from the source event id, so rerunning a migration produces the same ids.
+type Upcaster func(event esdb.EventData) ([]esdb.EventData, error)
+
+// maxUpcastDepth bounds upcaster chains, which would otherwise loop forever on upcasters returning their input type.
+const maxUpcastDepth = 16
+
+// Upcasters holds the upcaster of each event type. Upcasters are chained: the events an upcaster returns go through
+// the upcaster of their own type, if any, so v1 → v2 → v3 upcasters compose.
+type Upcasters struct {
+	byType map[string]Upcaster
+}
+
+func NewUpcasters() *Upcasters {
+	return &Upcasters{byType: make(map[string]Upcaster)}
+}
+
+// Register sets the upcaster of an event type, replacing the previous one.
+func (u *Upcasters) Register(eventType string, upcaster Upcaster) {
+	u.byType[eventType] = upcaster
+}
+
+// Apply runs the event through the registered upcasters.
+func (u *Upcasters) Apply(event esdb.EventData) ([]esdb.EventData, error) {
+	return u.apply(event, 0)
+}
+
+func (u *Upcasters) apply(event esdb.EventData, depth int) ([]esdb.EventData, error) {
+	upcaster, ok := u.byType[event.EventType]
+	if !ok {
+		return []esdb.EventData{event}, nil
+	}
+
+	if depth >= maxUpcastDepth {
+		return nil, fmt.Errorf("upcasting event %s of type %s exceeded %d steps", event.EventID, event.EventType, maxUpcastDepth)
+	}
+
+	upcasted, err := upcaster(event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upcast event %s of type %s: %w", event.EventID, event.EventType, err)
+	}
+
+	var result []esdb.EventData
+	for i, next := range upcasted {
+		if next.EventID == uuid.Nil {
+			next.EventID = derivedEventID(event.EventID, i)
+		}
+
+		if next.EventType == event.EventType {
+			result = append(result, next)
+			continue
+		}
+
+		chained, err := u.apply(next, depth+1)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, chained...)
+	}
+
+	return result, nil
+}
+
+func derivedEventID(source uuid.UUID, index int) uuid.UUID {
+	return uuid.NewV5(source, fmt.Sprintf("upcast-%d", index))
+}
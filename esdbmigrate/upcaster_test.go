@@ -0,0 +1,64 @@
+package esdbmigrate
+
+import (
+	"testing"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpcastersChain(t *testing.T) {
+	upcasters := NewUpcasters()
+	upcasters.Register("NameSet.v1", func(event esdb.EventData) ([]esdb.EventData, error) {
+		event.EventType = "NameSet.v2"
+		return []esdb.EventData{event}, nil
+	})
+	upcasters.Register("NameSet.v2", func(event esdb.EventData) ([]esdb.EventData, error) {
+		return []esdb.EventData{
+			{EventType: "FirstNameSet", Data: event.Data},
+			{EventType: "LastNameSet", Data: event.Data},
+		}, nil
+	})
+	upcasters.Register("Obsolete", func(esdb.EventData) ([]esdb.EventData, error) {
+		return nil, nil
+	})
+
+	source := uuid.Must(uuid.NewV4())
+	events, err := upcasters.Apply(esdb.EventData{EventID: source, EventType: "NameSet.v1"})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, "FirstNameSet", events[0].EventType)
+	assert.Equal(t, "LastNameSet", events[1].EventType)
+	assert.Equal(t, derivedEventID(source, 0), events[0].EventID)
+	assert.Equal(t, derivedEventID(source, 1), events[1].EventID)
+	assert.NotEqual(t, events[0].EventID, events[1].EventID)
+
+	again, err := upcasters.Apply(esdb.EventData{EventID: source, EventType: "NameSet.v1"})
+	require.NoError(t, err)
+	assert.Equal(t, events[0].EventID, again[0].EventID)
+
+	events, err = upcasters.Apply(esdb.EventData{EventID: source, EventType: "Obsolete"})
+	require.NoError(t, err)
+	assert.Empty(t, events)
+
+	events, err = upcasters.Apply(esdb.EventData{EventID: source, EventType: "Untouched"})
+	require.NoError(t, err)
+	assert.Equal(t, []esdb.EventData{{EventID: source, EventType: "Untouched"}}, events)
+}
+
+func TestUpcastersDetectLoops(t *testing.T) {
+	upcasters := NewUpcasters()
+	upcasters.Register("A", func(event esdb.EventData) ([]esdb.EventData, error) {
+		event.EventType = "B"
+		return []esdb.EventData{event}, nil
+	})
+	upcasters.Register("B", func(event esdb.EventData) ([]esdb.EventData, error) {
+		event.EventType = "A"
+		return []esdb.EventData{event}, nil
+	})
+
+	_, err := upcasters.Apply(esdb.EventData{EventType: "A"})
+	assert.Error(t, err)
+}
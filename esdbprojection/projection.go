@@ -0,0 +1,92 @@
+// Package esdbprojection runs read models over $all: a projection is a state and a set of typed event handlers, fed
+// by a filtered $all subscription.
+package esdbprojection
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+)
+
+type handler[S any] func(ctx context.Context, state *S, event *esdb.RecordedEvent) error
+
+// Projection defines how events are folded into a state of type S.
+type Projection[S any] struct {
+	name     string
+	initial  func() S
+	handlers map[string]handler[S]
+	// Called when the projection gets rebuilt, before any event is handled. Use it to clear external read models.
+	OnReset func(ctx context.Context) error
+}
+
+// New returns a projection without handlers, whose state starts as initial().
+func New[S any](name string, initial func() S) *Projection[S] {
+	return &Projection[S]{
+		name:     name,
+		initial:  initial,
+		handlers: make(map[string]handler[S]),
+	}
+}
+
+func (p *Projection[S]) Name() string {
+	return p.name
+}
+
+// On registers the handler of an event type. The event data is decoded from JSON into an E value.
+func On[S any, E any](p *Projection[S], eventType string, handle func(ctx context.Context, state *S, event E, recorded *esdb.RecordedEvent) error) {
+	p.handlers[eventType] = func(ctx context.Context, state *S, recorded *esdb.RecordedEvent) error {
+		var event E
+		if err := json.Unmarshal(recorded.Data, &event); err != nil {
+			return fmt.Errorf("failed to decode %s event: %w", recorded.EventType, err)
+		}
+
+		return handle(ctx, state, event, recorded)
+	}
+}
+
+// OnRaw registers the handler of an event type, which receives the recorded event as is.
+func OnRaw[S any](p *Projection[S], eventType string, handle func(ctx context.Context, state *S, recorded *esdb.RecordedEvent) error) {
+	p.handlers[eventType] = handle
+}
+
+// EventTypes returns the sorted event types the projection handles.
+func (p *Projection[S]) EventTypes() []string {
+	types := make([]string, 0, len(p.handlers))
+	for eventType := range p.handlers {
+		types = append(types, eventType)
+	}
+
+	sort.Strings(types)
+	return types
+}
+
+// filter returns a server-side filter only letting through the handled event types.
+func (p *Projection[S]) filter() *esdb.SubscriptionFilter {
+	types := p.EventTypes()
+	for i, eventType := range types {
+		types[i] = regexp.QuoteMeta(eventType)
+	}
+
+	return &esdb.SubscriptionFilter{
+		Type:  esdb.EventFilterType,
+		Regex: "^(?:" + strings.Join(types, "|") + ")$",
+	}
+}
+
+func (p *Projection[S]) handle(ctx context.Context, state *S, event *esdb.RecordedEvent) error {
+	handle, ok := p.handlers[event.EventType]
+	if !ok {
+		return nil
+	}
+
+	if err := handle(ctx, state, event); err != nil {
+		return fmt.Errorf("projection %s failed to handle event %d@%s: %w", p.name, event.EventNumber, event.StreamID, err)
+	}
+
+	return nil
+}
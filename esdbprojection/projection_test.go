@@ -0,0 +1,77 @@
+package esdbprojection
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type itemAdded struct {
+	Quantity int `json:"quantity"`
+}
+
+type totals struct {
+	Items int
+}
+
+func testProjection() *Projection[totals] {
+	projection := New("totals", func() totals { return totals{} })
+
+	On(projection, "ItemAdded", func(_ context.Context, state *totals, event itemAdded, _ *esdb.RecordedEvent) error {
+		state.Items += event.Quantity
+		return nil
+	})
+
+	OnRaw(projection, "Cart.Cleared", func(_ context.Context, state *totals, _ *esdb.RecordedEvent) error {
+		state.Items = 0
+		return nil
+	})
+
+	OnRaw(projection, "Broken", func(context.Context, *totals, *esdb.RecordedEvent) error {
+		return errors.New("boom")
+	})
+
+	return projection
+}
+
+func TestProjectionHandlesEvents(t *testing.T) {
+	projection := testProjection()
+	state := projection.initial()
+	ctx := context.Background()
+
+	require.NoError(t, projection.handle(ctx, &state, &esdb.RecordedEvent{EventType: "ItemAdded", Data: []byte(`{"quantity":2}`)}))
+	require.NoError(t, projection.handle(ctx, &state, &esdb.RecordedEvent{EventType: "ItemAdded", Data: []byte(`{"quantity":3}`)}))
+	require.NoError(t, projection.handle(ctx, &state, &esdb.RecordedEvent{EventType: "Unhandled"}))
+	assert.Equal(t, 5, state.Items)
+
+	require.NoError(t, projection.handle(ctx, &state, &esdb.RecordedEvent{EventType: "Cart.Cleared"}))
+	assert.Equal(t, 0, state.Items)
+
+	assert.Error(t, projection.handle(ctx, &state, &esdb.RecordedEvent{EventType: "ItemAdded", Data: []byte(`nope`)}))
+	assert.Error(t, projection.handle(ctx, &state, &esdb.RecordedEvent{EventType: "Broken"}))
+}
+
+func TestProjectionFilter(t *testing.T) {
+	projection := testProjection()
+	assert.Equal(t, []string{"Broken", "Cart.Cleared", "ItemAdded"}, projection.EventTypes())
+
+	filter := projection.filter()
+	assert.Equal(t, esdb.EventFilterType, filter.Type)
+
+	regex := regexp.MustCompile(filter.Regex)
+	assert.True(t, regex.MatchString("Cart.Cleared"))
+	assert.True(t, regex.MatchString("ItemAdded"))
+	assert.False(t, regex.MatchString("CartXCleared"))
+	assert.False(t, regex.MatchString("ItemAddedLater"))
+}
+
+func TestStatusString(t *testing.T) {
+	assert.Equal(t, "stopped", Stopped.String())
+	assert.Equal(t, "rebuilding", Rebuilding.String())
+	assert.Equal(t, "live", Live.String())
+}
@@ -0,0 +1,291 @@
+package esdbprojection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+	"github.com/EventStore/EventStore-Client-Go/v2/esdbcatchup"
+)
+
+type Status int
+
+const (
+	// Stopped means the runner isn't running.
+	Stopped Status = iota
+	// Rebuilding means the runner is catching up with events written before it started.
+	Rebuilding
+	// Live means the runner caught up and handles events as they get written.
+	Live
+)
+
+func (s Status) String() string {
+	switch s {
+	case Rebuilding:
+		return "rebuilding"
+	case Live:
+		return "live"
+	default:
+		return "stopped"
+	}
+}
+
+type RunnerOptions struct {
+	// Where the projection progress is kept. Only use it when the projection state lives outside the process, like a
+	// database table updated by the handlers, and is durable. Without it, the projection is rebuilt from the
+	// beginning of $all on every run. Only the checkpoint position is used.
+	Checkpoints esdbcatchup.CheckpointStore
+	// Number of handled events after which a checkpoint is stored.
+	CheckpointEvery int // Defaults to 100.
+	// Server-side filter of the subscription. Defaults to the event types the projection handles.
+	Filter        *esdb.SubscriptionFilter
+	Authenticated *esdb.Credentials
+}
+
+func (o *RunnerOptions) setDefaults() {
+	if o.CheckpointEvery == 0 {
+		o.CheckpointEvery = 100
+	}
+}
+
+// Runner feeds a projection from a $all subscription. Its status is Rebuilding until the subscription reaches the
+// position $all was at when the run started, then Live.
+type Runner[S any] struct {
+	client     *esdb.Client
+	projection *Projection[S]
+	opts       RunnerOptions
+
+	lock   sync.RWMutex
+	state  S
+	status Status
+}
+
+func NewRunner[S any](client *esdb.Client, projection *Projection[S], opts RunnerOptions) *Runner[S] {
+	opts.setDefaults()
+
+	return &Runner[S]{
+		client:     client,
+		projection: projection,
+		opts:       opts,
+		state:      projection.initial(),
+	}
+}
+
+func (r *Runner[S]) Status() Status {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	return r.status
+}
+
+// State returns a shallow copy of the current projection state.
+func (r *Runner[S]) State() S {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	return r.state
+}
+
+// Run handles events until the context gets cancelled or a handler fails. It resumes from the stored checkpoint, if
+// any. It returns nil when the context got cancelled.
+func (r *Runner[S]) Run(ctx context.Context) error {
+	var checkpoint *esdb.Position
+
+	if r.opts.Checkpoints != nil {
+		stored, err := r.opts.Checkpoints.Load(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to load checkpoint of projection %s: %w", r.projection.name, err)
+		}
+
+		if stored != nil {
+			checkpoint = &stored.Position
+		}
+	}
+
+	if checkpoint == nil {
+		if err := r.reset(ctx); err != nil {
+			return err
+		}
+	}
+
+	return r.run(ctx, checkpoint)
+}
+
+// Rebuild discards the projection state, calls the projection OnReset hook and handles all the events from the
+// beginning of $all, then keeps running like Run.
+func (r *Runner[S]) Rebuild(ctx context.Context) error {
+	if err := r.reset(ctx); err != nil {
+		return err
+	}
+
+	return r.run(ctx, nil)
+}
+
+func (r *Runner[S]) reset(ctx context.Context) error {
+	r.lock.Lock()
+	r.state = r.projection.initial()
+	r.lock.Unlock()
+
+	if r.projection.OnReset != nil {
+		if err := r.projection.OnReset(ctx); err != nil {
+			return fmt.Errorf("failed to reset projection %s: %w", r.projection.name, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Runner[S]) setStatus(status Status) {
+	r.lock.Lock()
+	r.status = status
+	r.lock.Unlock()
+}
+
+func (r *Runner[S]) run(ctx context.Context, checkpoint *esdb.Position) error {
+	defer r.setStatus(Stopped)
+
+	head, err := r.headPosition(ctx)
+	if err != nil {
+		return err
+	}
+
+	live := head == nil || (checkpoint != nil && !head.After(*checkpoint))
+	if live {
+		r.setStatus(Live)
+	} else {
+		r.setStatus(Rebuilding)
+	}
+
+	var from esdb.AllPosition = esdb.Start{}
+	if checkpoint != nil {
+		from = *checkpoint
+	}
+
+	filter := r.opts.Filter
+	if filter == nil {
+		filter = r.projection.filter()
+	}
+
+	subscription, err := r.client.SubscribeToAll(ctx, esdb.SubscribeToAllOptions{
+		From:          from,
+		Filter:        filter,
+		Authenticated: r.opts.Authenticated,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to subscribe projection %s: %w", r.projection.name, err)
+	}
+
+	defer subscription.Close()
+
+	pending := 0
+	var last *esdb.Position
+
+	storeCheckpoint := func(ctx context.Context, position esdb.Position) error {
+		if r.opts.Checkpoints == nil {
+			return nil
+		}
+
+		if err := r.opts.Checkpoints.Store(ctx, esdbcatchup.Checkpoint{Position: position}); err != nil {
+			return fmt.Errorf("failed to store checkpoint of projection %s: %w", r.projection.name, err)
+		}
+
+		pending = 0
+		return nil
+	}
+
+	reached := func(position esdb.Position) {
+		if !live && !head.After(position) {
+			live = true
+			r.setStatus(Live)
+		}
+	}
+
+	for {
+		event := subscription.Recv()
+
+		if ctx.Err() != nil {
+			if last != nil && pending > 0 {
+				_ = storeCheckpoint(context.Background(), *last)
+			}
+
+			return nil
+		}
+
+		if event.SubscriptionDropped != nil {
+			return fmt.Errorf("subscription of projection %s dropped: %w", r.projection.name, event.SubscriptionDropped.Error)
+		}
+
+		if event.CheckPointReached != nil {
+			reached(*event.CheckPointReached)
+
+			if checkpoint == nil || event.CheckPointReached.After(*checkpoint) {
+				if err := storeCheckpoint(ctx, *event.CheckPointReached); err != nil {
+					return err
+				}
+			}
+
+			continue
+		}
+
+		if event.EventAppeared == nil {
+			continue
+		}
+
+		recorded := event.EventAppeared.Event
+
+		// Subscribing from a position includes the event at that position, which was already handled.
+		if checkpoint != nil && !recorded.Position.After(*checkpoint) {
+			continue
+		}
+
+		r.lock.Lock()
+		err := r.projection.handle(ctx, &r.state, recorded)
+		r.lock.Unlock()
+
+		if err != nil {
+			return err
+		}
+
+		position := recorded.Position
+		last = &position
+		pending++
+		reached(position)
+
+		if pending >= r.opts.CheckpointEvery {
+			if err := storeCheckpoint(ctx, position); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// headPosition returns the position of the last event of $all, or nil when the database is empty.
+func (r *Runner[S]) headPosition(ctx context.Context) (*esdb.Position, error) {
+	stream, err := r.client.ReadAll(ctx, esdb.ReadAllOptions{
+		Direction:     esdb.Backwards,
+		From:          esdb.End{},
+		Authenticated: r.opts.Authenticated,
+	}, 1)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer stream.Close()
+
+	event, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the head of $all: %w", err)
+	}
+
+	position := event.OriginalEvent().Position
+	return &position, nil
+}
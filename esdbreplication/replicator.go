@@ -0,0 +1,191 @@
+// Package esdbreplication continuously copies the events of a source EventStoreDB cluster into a destination
+// cluster.
+package esdbreplication
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+	"github.com/EventStore/EventStore-Client-Go/v2/esdbcatchup"
+)
+
+type Options struct {
+	// Server-side filter applied to the $all subscription. System streams are never replicated, regardless of the
+	// filter.
+	Filter *esdb.SubscriptionFilter
+	// Where the replication progress is kept, usually an esdbcatchup.StreamCheckpointStore on the destination cluster
+	// so it moves along with the replicated data. Only the checkpoint position is used.
+	Checkpoints esdbcatchup.CheckpointStore // Defaults to an esdbcatchup.MemoryCheckpointStore.
+	// Number of replicated events after which a checkpoint is stored.
+	CheckpointEvery int // Defaults to 100.
+	// Credentials used against the source and destination clusters.
+	SourceCredentials      *esdb.Credentials
+	DestinationCredentials *esdb.Credentials
+}
+
+func (o *Options) setDefaults() {
+	if o.Checkpoints == nil {
+		o.Checkpoints = &esdbcatchup.MemoryCheckpointStore{}
+	}
+
+	if o.CheckpointEvery == 0 {
+		o.CheckpointEvery = 100
+	}
+}
+
+// Replicator subscribes to $all on the source cluster and appends every user event, stream metadata and tombstone to
+// the destination cluster, one at a time and in transaction log order. Events keep their ids, so the server side
+// idempotency makes replaying events after a restart from an older checkpoint harmless.
+type Replicator struct {
+	source      *esdb.Client
+	destination *esdb.Client
+	opts        Options
+}
+
+func New(source *esdb.Client, destination *esdb.Client, opts Options) *Replicator {
+	opts.setDefaults()
+
+	return &Replicator{
+		source:      source,
+		destination: destination,
+		opts:        opts,
+	}
+}
+
+// Run replicates events until the context gets cancelled or an error occurs. It returns nil when the context got
+// cancelled.
+func (r *Replicator) Run(ctx context.Context) error {
+	stored, err := r.opts.Checkpoints.Load(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to load replication checkpoint: %w", err)
+	}
+
+	var checkpoint *esdb.Position
+	if stored != nil {
+		checkpoint = &stored.Position
+	}
+
+	var from esdb.AllPosition = esdb.Start{}
+	if checkpoint != nil {
+		from = *checkpoint
+	}
+
+	subscription, err := r.source.SubscribeToAll(ctx, esdb.SubscribeToAllOptions{
+		From:          from,
+		Filter:        r.opts.Filter,
+		Authenticated: r.opts.SourceCredentials,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to the source cluster: %w", err)
+	}
+
+	defer subscription.Close()
+
+	pending := 0
+	var last *esdb.Position
+
+	storeCheckpoint := func(position esdb.Position) error {
+		if err := r.opts.Checkpoints.Store(ctx, esdbcatchup.Checkpoint{Position: position}); err != nil {
+			return fmt.Errorf("failed to store replication checkpoint: %w", err)
+		}
+
+		pending = 0
+		return nil
+	}
+
+	for {
+		event := subscription.Recv()
+
+		if ctx.Err() != nil {
+			if last != nil && pending > 0 {
+				// The run context is done, so the final checkpoint is stored with a fresh context.
+				_ = r.opts.Checkpoints.Store(context.Background(), esdbcatchup.Checkpoint{Position: *last})
+			}
+
+			return nil
+		}
+
+		if event.SubscriptionDropped != nil {
+			return fmt.Errorf("source subscription dropped: %w", event.SubscriptionDropped.Error)
+		}
+
+		if event.CheckPointReached != nil {
+			if checkpoint == nil || event.CheckPointReached.After(*checkpoint) {
+				if err := storeCheckpoint(*event.CheckPointReached); err != nil {
+					return err
+				}
+			}
+
+			continue
+		}
+
+		if event.EventAppeared == nil {
+			continue
+		}
+
+		recorded := event.EventAppeared.Event
+
+		// Subscribing from a position includes the event at that position, which was already replicated.
+		if checkpoint != nil && !recorded.Position.After(*checkpoint) {
+			continue
+		}
+
+		if isReplicated(recorded.StreamID) {
+			if err := r.replicate(ctx, recorded); err != nil {
+				return err
+			}
+		}
+
+		position := recorded.Position
+		last = &position
+		pending++
+
+		if pending >= r.opts.CheckpointEvery {
+			if err := storeCheckpoint(position); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (r *Replicator) replicate(ctx context.Context, event *esdb.RecordedEvent) error {
+	if event.EventType == esdb.StreamDeletedEventType {
+		_, err := r.destination.TombstoneStream(ctx, event.StreamID, esdb.TombstoneStreamOptions{
+			Authenticated: r.opts.DestinationCredentials,
+		})
+
+		var esdbErr *esdb.Error
+		if errors.As(err, &esdbErr) && esdbErr.Code() == esdb.ErrorStreamDeleted {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to replicate tombstone of stream '%s': %w", event.StreamID, err)
+		}
+
+		return nil
+	}
+
+	_, err := r.destination.AppendToStream(ctx, event.StreamID, esdb.AppendToStreamOptions{
+		Authenticated: r.opts.DestinationCredentials,
+	}, esdb.EventDataFromRecordedEvent(event))
+
+	if err != nil {
+		return fmt.Errorf("failed to replicate event %d of stream '%s': %w", event.EventNumber, event.StreamID, err)
+	}
+
+	return nil
+}
+
+// isReplicated tells if events of the stream get replicated: user streams and their metadata streams.
+func isReplicated(streamID string) bool {
+	if esdb.IsMetadataStream(streamID) {
+		return !esdb.IsSystemStream(streamID[2:])
+	}
+
+	return !esdb.IsSystemStream(streamID)
+}
@@ -0,0 +1,14 @@
+package esdbreplication
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsReplicated(t *testing.T) {
+	assert.True(t, isReplicated("account-1"))
+	assert.True(t, isReplicated("$$account-1"))
+	assert.False(t, isReplicated("$ce-account"))
+	assert.False(t, isReplicated("$$$ce-account"))
+}
@@ -0,0 +1,260 @@
+package esdbretention
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+)
+
+const streamsStreamName = "$streams"
+
+type Options struct {
+	// Policies are evaluated in order, and the first one matching a stream applies to it.
+	Policies []Policy
+	// Time between two convergences when running continuously.
+	Interval time.Duration // Defaults to 1h.
+	// Computes and reports the changes without writing them.
+	DryRun bool
+	// Called with the report of each convergence.
+	Report        func(*Report)
+	Authenticated *esdb.Credentials
+}
+
+func (o *Options) setDefaults() {
+	if o.Interval == 0 {
+		o.Interval = time.Hour
+	}
+}
+
+// Change describes a stream whose retention metadata differs from its policy.
+type Change struct {
+	Stream string
+	Policy string
+	Before Retention
+	After  Retention
+	// Applied tells if the change got written, which is never the case in dry-run mode.
+	Applied bool
+}
+
+type Report struct {
+	// Number of streams checked against the policies.
+	Scanned int
+	// Number of streams matched by a policy.
+	Matched int
+	Changes []Change
+	// Errors keyed by stream. A failing stream doesn't stop the convergence.
+	Errors map[string]error
+}
+
+// Manager converges the metadata of streams towards the retention policies. Streams are discovered through the
+// $streams system projection stream, so the $streams projection must be running.
+type Manager struct {
+	client *esdb.Client
+	opts   Options
+}
+
+func New(client *esdb.Client, opts Options) *Manager {
+	opts.setDefaults()
+
+	return &Manager{
+		client: client,
+		opts:   opts,
+	}
+}
+
+// Run converges every Interval until the context gets cancelled, which makes it return nil. Errors of individual
+// streams are reported, only failures to list the streams stop it.
+func (m *Manager) Run(ctx context.Context) error {
+	ticker := time.NewTicker(m.opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := m.Converge(ctx); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Converge checks every stream once and updates the metadata of the ones not matching their policy.
+func (m *Manager) Converge(ctx context.Context) (*Report, error) {
+	report := &Report{Errors: make(map[string]error)}
+
+	err := m.forEachStream(ctx, func(streamID string) {
+		report.Scanned++
+
+		policy := m.policyOf(streamID)
+		if policy == nil {
+			return
+		}
+
+		report.Matched++
+
+		change, err := m.converge(ctx, streamID, policy)
+		if err != nil {
+			report.Errors[streamID] = err
+			return
+		}
+
+		if change != nil {
+			report.Changes = append(report.Changes, *change)
+		}
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if m.opts.Report != nil {
+		m.opts.Report(report)
+	}
+
+	return report, nil
+}
+
+func (m *Manager) policyOf(streamID string) *Policy {
+	for i := range m.opts.Policies {
+		if m.opts.Policies[i].matches(streamID) {
+			return &m.opts.Policies[i]
+		}
+	}
+
+	return nil
+}
+
+func (m *Manager) converge(ctx context.Context, streamID string, policy *Policy) (*Change, error) {
+	var head *uint64
+	if policy.TruncateKeepLast != nil {
+		revision, err := m.headRevision(ctx, streamID)
+		if err != nil {
+			return nil, err
+		}
+
+		head = revision
+	}
+
+	meta, err := m.client.GetStreamMetadata(ctx, streamID, esdb.ReadStreamOptions{
+		Direction:     esdb.Backwards,
+		From:          esdb.End{},
+		Authenticated: m.opts.Authenticated,
+	})
+
+	// A stream which never had metadata gets it created.
+	var esdbErr *esdb.Error
+	if errors.As(err, &esdbErr) && esdbErr.Code() == esdb.ErrorResourceNotFound {
+		meta, err = &esdb.StreamMetadata{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	desired := policy.apply(*meta, head)
+	change := &Change{
+		Stream: streamID,
+		Policy: policy.Name,
+		Before: retentionOf(meta),
+		After:  retentionOf(&desired),
+	}
+
+	if change.Before.equal(change.After) {
+		return nil, nil
+	}
+
+	if m.opts.DryRun {
+		return change, nil
+	}
+
+	_, err = m.client.UpdateStreamMetadata(ctx, streamID, esdb.UpdateStreamMetadataOptions{
+		Authenticated: m.opts.Authenticated,
+	}, func(current esdb.StreamMetadata) (esdb.StreamMetadata, error) {
+		return policy.apply(current, head), nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	change.Applied = true
+	return change, nil
+}
+
+// forEachStream calls fn with the name of every stream listed by the $streams projection.
+func (m *Manager) forEachStream(ctx context.Context, fn func(streamID string)) error {
+	stream, err := m.client.ReadStream(ctx, streamsStreamName, esdb.ReadStreamOptions{
+		Authenticated: m.opts.Authenticated,
+	}, ^uint64(0))
+
+	if err != nil {
+		return err
+	}
+
+	defer stream.Close()
+
+	for {
+		event, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to list streams: %w", err)
+		}
+
+		// $streams holds links to the first event of each stream, whose data is "<revision>@<stream>".
+		recorded := event.OriginalEvent()
+		if recorded.EventType != esdb.LinkEventType {
+			continue
+		}
+
+		if _, streamID, ok := strings.Cut(string(recorded.Data), "@"); ok {
+			fn(streamID)
+		}
+	}
+}
+
+// headRevision returns the revision of the last event of the stream, or nil if the stream is empty.
+func (m *Manager) headRevision(ctx context.Context, streamID string) (*uint64, error) {
+	stream, err := m.client.ReadStream(ctx, streamID, esdb.ReadStreamOptions{
+		Direction:     esdb.Backwards,
+		From:          esdb.End{},
+		Authenticated: m.opts.Authenticated,
+	}, 1)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer stream.Close()
+
+	event, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+
+	var esdbErr *esdb.Error
+	if errors.As(err, &esdbErr) && esdbErr.Code() == esdb.ErrorResourceNotFound {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	revision := event.OriginalEvent().EventNumber
+	return &revision, nil
+}
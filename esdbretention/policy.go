@@ -0,0 +1,89 @@
+// Package esdbretention manages stream retention as code: policies describe the retention of groups of streams, and
+// the manager converges the metadata of the matching streams towards them.
+package esdbretention
+
+import (
+	"strings"
+	"time"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+)
+
+// Policy sets the retention of the streams it matches. Unset limits are left as they are in the stream metadata.
+type Policy struct {
+	Name string
+	// Matches the streams of the category, i.e. whose name starts with "<Category>-".
+	Category string
+	// Matches the streams whose name starts with the prefix. A policy without Category nor Prefix matches every user
+	// stream.
+	Prefix string
+	// Sets the $maxAge of the streams.
+	MaxAge *time.Duration
+	// Sets the $maxCount of the streams.
+	MaxCount *uint64
+	// Truncates the streams so only their last TruncateKeepLast events are kept, by moving their $tb forward.
+	// Unlike MaxCount, the truncation is applied once per convergence, so events written later are kept until the
+	// next one.
+	TruncateKeepLast *uint64
+}
+
+func (p *Policy) matches(streamID string) bool {
+	if esdb.IsSystemStream(streamID) {
+		return false
+	}
+
+	if p.Category != "" && !strings.HasPrefix(streamID, p.Category+"-") {
+		return false
+	}
+
+	return strings.HasPrefix(streamID, p.Prefix)
+}
+
+// apply returns the metadata with the policy applied. The head revision is only used by TruncateKeepLast.
+func (p *Policy) apply(meta esdb.StreamMetadata, head *uint64) esdb.StreamMetadata {
+	if p.MaxAge != nil {
+		meta.SetMaxAge(*p.MaxAge)
+	}
+
+	if p.MaxCount != nil {
+		meta.SetMaxCount(*p.MaxCount)
+	}
+
+	if p.TruncateKeepLast != nil && head != nil && *head+1 > *p.TruncateKeepLast {
+		before := *head + 1 - *p.TruncateKeepLast
+
+		// $tb only ever moves forward.
+		if current := meta.TruncateBefore(); current == nil || *current < before {
+			meta.SetTruncateBefore(before)
+		}
+	}
+
+	return meta
+}
+
+// Retention holds the retention-related metadata values of a stream.
+type Retention struct {
+	MaxAge         *time.Duration
+	MaxCount       *uint64
+	TruncateBefore *uint64
+}
+
+func retentionOf(meta *esdb.StreamMetadata) Retention {
+	return Retention{
+		MaxAge:         meta.MaxAge(),
+		MaxCount:       meta.MaxCount(),
+		TruncateBefore: meta.TruncateBefore(),
+	}
+}
+
+func (r Retention) equal(other Retention) bool {
+	return equalPtr(r.MaxAge, other.MaxAge) && equalPtr(r.MaxCount, other.MaxCount) && equalPtr(r.TruncateBefore, other.TruncateBefore)
+}
+
+func equalPtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
@@ -0,0 +1,60 @@
+package esdbretention
+
+import (
+	"testing"
+	"time"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+	"github.com/stretchr/testify/assert"
+)
+
+func ptr[T any](value T) *T {
+	return &value
+}
+
+func TestPolicyMatches(t *testing.T) {
+	category := Policy{Category: "order"}
+	assert.True(t, category.matches("order-1"))
+	assert.False(t, category.matches("orders-1"))
+	assert.False(t, category.matches("$ce-order"))
+
+	prefix := Policy{Prefix: "tmp"}
+	assert.True(t, prefix.matches("tmp-1"))
+	assert.True(t, prefix.matches("tmpfile"))
+	assert.False(t, prefix.matches("order-1"))
+
+	all := Policy{}
+	assert.True(t, all.matches("anything"))
+	assert.False(t, all.matches("$settings"))
+}
+
+func TestPolicyApply(t *testing.T) {
+	policy := Policy{MaxAge: ptr(time.Hour), TruncateKeepLast: ptr(uint64(10))}
+
+	meta := esdb.StreamMetadata{}
+	meta.SetMaxCount(5)
+
+	applied := policy.apply(meta, ptr(uint64(99)))
+	assert.Equal(t, time.Hour, *applied.MaxAge())
+	assert.Equal(t, uint64(5), *applied.MaxCount())
+	assert.Equal(t, uint64(90), *applied.TruncateBefore())
+
+	// $tb never moves backwards.
+	meta.SetTruncateBefore(95)
+	applied = policy.apply(meta, ptr(uint64(99)))
+	assert.Equal(t, uint64(95), *applied.TruncateBefore())
+
+	// Streams shorter than the limit aren't truncated.
+	applied = policy.apply(esdb.StreamMetadata{}, ptr(uint64(3)))
+	assert.Nil(t, applied.TruncateBefore())
+}
+
+func TestRetentionEqual(t *testing.T) {
+	before := retentionOf(&esdb.StreamMetadata{})
+	assert.True(t, before.equal(Retention{}))
+
+	meta := esdb.StreamMetadata{}
+	meta.SetMaxCount(5)
+	assert.False(t, before.equal(retentionOf(&meta)))
+	assert.True(t, retentionOf(&meta).equal(Retention{MaxCount: ptr(uint64(5))}))
+}
@@ -0,0 +1,49 @@
+package kafkasink_test
+
+import (
+	"context"
+	"log"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+	"github.com/EventStore/EventStore-Client-Go/v2/esdbsink"
+	"github.com/EventStore/EventStore-Client-Go/v2/esdbsink/kafkasink"
+	"github.com/segmentio/kafka-go"
+)
+
+func Example() {
+	settings, err := esdb.ParseConnectionString("esdb://localhost:2113?tls=false")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	client, err := esdb.NewClient(settings)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	defer client.Close()
+
+	ctx := context.Background()
+	subscription, err := client.SubscribeToPersistentSubscription(ctx, "orders", "kafka", esdb.SubscribeToPersistentSubscriptionOptions{
+		BufferSize: 500,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	defer subscription.Close()
+
+	sink := kafkasink.New(&kafka.Writer{
+		Addr:         kafka.TCP("localhost:9092"),
+		Topic:        "orders",
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireAll,
+	})
+
+	defer sink.Close()
+
+	bridge := esdbsink.NewBridge(sink, esdbsink.BridgeOptions{BatchSize: 500})
+	if err := bridge.Run(ctx, subscription); err != nil {
+		log.Fatal(err)
+	}
+}
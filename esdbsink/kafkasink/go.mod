@@ -0,0 +1,28 @@
+module github.com/EventStore/EventStore-Client-Go/v2/esdbsink/kafkasink
+
+go 1.21
+
+require (
+	github.com/EventStore/EventStore-Client-Go/v2 v2.0.0
+	github.com/gofrs/uuid v3.3.0+incompatible
+	github.com/segmentio/kafka-go v0.4.47
+	github.com/stretchr/testify v1.8.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/golang/protobuf v1.5.2 // indirect
+	github.com/klauspost/compress v1.15.9 // indirect
+	github.com/kr/pretty v0.3.1 // indirect
+	github.com/pierrec/lz4/v4 v4.1.15 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/net v0.17.0 // indirect
+	golang.org/x/sys v0.13.0 // indirect
+	golang.org/x/text v0.13.0 // indirect
+	google.golang.org/genproto v0.0.0-20200815001618-f69a88009b70 // indirect
+	google.golang.org/grpc v1.45.0 // indirect
+	google.golang.org/protobuf v1.28.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/EventStore/EventStore-Client-Go/v2 => ../../
@@ -0,0 +1,72 @@
+// Package kafkasink is an esdbsink.Sink producing events to Kafka.
+package kafkasink
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+	"github.com/segmentio/kafka-go"
+)
+
+// Message header keys.
+const (
+	HeaderEventID     = "esdb-event-id"
+	HeaderEventType   = "esdb-event-type"
+	HeaderContentType = "esdb-content-type"
+	HeaderStreamID    = "esdb-stream-id"
+	HeaderEventNumber = "esdb-event-number"
+	HeaderMetadata    = "esdb-metadata"
+)
+
+// Sink produces one message per event. Messages are keyed by stream name, so the events of a stream land on the
+// same partition and keep their order.
+type Sink struct {
+	writer *kafka.Writer
+}
+
+// New returns a sink producing with the writer, which should have its Topic set. Writes are synchronous unless the
+// writer is configured as Async, in which case events may be acked before Kafka got them.
+func New(writer *kafka.Writer) *Sink {
+	return &Sink{writer: writer}
+}
+
+func (s *Sink) Write(ctx context.Context, batch []*esdb.ResolvedEvent) error {
+	messages := make([]kafka.Message, 0, len(batch))
+
+	for _, resolved := range batch {
+		messages = append(messages, toMessage(resolved.OriginalEvent()))
+	}
+
+	return s.writer.WriteMessages(ctx, messages...)
+}
+
+// Flush is a no-op, Write only returns once Kafka acknowledged the messages.
+func (s *Sink) Flush(context.Context) error {
+	return nil
+}
+
+func (s *Sink) Close() error {
+	return s.writer.Close()
+}
+
+func toMessage(event *esdb.RecordedEvent) kafka.Message {
+	headers := []kafka.Header{
+		{Key: HeaderEventID, Value: []byte(event.EventID.String())},
+		{Key: HeaderEventType, Value: []byte(event.EventType)},
+		{Key: HeaderContentType, Value: []byte(event.ContentType)},
+		{Key: HeaderStreamID, Value: []byte(event.StreamID)},
+		{Key: HeaderEventNumber, Value: []byte(strconv.FormatUint(event.EventNumber, 10))},
+	}
+
+	if len(event.UserMetadata) > 0 {
+		headers = append(headers, kafka.Header{Key: HeaderMetadata, Value: event.UserMetadata})
+	}
+
+	return kafka.Message{
+		Key:     []byte(event.StreamID),
+		Value:   event.Data,
+		Headers: headers,
+		Time:    event.CreatedDate,
+	}
+}
@@ -0,0 +1,44 @@
+package kafkasink
+
+import (
+	"testing"
+	"time"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToMessage(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	created := time.Now()
+
+	message := toMessage(&esdb.RecordedEvent{
+		EventID:      id,
+		EventType:    "OrderPlaced",
+		ContentType:  "application/json",
+		StreamID:     "order-1",
+		EventNumber:  3,
+		Data:         []byte(`{}`),
+		UserMetadata: []byte(`{"a":1}`),
+		CreatedDate:  created,
+	})
+
+	assert.Equal(t, "order-1", string(message.Key))
+	assert.Equal(t, `{}`, string(message.Value))
+	assert.Equal(t, created, message.Time)
+
+	headers := make(map[string]string)
+	for _, header := range message.Headers {
+		headers[header.Key] = string(header.Value)
+	}
+
+	assert.Equal(t, map[string]string{
+		HeaderEventID:     id.String(),
+		HeaderEventType:   "OrderPlaced",
+		HeaderContentType: "application/json",
+		HeaderStreamID:    "order-1",
+		HeaderEventNumber: "3",
+		HeaderMetadata:    `{"a":1}`,
+	}, headers)
+}
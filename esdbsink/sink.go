@@ -0,0 +1,206 @@
+// Package esdbsink drains persistent subscriptions into external systems.
+package esdbsink
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+)
+
+// Sink receives batches of events. A batch is only acked on the subscription once both Write and Flush succeeded, so
+// a sink may buffer in Write as long as Flush makes everything durable.
+type Sink interface {
+	Write(ctx context.Context, batch []*esdb.ResolvedEvent) error
+	Flush(ctx context.Context) error
+}
+
+type BridgeOptions struct {
+	// Largest number of events handed to the sink at once.
+	BatchSize int // Defaults to 100.
+	// Longest time an incomplete batch waits for more events.
+	FlushInterval time.Duration // Defaults to 1s.
+	// Number of retries of a failed batch. Negative values disable retries.
+	MaxRetries int // Defaults to 5.
+	// Wait before the first retry, doubling after each one.
+	RetryBackoff time.Duration // Defaults to 100ms.
+	// What the server does with the events of a batch that still failed after all the retries.
+	NackAction esdb.Nack_Action // Defaults to esdb.Nack_Retry.
+	// Called with the error of each failed batch write, including retried ones.
+	OnError func(err error, batch []*esdb.ResolvedEvent)
+}
+
+func (o *BridgeOptions) setDefaults() {
+	if o.BatchSize == 0 {
+		o.BatchSize = 100
+	}
+
+	if o.FlushInterval == 0 {
+		o.FlushInterval = time.Second
+	}
+
+	if o.MaxRetries == 0 {
+		o.MaxRetries = 5
+	}
+
+	if o.RetryBackoff == 0 {
+		o.RetryBackoff = 100 * time.Millisecond
+	}
+
+	if o.NackAction == esdb.Nack_Unknown {
+		o.NackAction = esdb.Nack_Retry
+	}
+}
+
+// Bridge moves the events of a persistent subscription into a sink, in batches.
+type Bridge struct {
+	sink Sink
+	opts BridgeOptions
+}
+
+func NewBridge(sink Sink, opts BridgeOptions) *Bridge {
+	opts.setDefaults()
+
+	return &Bridge{
+		sink: sink,
+		opts: opts,
+	}
+}
+
+// Run drains the subscription until the context gets cancelled or the subscription drops. Batches are written in the
+// order the events got received and acked once written. It returns nil when the context got cancelled. Unacked
+// events are redelivered by the server after their message timeout, so the subscription BufferSize must be at least
+// the batch size for batches to fill up. The subscription is closed when Run returns.
+func (b *Bridge) Run(ctx context.Context, subscription *esdb.PersistentSubscription) error {
+	events := make(chan *esdb.PersistentSubscriptionEvent)
+	stop := make(chan struct{})
+	received := make(chan struct{})
+	go func() {
+		defer close(received)
+		defer close(events)
+
+		for {
+			event := subscription.Recv()
+
+			select {
+			case events <- event:
+			case <-stop:
+				return
+			}
+
+			if event.SubscriptionDropped != nil {
+				return
+			}
+		}
+	}()
+
+	// Closing the subscription unblocks a pending Recv, so the receiving goroutine is gone once Run returns.
+	defer func() {
+		close(stop)
+		subscription.Close()
+		<-received
+	}()
+
+	batch := make([]*esdb.ResolvedEvent, 0, b.opts.BatchSize)
+	timer := time.NewTimer(b.opts.FlushInterval)
+	defer timer.Stop()
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+
+		err := b.deliver(ctx, subscription, batch)
+		batch = make([]*esdb.ResolvedEvent, 0, b.opts.BatchSize)
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-timer.C:
+			if err := flush(); err != nil {
+				return err
+			}
+
+			timer.Reset(b.opts.FlushInterval)
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+
+			if event.SubscriptionDropped != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+
+				return fmt.Errorf("subscription dropped: %w", event.SubscriptionDropped.Error)
+			}
+
+			if event.EventAppeared == nil {
+				continue
+			}
+
+			batch = append(batch, event.EventAppeared.Event)
+
+			if len(batch) >= b.opts.BatchSize {
+				if err := flush(); err != nil {
+					return err
+				}
+
+				if !timer.Stop() {
+					<-timer.C
+				}
+
+				timer.Reset(b.opts.FlushInterval)
+			}
+		}
+	}
+}
+
+// deliver writes the batch to the sink, retrying on failure, then acks or nacks it.
+func (b *Bridge) deliver(ctx context.Context, subscription *esdb.PersistentSubscription, batch []*esdb.ResolvedEvent) error {
+	err := b.write(ctx, batch)
+
+	if ctx.Err() != nil {
+		return nil
+	}
+
+	if err != nil {
+		return subscription.NackContext(ctx, err.Error(), b.opts.NackAction, batch...)
+	}
+
+	return subscription.AckContext(ctx, batch...)
+}
+
+func (b *Bridge) write(ctx context.Context, batch []*esdb.ResolvedEvent) error {
+	backoff := b.opts.RetryBackoff
+
+	for attempt := 0; ; attempt++ {
+		err := b.sink.Write(ctx, batch)
+		if err == nil {
+			err = b.sink.Flush(ctx)
+		}
+
+		if err == nil {
+			return nil
+		}
+
+		if b.opts.OnError != nil {
+			b.opts.OnError(err, batch)
+		}
+
+		if attempt >= b.opts.MaxRetries {
+			return err
+		}
+
+		select {
+		case <-time.After(backoff):
+			backoff *= 2
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package esdbsink
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+	"github.com/stretchr/testify/assert"
+)
+
+type flakySink struct {
+	failures int
+	writes   int
+	flushes  int
+}
+
+func (s *flakySink) Write(context.Context, []*esdb.ResolvedEvent) error {
+	s.writes++
+	if s.writes <= s.failures {
+		return errors.New("unavailable")
+	}
+
+	return nil
+}
+
+func (s *flakySink) Flush(context.Context) error {
+	s.flushes++
+	return nil
+}
+
+func TestBridgeRetriesFailedWrites(t *testing.T) {
+	sink := &flakySink{failures: 2}
+	errs := 0
+	bridge := NewBridge(sink, BridgeOptions{
+		RetryBackoff: time.Millisecond,
+		OnError:      func(error, []*esdb.ResolvedEvent) { errs++ },
+	})
+
+	assert.NoError(t, bridge.write(context.Background(), []*esdb.ResolvedEvent{{}}))
+	assert.Equal(t, 3, sink.writes)
+	assert.Equal(t, 1, sink.flushes)
+	assert.Equal(t, 2, errs)
+}
+
+func TestBridgeGivesUpAfterMaxRetries(t *testing.T) {
+	sink := &flakySink{failures: 10}
+	bridge := NewBridge(sink, BridgeOptions{MaxRetries: 2, RetryBackoff: time.Millisecond})
+
+	assert.Error(t, bridge.write(context.Background(), []*esdb.ResolvedEvent{{}}))
+	assert.Equal(t, 3, sink.writes)
+	assert.Equal(t, 0, sink.flushes)
+}
+
+func TestBridgeWithoutRetries(t *testing.T) {
+	sink := &flakySink{failures: 1}
+	bridge := NewBridge(sink, BridgeOptions{MaxRetries: -1})
+
+	assert.Error(t, bridge.write(context.Background(), []*esdb.ResolvedEvent{{}}))
+	assert.Equal(t, 1, sink.writes)
+}
@@ -0,0 +1,79 @@
+// Package esdbwatermill implements the Watermill message.Publisher and message.Subscriber interfaces on top of
+// EventStoreDB appends and persistent subscriptions. Topics map to stream names.
+package esdbwatermill
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/gofrs/uuid"
+)
+
+const (
+	// MetadataEventType is the message metadata key holding the event type to use. When missing, the event type
+	// configured on the publisher is used.
+	MetadataEventType = "event_type"
+	// metadataMessageUUID holds the original message UUID when it can't be used as an event id as-is.
+	metadataMessageUUID = "_watermill_message_uuid"
+)
+
+// messageNamespace is used to derive stable event ids from message UUIDs that aren't UUIDs.
+var messageNamespace = uuid.Must(uuid.FromString("0a0e7c74-4b7b-4c1e-9c53-3a1f6c7ac5b1"))
+
+func marshalMessage(msg *message.Message, defaultEventType string) (esdb.EventData, error) {
+	metadata := make(map[string]string, len(msg.Metadata)+1)
+	for key, value := range msg.Metadata {
+		metadata[key] = value
+	}
+
+	eventID, err := uuid.FromString(msg.UUID)
+	if err != nil {
+		eventID = uuid.NewV5(messageNamespace, msg.UUID)
+		metadata[metadataMessageUUID] = msg.UUID
+	}
+
+	eventType := metadata[MetadataEventType]
+	if eventType == "" {
+		eventType = defaultEventType
+	}
+
+	encodedMetadata, err := json.Marshal(metadata)
+	if err != nil {
+		return esdb.EventData{}, fmt.Errorf("failed to encode metadata of message %s: %w", msg.UUID, err)
+	}
+
+	return esdb.EventData{
+		EventID:     eventID,
+		EventType:   eventType,
+		ContentType: esdb.BinaryContentType,
+		Data:        msg.Payload,
+		Metadata:    encodedMetadata,
+	}, nil
+}
+
+func unmarshalMessage(event *esdb.RecordedEvent) (*message.Message, error) {
+	metadata := make(map[string]string)
+
+	if len(event.UserMetadata) > 0 {
+		if err := json.Unmarshal(event.UserMetadata, &metadata); err != nil {
+			return nil, fmt.Errorf("failed to decode metadata of event %s: %w", event.EventID, err)
+		}
+	}
+
+	messageUUID := event.EventID.String()
+	if original, ok := metadata[metadataMessageUUID]; ok {
+		messageUUID = original
+		delete(metadata, metadataMessageUUID)
+	}
+
+	msg := message.NewMessage(messageUUID, event.Data)
+	msg.Metadata = metadata
+
+	if _, ok := metadata[MetadataEventType]; !ok {
+		msg.Metadata.Set(MetadataEventType, event.EventType)
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,56 @@
+package esdbwatermill
+
+import (
+	"testing"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func recordedFrom(event esdb.EventData) *esdb.RecordedEvent {
+	return &esdb.RecordedEvent{
+		EventID:      event.EventID,
+		EventType:    event.EventType,
+		ContentType:  "application/octet-stream",
+		Data:         event.Data,
+		UserMetadata: event.Metadata,
+	}
+}
+
+func TestMarshalRoundTripWithUUID(t *testing.T) {
+	id := uuid.Must(uuid.NewV4()).String()
+	msg := message.NewMessage(id, []byte("payload"))
+	msg.Metadata.Set("key", "value")
+
+	event, err := marshalMessage(msg, "Default")
+	require.NoError(t, err)
+	require.Equal(t, id, event.EventID.String())
+	require.Equal(t, "Default", event.EventType)
+
+	decoded, err := unmarshalMessage(recordedFrom(event))
+	require.NoError(t, err)
+	require.Equal(t, id, decoded.UUID)
+	require.Equal(t, []byte("payload"), []byte(decoded.Payload))
+	require.Equal(t, "value", decoded.Metadata.Get("key"))
+	require.Equal(t, "Default", decoded.Metadata.Get(MetadataEventType))
+}
+
+func TestMarshalRoundTripWithNonUUID(t *testing.T) {
+	msg := message.NewMessage("not-a-uuid", []byte("payload"))
+	msg.Metadata.Set(MetadataEventType, "OrderPlaced")
+
+	event, err := marshalMessage(msg, "Default")
+	require.NoError(t, err)
+	require.Equal(t, "OrderPlaced", event.EventType)
+
+	again, err := marshalMessage(msg, "Default")
+	require.NoError(t, err)
+	require.Equal(t, event.EventID, again.EventID)
+
+	decoded, err := unmarshalMessage(recordedFrom(event))
+	require.NoError(t, err)
+	require.Equal(t, "not-a-uuid", decoded.UUID)
+	require.Empty(t, decoded.Metadata.Get(metadataMessageUUID))
+}
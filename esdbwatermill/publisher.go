@@ -0,0 +1,92 @@
+package esdbwatermill
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+)
+
+var ErrPublisherClosed = errors.New("publisher is closed")
+
+type PublisherConfig struct {
+	// Event type of the appended events, unless the message holds a MetadataEventType entry.
+	EventType     string // Defaults to "WatermillMessage".
+	Authenticated *esdb.Credentials
+	Logger        watermill.LoggerAdapter
+}
+
+func (c *PublisherConfig) setDefaults() {
+	if c.EventType == "" {
+		c.EventType = "WatermillMessage"
+	}
+
+	if c.Logger == nil {
+		c.Logger = watermill.NopLogger{}
+	}
+}
+
+// Publisher appends messages to the stream named after the topic. The client is not closed by the publisher.
+type Publisher struct {
+	client *esdb.Client
+	config PublisherConfig
+	closed int32
+}
+
+func NewPublisher(client *esdb.Client, config PublisherConfig) *Publisher {
+	config.setDefaults()
+
+	return &Publisher{
+		client: client,
+		config: config,
+	}
+}
+
+// Publish appends all the messages to the topic stream in a single, atomic, append.
+func (p *Publisher) Publish(topic string, messages ...*message.Message) error {
+	if atomic.LoadInt32(&p.closed) != 0 {
+		return ErrPublisherClosed
+	}
+
+	if len(messages) == 0 {
+		return nil
+	}
+
+	events := make([]esdb.EventData, 0, len(messages))
+	for _, msg := range messages {
+		event, err := marshalMessage(msg, p.config.EventType)
+		if err != nil {
+			return err
+		}
+
+		events = append(events, event)
+	}
+
+	ctx := messages[0].Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	_, err := p.client.AppendToStream(ctx, topic, esdb.AppendToStreamOptions{
+		Authenticated: p.config.Authenticated,
+	}, events...)
+
+	if err != nil {
+		return err
+	}
+
+	p.config.Logger.Trace("Messages published", watermill.LogFields{
+		"topic":         topic,
+		"message_count": len(messages),
+	})
+
+	return nil
+}
+
+func (p *Publisher) Close() error {
+	atomic.StoreInt32(&p.closed, 1)
+	return nil
+}
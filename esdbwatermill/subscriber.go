@@ -0,0 +1,215 @@
+package esdbwatermill
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/EventStore/EventStore-Client-Go/v2/esdb"
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+)
+
+var ErrSubscriberClosed = errors.New("subscriber is closed")
+
+type SubscriberConfig struct {
+	// Name of the persistent subscription group consuming the topic stream. Subscribers sharing a group compete for
+	// messages.
+	ConsumerGroup string
+	// Skips creating the persistent subscription when it doesn't exist.
+	DoNotCreateSubscription bool
+	// Settings used when creating the persistent subscription.
+	Settings *esdb.SubscriptionSettings
+	// Number of in-flight messages the server sends ahead of acknowledgements.
+	BufferSize uint32
+	// What the server does with a message that got nacked.
+	NackAction    esdb.Nack_Action // Defaults to esdb.Nack_Retry.
+	Authenticated *esdb.Credentials
+	Logger        watermill.LoggerAdapter
+}
+
+func (c *SubscriberConfig) setDefaults() {
+	if c.NackAction == esdb.Nack_Unknown {
+		c.NackAction = esdb.Nack_Retry
+	}
+
+	if c.Logger == nil {
+		c.Logger = watermill.NopLogger{}
+	}
+}
+
+// Subscriber consumes the stream named after the topic through a persistent subscription. Messages are delivered one
+// at a time: the next message is only sent on the channel once the previous one got acked or nacked.
+type Subscriber struct {
+	client  *esdb.Client
+	config  SubscriberConfig
+	lock    sync.Mutex
+	closed  bool
+	closing chan struct{}
+	running sync.WaitGroup
+}
+
+func NewSubscriber(client *esdb.Client, config SubscriberConfig) (*Subscriber, error) {
+	config.setDefaults()
+
+	if config.ConsumerGroup == "" {
+		return nil, errors.New("a consumer group is required")
+	}
+
+	return &Subscriber{
+		client:  client,
+		config:  config,
+		closing: make(chan struct{}),
+	}, nil
+}
+
+// SubscribeInitialize creates the persistent subscription of the topic if it doesn't exist yet.
+func (s *Subscriber) SubscribeInitialize(topic string) error {
+	err := s.client.CreatePersistentSubscription(context.Background(), topic, s.config.ConsumerGroup, esdb.PersistentStreamSubscriptionOptions{
+		Settings:      s.config.Settings,
+		StartFrom:     esdb.Start{},
+		Authenticated: s.config.Authenticated,
+	})
+
+	var esdbErr *esdb.Error
+	if errors.As(err, &esdbErr) && esdbErr.Code() == esdb.ErrorResourceAlreadyExists {
+		return nil
+	}
+
+	return err
+}
+
+func (s *Subscriber) Subscribe(ctx context.Context, topic string) (<-chan *message.Message, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.closed {
+		return nil, ErrSubscriberClosed
+	}
+
+	if !s.config.DoNotCreateSubscription {
+		if err := s.SubscribeInitialize(topic); err != nil {
+			return nil, err
+		}
+	}
+
+	subscription, err := s.client.SubscribeToPersistentSubscription(ctx, topic, s.config.ConsumerGroup, esdb.SubscribeToPersistentSubscriptionOptions{
+		BufferSize:    s.config.BufferSize,
+		Authenticated: s.config.Authenticated,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := make(chan *message.Message)
+	logger := s.config.Logger.With(watermill.LogFields{
+		"topic":          topic,
+		"consumer_group": s.config.ConsumerGroup,
+	})
+
+	finished := make(chan struct{})
+	s.running.Add(1)
+	go func() {
+		select {
+		case <-s.closing:
+		case <-ctx.Done():
+		case <-finished:
+		}
+
+		_ = subscription.Close()
+	}()
+
+	go func() {
+		defer s.running.Done()
+		defer close(output)
+		defer close(finished)
+
+		s.consume(ctx, subscription, output, logger)
+	}()
+
+	return output, nil
+}
+
+func (s *Subscriber) consume(ctx context.Context, subscription *esdb.PersistentSubscription, output chan<- *message.Message, logger watermill.LoggerAdapter) {
+	for {
+		event := subscription.Recv()
+
+		if event.SubscriptionDropped != nil {
+			if ctx.Err() == nil && !s.isClosed() {
+				logger.Error("Persistent subscription dropped", event.SubscriptionDropped.Error, nil)
+			}
+
+			return
+		}
+
+		if event.EventAppeared == nil {
+			continue
+		}
+
+		resolved := event.EventAppeared.Event
+		msg, err := unmarshalMessage(resolved.Event)
+
+		if err != nil {
+			logger.Error("Cannot unmarshal message, parking it", err, nil)
+			_ = subscription.Nack(err.Error(), esdb.Nack_Park, resolved)
+			continue
+		}
+
+		msgCtx, cancel := context.WithCancel(ctx)
+		msg.SetContext(msgCtx)
+
+		select {
+		case output <- msg:
+		case <-ctx.Done():
+			cancel()
+			return
+		case <-s.closing:
+			cancel()
+			return
+		}
+
+		select {
+		case <-msg.Acked():
+			err = subscription.Ack(resolved)
+		case <-msg.Nacked():
+			err = subscription.Nack("nacked by watermill handler", s.config.NackAction, resolved)
+		case <-ctx.Done():
+			cancel()
+			return
+		case <-s.closing:
+			cancel()
+			return
+		}
+
+		cancel()
+
+		if err != nil {
+			logger.Error("Cannot send acknowledgement", err, watermill.LogFields{"message_uuid": msg.UUID})
+			return
+		}
+	}
+}
+
+func (s *Subscriber) isClosed() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.closed
+}
+
+// Close stops all the subscriptions and waits for their goroutines to exit. The client is not closed.
+func (s *Subscriber) Close() error {
+	s.lock.Lock()
+	if s.closed {
+		s.lock.Unlock()
+		return nil
+	}
+
+	s.closed = true
+	close(s.closing)
+	s.lock.Unlock()
+
+	s.running.Wait()
+	return nil
+}